@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/hooks"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// runAdd creates tickets from the command line: one ticket from the title
+// arguments, or with --bulk one ticket per line of stdin.
+func runAdd(args []string) {
+	fs := flag.NewFlagSet("add", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	column := fs.String("column", "", "Column name or directory (default: first column)")
+	tags := fs.String("tags", "", "Comma-separated tags for the new tickets")
+	bulk := fs.Bool("bulk", false, "Create one ticket per line of stdin")
+	sep := fs.String("sep", "", "With --bulk, split each line into title and content at this separator")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban add [flags] <title...>")
+		fmt.Fprintln(os.Stderr, "       <command> | kanban add --bulk [flags]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	col, ok := findColumn(cfg, *column)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown column: %s\n", *column)
+		os.Exit(1)
+	}
+	if err := requireBoard(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(cfg.ColumnPath(col.Dir), 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating directories: %v\n", err)
+		os.Exit(1)
+	}
+
+	var entries [][2]string
+	if *bulk {
+		entries, err = readBulkEntries(os.Stdin, *sep)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading stdin: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		title := strings.TrimSpace(strings.Join(fs.Args(), " "))
+		if title == "" {
+			fs.Usage()
+			os.Exit(2)
+		}
+		entries = [][2]string{{title, ""}}
+	}
+
+	tagList := splitTags(*tags)
+	for _, entry := range entries {
+		ticket := models.NewTicket(entry[0], col.Dir)
+		ticket.Tags, ticket.Content = col.WithDefaults(append([]string(nil), tagList...), entry[1])
+		path, err := ticket.UniqueFilePath(cfg.ColumnPath(col.Dir))
+		if err == nil {
+			ticket.FilePath = path
+			err = ticket.Save()
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %q: %v\n", entry[0], err)
+			os.Exit(1)
+		}
+		fmt.Println(filepath.Join(col.Dir, ticket.Filename()))
+		if command := hooks.Command(cfg.Hooks, hooks.Create); command != "" {
+			if err := hooks.Run(command, hooks.NewPayload(hooks.Create, ticket, "")); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}
+	}
+}
+
+// readBulkEntries reads one (title, content) pair per non-empty line. With
+// sep set, the text after the first separator becomes the content.
+func readBulkEntries(r io.Reader, sep string) ([][2]string, error) {
+	var entries [][2]string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		title, content := line, ""
+		if sep != "" {
+			if before, after, found := strings.Cut(line, sep); found {
+				title, content = strings.TrimSpace(before), strings.TrimSpace(after)
+			}
+		}
+		if title != "" {
+			entries = append(entries, [2]string{title, content})
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// loadCLIConfig loads the config for non-interactive subcommands, applying
+// a -dir override. Without -config or -dir it uses .kanban/config.yaml, or
+// else the nearest board above the current directory, like the TUI.
+func loadCLIConfig(cfgPath, kanbanDir string) (*config.Config, error) {
+	if cfgPath == "" {
+		cfgPath = ".kanban/config.yaml"
+		if kanbanDir == "" && !config.Exists(cfgPath) {
+			if board := config.FindBoard("."); board != "" {
+				return config.LoadBoard(board)
+			}
+		}
+	}
+	cfg, err := config.Load(config.ExpandPath(cfgPath))
+	if err != nil {
+		return nil, err
+	}
+	if kanbanDir != "" {
+		absDir, err := filepath.Abs(kanbanDir)
+		if err != nil {
+			return nil, err
+		}
+		cfg.KanbanDir = absDir
+	}
+	return cfg, nil
+}
+
+// requireBoard fails unless the board of cfg exists, so subcommands never
+// create one by accident; kanban init does that.
+func requireBoard(cfg *config.Config) error {
+	if info, err := os.Stat(cfg.KanbanDir); err != nil || !info.IsDir() {
+		return fmt.Errorf("no board in %s: run kanban init first", cfg.KanbanDir)
+	}
+	return nil
+}
+
+// findColumn looks up a column by directory or name (case-insensitive); an
+// empty name selects the first column.
+func findColumn(cfg *config.Config, name string) (config.Column, bool) {
+	if name == "" {
+		return cfg.Columns[0], true
+	}
+	for _, col := range cfg.Columns {
+		if strings.EqualFold(col.Dir, name) || strings.EqualFold(col.Name, name) {
+			return col, true
+		}
+	}
+	return config.Column{}, false
+}
+
+// splitTags parses a comma-separated tag list.
+func splitTags(s string) []string {
+	tags := []string{}
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
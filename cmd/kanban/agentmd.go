@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// runAgentMd regenerates AGENT.md from the board's config.
+func runAgentMd(args []string) {
+	fs := flag.NewFlagSet("agent-md", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	stdout := fs.Bool("stdout", false, "Print the instructions instead of writing AGENT.md")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban agent-md [flags]")
+		fmt.Fprintln(os.Stderr, "  Regenerates AGENT.md from the columns, move reasons and prompts in the config.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *stdout {
+		text, err := cfg.AgentInstructions()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Print(text)
+		return
+	}
+	path, err := cfg.WriteAgentInstructions()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote %s\n", path)
+}
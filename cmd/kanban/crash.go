@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/logging"
+)
+
+// crashTimeLayout names crash reports, e.g. crash-2025-01-15T10-04-05.log.
+const crashTimeLayout = "2006-01-02T15-04-05"
+
+// writeCrashReport writes a crash report for a panic to
+// kanbanDir/crash-<timestamp>.log: the panic value, the stack trace, the
+// preserved draft (if any) and the recent activity log. It falls back to the
+// temp directory when the board is not writable, and returns the path written.
+func writeCrashReport(kanbanDir string, r any, stack []byte, draft string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "kanban-tui v%s crashed at %s\n", version, time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&b, "%s/%s, %s\n\n", runtime.GOOS, runtime.GOARCH, runtime.Version())
+	fmt.Fprintf(&b, "panic: %v\n\n%s\n", r, stack)
+	if draft != "" {
+		fmt.Fprintf(&b, "Unsaved editor content was preserved in %s\n\n", draft)
+	}
+	b.WriteString("Recent activity:\n")
+	for _, line := range logging.Recent() {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	name := "crash-" + time.Now().Format(crashTimeLayout) + ".log"
+	path := filepath.Join(kanbanDir, name)
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		path = filepath.Join(os.TempDir(), name)
+		if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/mail"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// runEmail emails a ticket, or a summary of the board, through the SMTP
+// server in the user-wide config.
+func runEmail(args []string) {
+	fs := flag.NewFlagSet("email", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	to := fs.String("to", "", "Comma-separated recipients (default: smtp to in the user config)")
+	board := fs.Bool("board", false, "Email a summary of the whole board instead of a ticket")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban email [flags] <ticket>")
+		fmt.Fprintln(os.Stderr, "       kanban email -board [flags]")
+		fmt.Fprintln(os.Stderr, "  <ticket> is a ticket's filename, with or without .md, in any column.")
+		fmt.Fprintf(os.Stderr, "  The smtp section of %s configures the server.\n", config.UserConfigPath())
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *board == (fs.NArg() == 1) || fs.NArg() > 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	server, err := config.LoadSMTP()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	list := *to
+	if list == "" {
+		list = strings.Join(server.To, ", ")
+	}
+	recipients, err := mail.ParseRecipients(list)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v (pass -to or set smtp to)\n", err)
+		os.Exit(1)
+	}
+
+	columns, err := mail.ReadBoard(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading board: %v\n", err)
+		os.Exit(1)
+	}
+	var subject, body string
+	if *board {
+		subject, body = mail.BoardSummary(config.BoardName(cfg.KanbanDir), columns, time.Now())
+	} else {
+		subject, body, err = ticketEmail(columns, fs.Arg(0))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	msg := mail.Message{From: server.From, To: recipients, Subject: subject, Body: body}
+	if err := mail.Send(server, msg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error sending: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Sent %q to %s\n", subject, strings.Join(recipients, ", "))
+}
+
+// ticketEmail finds the ticket named name on the board and summarizes it.
+func ticketEmail(columns []mail.Column, name string) (subject, body string, err error) {
+	name = strings.TrimSuffix(name, ".md") + ".md"
+	for _, col := range columns {
+		for _, t := range col.Tickets {
+			if t.Filename() != name {
+				continue
+			}
+			// The board was read without ticket bodies
+			if t, err = models.ParseTicket(t.FilePath); err != nil {
+				return "", "", err
+			}
+			subject, body = mail.TicketSummary(t, col.Name)
+			return subject, body, nil
+		}
+	}
+	return "", "", fmt.Errorf("no ticket %s on the board", name)
+}
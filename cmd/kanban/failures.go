@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/testreport"
+)
+
+// runFailures turns failing tests into tickets. It runs `go test -json`
+// (with the remaining arguments, default ./...) in the project, or reads a
+// saved -json stream or JUnit XML report. Tests that already have a ticket
+// outside the done column are skipped.
+func runFailures(args []string) {
+	fs := flag.NewFlagSet("failures", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	column := fs.String("column", "", "Column for new tickets (default: first column)")
+	tags := fs.String("tags", "test-failure", "Comma-separated tags for the new tickets")
+	jsonFile := fs.String("json", "", "Read `go test -json` output from this file (\"-\" for stdin) instead of running tests")
+	junitFile := fs.String("junit", "", "Read a JUnit XML report (\"-\" for stdin) instead of running tests")
+	dryRun := fs.Bool("dry-run", false, "Print what would be created without writing tickets")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban failures [flags] [go test args...]")
+		fmt.Fprintln(os.Stderr, "  Creates a ticket per failing test.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	col, ok := findColumn(cfg, *column)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown column: %s\n", *column)
+		os.Exit(1)
+	}
+
+	var failures []testreport.Failure
+	switch {
+	case *junitFile != "":
+		err = withInput(*junitFile, func(r io.Reader) (err error) {
+			failures, err = testreport.ParseJUnit(r)
+			return err
+		})
+	case *jsonFile != "":
+		err = withInput(*jsonFile, func(r io.Reader) (err error) {
+			failures, err = testreport.ParseGoTestJSON(r)
+			return err
+		})
+	default:
+		failures, err = runGoTest(filepath.Dir(cfg.KanbanDir), fs.Args())
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading test results: %v\n", err)
+		os.Exit(1)
+	}
+
+	tickets, err := loadBoardTickets(cfg, models.ParseTicketHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tickets: %v\n", err)
+		os.Exit(1)
+	}
+	// Tickets in the done column don't count, so regressions reopen work
+	doneDir := cfg.DoneColumn()
+	existing := make(map[string]bool)
+	for _, t := range tickets {
+		if t.ScanID != "" && t.Column != doneDir {
+			existing[t.ScanID] = true
+		}
+	}
+
+	var created int
+	for _, f := range failures {
+		id := failureID(f)
+		if existing[id] {
+			continue
+		}
+		existing[id] = true
+
+		ticket := ticketFromFailure(f, col.Dir)
+		ticket.Tags = splitTags(*tags)
+		ticket.ScanID = id
+		if ticket.FilePath, err = ticket.UniqueFilePath(cfg.ColumnPath(col.Dir)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", ticket.Title, err)
+			continue
+		}
+		fmt.Printf("create  %s\n", ticket.Title)
+		created++
+		if !*dryRun {
+			if err := ticket.Save(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", ticket.FilePath, err)
+			}
+		}
+	}
+	fmt.Printf("%d failing tests: %d new tickets\n", len(failures), created)
+}
+
+// runGoTest runs `go test -json` in dir and parses the failures. A non-zero
+// exit status is expected when tests fail and is not an error.
+func runGoTest(dir string, args []string) ([]testreport.Failure, error) {
+	if len(args) == 0 {
+		args = []string{"./..."}
+	}
+	cmd := exec.Command("go", append([]string{"test", "-json"}, args...)...)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, err
+	}
+	return testreport.ParseGoTestJSON(bytes.NewReader(out))
+}
+
+// withInput calls fn with the named file, or stdin for "-".
+func withInput(path string, fn func(io.Reader) error) error {
+	if path == "-" {
+		return fn(os.Stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return fn(f)
+}
+
+// ticketFromFailure builds a new ticket describing a failing test.
+func ticketFromFailure(f testreport.Failure, column string) *models.Ticket {
+	title := "Fix failing test " + f.Name()
+	if f.Test == "" {
+		title = "Fix failing package " + f.Package
+	}
+
+	ticket := models.NewTicket(title, column)
+	ticket.Source = f.Name()
+	var b strings.Builder
+	fmt.Fprintf(&b, "`%s` fails.\n\n", f.Name())
+	if f.Output != "" {
+		b.WriteString("## Output\n\n```\n")
+		b.WriteString(f.Output)
+		b.WriteString("\n```\n")
+	}
+	ticket.Content = strings.TrimSpace(b.String())
+	return ticket
+}
+
+// failureID is a stable hash identifying a failing test across runs.
+func failureID(f testreport.Failure) string {
+	sum := sha1.Sum([]byte("test\x00" + f.Name()))
+	return hex.EncodeToString(sum[:])[:12]
+}
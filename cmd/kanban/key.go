@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/platform"
+	"github.com/user/kanban-tui/internal/secret"
+)
+
+// runKey manages the board key that encrypts tickets' private notes.
+func runKey(args []string) {
+	usage := func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban key <generate|set> [flags]")
+		fmt.Fprintln(os.Stderr, "  generate  creates a board key, stores it in the keychain and prints it to share")
+		fmt.Fprintln(os.Stderr, "  set       reads a key shared by a teammate from stdin and stores it in the keychain")
+	}
+	if len(args) == 0 || (args[0] != "generate" && args[0] != "set") {
+		usage()
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("key "+args[0], flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	force := fs.Bool("force", false, "Replace a different key already stored for the board")
+	fs.Parse(args[1:])
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	var key string
+	if args[0] == "generate" {
+		if key, err = secret.GenerateKey(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		fmt.Fprintln(os.Stderr, "Paste the board key and press Enter:")
+		scanner := bufio.NewScanner(os.Stdin)
+		if !scanner.Scan() {
+			fmt.Fprintln(os.Stderr, "Error: no key given")
+			os.Exit(1)
+		}
+		key = scanner.Text()
+	}
+
+	// Notes sealed with the stored key can't be opened with another one
+	if stored, err := secret.StoredKey(cfg.KanbanDir); err == nil && strings.TrimSpace(stored) != strings.TrimSpace(key) && !*force {
+		fmt.Fprintln(os.Stderr, "Error: a different key is already stored for this board; private notes sealed with it")
+		fmt.Fprintln(os.Stderr, "could not be decrypted with the new one. Pass -force to replace it anyway.")
+		os.Exit(1)
+	}
+
+	account := secret.Account(cfg.KanbanDir)
+	err = secret.StoreKey(cfg.KanbanDir, key)
+	switch {
+	case errors.Is(err, platform.ErrNoKeychain):
+		fmt.Fprintf(os.Stderr, "No keychain available; set $%s instead.\n", secret.KeyEnv)
+		if args[0] == "set" {
+			os.Exit(1)
+		}
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	default:
+		fmt.Fprintf(os.Stderr, "Stored the key in the keychain as %s/%s\n", secret.Service, account)
+	}
+
+	if args[0] == "generate" {
+		fmt.Fprintln(os.Stderr, "Share it with teammates over a secure channel; they run kanban key set:")
+		fmt.Println(key)
+	}
+}
@@ -2,14 +2,21 @@
 package main
 
 import (
+	"bufio"
 	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime/debug"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/demo"
+	"github.com/user/kanban-tui/internal/logging"
+	"github.com/user/kanban-tui/internal/state"
 	"github.com/user/kanban-tui/internal/ui"
+	"golang.org/x/term"
 )
 
 var (
@@ -17,25 +24,105 @@ var (
 )
 
 func main() {
+	// Keep log records off the terminal unless -log enables the log file
+	logging.Setup("", "")
+
+	// Subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "demo":
+			runDemo(os.Args[2:])
+			return
+		case "open":
+			runOpen(os.Args[2:])
+			return
+		case "init":
+			runInit(os.Args[2:])
+			return
+		case "add":
+			runAdd(os.Args[2:])
+			return
+		case "scan":
+			runScan(os.Args[2:])
+			return
+		case "failures":
+			runFailures(os.Args[2:])
+			return
+		case "migrate-columns":
+			runMigrateColumns(os.Args[2:])
+			return
+		case "tag":
+			runTag(os.Args[2:])
+			return
+		case "replace":
+			runReplace(os.Args[2:])
+			return
+		case "serve":
+			runServe(os.Args[2:])
+			return
+		case "key":
+			runKey(os.Args[2:])
+			return
+		case "print":
+			runPrint(os.Args[2:])
+			return
+		case "email":
+			runEmail(os.Args[2:])
+			return
+		case "agent-md":
+			runAgentMd(os.Args[2:])
+			return
+		}
+	}
+
 	// Command line flags
 	configPath := flag.String("config", "", "Path to config file")
 	kanbanDir := flag.String("dir", "", "Kanban directory (overrides config)")
 	showVersion := flag.Bool("version", false, "Show version")
+	skipSetup := flag.Bool("no-setup", false, "Skip the first-run setup wizard and use defaults")
+	noDiscover := flag.Bool("no-discover", false, "Don't look for a .kanban board in parent directories")
+	yes := flag.Bool("yes", false, "Create the board without asking if it doesn't exist")
+	logLevel := flag.String("log", "", "Write a debug log at this level (debug, info, warn, error)")
+	logFile := flag.String("log-file", logging.DefaultPath(), "Log file used with -log")
 	flag.Parse()
 
+	logCloser, err := logging.Setup(*logLevel, *logFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting up logging: %v\n", err)
+		os.Exit(1)
+	}
+	defer logCloser.Close()
+
 	if *showVersion {
 		fmt.Printf("kanban-tui v%s\n", version)
 		os.Exit(0)
 	}
 
-	// Determine config path
-	cfgPath := *configPath
+	// Determine config path: the board's config, else the nearest board in a
+	// parent directory, else the user-wide $XDG_CONFIG_HOME/kanban-tui/config.yaml
+	cfgPath := config.ExpandPath(*configPath)
+	parentBoard := ""
 	if cfgPath == "" {
 		cfgPath = ".kanban/config.yaml"
+		if !config.Exists(cfgPath) && *kanbanDir == "" && !*noDiscover {
+			parentBoard = findParentBoard()
+		}
+		if parentBoard == "" && !config.Exists(cfgPath) && config.Exists(config.UserConfigPath()) {
+			cfgPath = config.UserConfigPath()
+		}
 	}
 
-	// Load configuration
-	cfg, err := config.Load(cfgPath)
+	// Load configuration, running the setup wizard on an interactive first run
+	var cfg *config.Config
+	setupRan := false
+	if parentBoard != "" {
+		cfg, err = config.LoadBoard(parentBoard)
+	} else if !config.Exists(cfgPath) && *kanbanDir == "" && !*skipSetup && term.IsTerminal(int(os.Stdin.Fd())) {
+		cfg, err = runSetup(cfgPath)
+		setupRan = true
+	} else {
+		cfg, err = config.Load(cfgPath)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 		os.Exit(1)
@@ -43,7 +130,7 @@ func main() {
 
 	// Override kanban directory if specified
 	if *kanbanDir != "" {
-		absDir, err := filepath.Abs(*kanbanDir)
+		absDir, err := filepath.Abs(config.ExpandPath(*kanbanDir))
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error resolving directory: %v\n", err)
 			os.Exit(1)
@@ -51,12 +138,98 @@ func main() {
 		cfg.KanbanDir = absDir
 	}
 
-	// Ensure directories exist
-	if err := cfg.EnsureDirectories(); err != nil {
+	// Never create a board without consent: the setup wizard, -yes, or a prompt
+	if !boardExists(cfg.KanbanDir) && !setupRan && !*yes && !confirmCreateBoard(cfg.KanbanDir) {
+		os.Exit(1)
+	}
+	if err := createBoard(cfg); err != nil {
 		fmt.Fprintf(os.Stderr, "Error creating directories: %v\n", err)
 		os.Exit(1)
 	}
 
+	runBoard(cfg)
+}
+
+// boardExists reports whether kanbanDir is an existing directory.
+func boardExists(kanbanDir string) bool {
+	info, err := os.Stat(kanbanDir)
+	return err == nil && info.IsDir()
+}
+
+// confirmCreateBoard asks on the terminal whether to create a board in
+// kanbanDir. Without a terminal it refuses, pointing at kanban init and -yes.
+func confirmCreateBoard(kanbanDir string) bool {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Fprintf(os.Stderr, "No kanban board in %s. Run `kanban init` or pass -yes to create one.\n", kanbanDir)
+		return false
+	}
+	fmt.Printf("No kanban board in %s. Create one? [y/N] ", kanbanDir)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	fmt.Println("Not created. Run `kanban init` to create a board here.")
+	return false
+}
+
+// createBoard creates the board's directories, and its config file when the
+// config belongs in the board and doesn't exist yet.
+func createBoard(cfg *config.Config) error {
+	cfgPath, err := filepath.Abs(cfg.Path)
+	if err == nil && cfg.Path != "" && !config.Exists(cfgPath) && filepath.Dir(cfgPath) == cfg.KanbanDir {
+		if err := cfg.Save(cfgPath); err != nil {
+			return err
+		}
+	}
+	return cfg.EnsureDirectories()
+}
+
+// runInit creates a board in path/.kanban (default: the current directory)
+// with the default columns.
+func runInit(args []string) {
+	fs := flag.NewFlagSet("init", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban init [path]")
+		fmt.Fprintln(os.Stderr, "  Creates a board in path/.kanban (default: the current directory).")
+	}
+	fs.Parse(args)
+
+	path := "."
+	if fs.NArg() > 0 {
+		path = config.ExpandPath(fs.Arg(0))
+	}
+	kanbanDir := boardDirFor(path)
+	if boardExists(kanbanDir) {
+		fmt.Printf("Board already exists in %s\n", kanbanDir)
+		return
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = kanbanDir
+	cfg.Path = filepath.Join(kanbanDir, "config.yaml")
+	if err := createBoard(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating board: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Created board in %s\n", kanbanDir)
+}
+
+// findParentBoard returns the nearest .kanban directory above the current
+// directory, or "" if there is none or the current directory has its own.
+func findParentBoard() string {
+	board := config.FindBoard(".")
+	if board == "" {
+		return ""
+	}
+	if cwd, err := filepath.Abs(".kanban"); err == nil && board == cwd {
+		return ""
+	}
+	return board
+}
+
+// runBoard runs the kanban board UI for cfg.
+func runBoard(cfg *config.Config) {
 	// Create the UI model
 	model, err := ui.New(cfg)
 	if err != nil {
@@ -64,15 +237,184 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Run the program
+	// Run the program, handling panics ourselves so the terminal is restored
+	// and a crash report is written
 	p := tea.NewProgram(
 		model,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
+		tea.WithoutCatchPanics(),
 	)
+	defer func() {
+		if r := recover(); r != nil {
+			stack := debug.Stack()
+			p.ReleaseTerminal()
+			draft := model.PreserveDraft()
+			fmt.Fprintf(os.Stderr, "kanban-tui crashed: %v\n", r)
+			if draft != "" {
+				fmt.Fprintf(os.Stderr, "Unsaved editor content was preserved in %s\n", draft)
+			}
+			if path, err := writeCrashReport(model.KanbanDir(), r, stack, draft); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing crash report: %v\n%s", err, stack)
+			} else {
+				fmt.Fprintf(os.Stderr, "Crash report written to %s; please attach it to a bug report.\n", path)
+			}
+			os.Exit(2)
+		}
+	}()
 
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
+	model.WaitRuns()
+}
+
+// runDemo populates a sample board and opens it. Without -dir the board is
+// created in a temporary directory that is removed on exit.
+func runDemo(args []string) {
+	fs := flag.NewFlagSet("demo", flag.ExitOnError)
+	dir := fs.String("dir", "", "Directory to create the demo board in (kept after exit)")
+	noUI := fs.Bool("no-ui", false, "Only generate the board, don't open it")
+	tickets := fs.Int("tickets", 0, "Generate this many synthetic tickets instead of the samples (for load testing)")
+	columns := fs.Int("columns", len(demo.Columns), "Number of columns for synthetic tickets")
+	fs.Parse(args)
+	if *tickets > 0 && *columns < 1 {
+		fmt.Fprintln(os.Stderr, "Error: -columns must be at least 1")
+		os.Exit(1)
+	}
+
+	root := *dir
+	if root == "" {
+		tmp, err := os.MkdirTemp("", "kanban-demo-")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating demo directory: %v\n", err)
+			os.Exit(1)
+		}
+		defer os.RemoveAll(tmp)
+		root = tmp
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error resolving directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = filepath.Join(absRoot, ".kanban")
+	cfg.Columns = demo.Columns
+	populate := demo.Populate
+	if *tickets > 0 {
+		cfg.Columns = demo.SyntheticColumns(*columns)
+		populate = func(cfg *config.Config) error {
+			return demo.Synthetic(cfg, *tickets, 1)
+		}
+	}
+
+	if err := cfg.EnsureDirectories(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating directories: %v\n", err)
+		os.Exit(1)
+	}
+	if err := populate(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating demo board: %v\n", err)
+		os.Exit(1)
+	}
+	cfg.Path = filepath.Join(cfg.KanbanDir, "config.yaml")
+	if err := cfg.Save(cfg.Path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *noUI {
+		fmt.Printf("Demo board created in %s\n", cfg.KanbanDir)
+		return
+	}
+	runBoard(cfg)
+}
+
+// runSetup runs the first-run wizard and saves the resulting config to cfgPath.
+func runSetup(cfgPath string) (*config.Config, error) {
+	setup := ui.NewSetup(config.DefaultConfig())
+	if _, err := tea.NewProgram(setup, tea.WithAltScreen()).Run(); err != nil {
+		return nil, err
+	}
+	if !setup.Completed() {
+		fmt.Println("Setup cancelled.")
+		os.Exit(0)
+	}
+
+	cfg := setup.Config()
+	cfg.Path = cfgPath
+	if err := cfg.Save(cfgPath); err != nil {
+		return nil, fmt.Errorf("saving config: %w", err)
+	}
+	return cfg, nil
+}
+
+// runOpen opens a board by path, registering it in the recent boards list, or
+// without a path lets the user fuzzy-pick among recent boards.
+func runOpen(args []string) {
+	fs := flag.NewFlagSet("open", flag.ExitOnError)
+	yes := fs.Bool("yes", false, "Create the board without asking if it doesn't exist")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban open [-yes] [path]")
+		fmt.Fprintln(os.Stderr, "  With a path, opens the board in path/.kanban.")
+		fmt.Fprintln(os.Stderr, "  Without a path, picks among recently opened boards.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	var kanbanDir string
+	if fs.NArg() > 0 {
+		kanbanDir = boardDirFor(fs.Arg(0))
+	} else {
+		st, err := state.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading state: %v\n", err)
+			os.Exit(1)
+		}
+		boards := st.ExistingRecentBoards()
+		if len(boards) == 0 {
+			fmt.Fprintln(os.Stderr, "No recent boards. Use: kanban open <path>")
+			os.Exit(1)
+		}
+
+		chooser := ui.NewBoardChooser(boards)
+		if _, err := tea.NewProgram(chooser, tea.WithAltScreen()).Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+			os.Exit(1)
+		}
+		if chooser.Chosen() == "" {
+			return
+		}
+		kanbanDir = chooser.Chosen()
+	}
+
+	cfg, err := config.LoadBoard(kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	if !boardExists(cfg.KanbanDir) && !*yes && !confirmCreateBoard(cfg.KanbanDir) {
+		os.Exit(1)
+	}
+	if err := cfg.EnsureDirectories(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating directories: %v\n", err)
+		os.Exit(1)
+	}
+	runBoard(cfg)
+}
+
+// boardDirFor resolves a path to a kanban directory: the path itself if it is
+// a ".kanban" directory, otherwise path/.kanban.
+func boardDirFor(path string) string {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = path
+	}
+	if filepath.Base(absPath) == ".kanban" {
+		return absPath
+	}
+	return filepath.Join(absPath, ".kanban")
 }
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/migrate"
+)
+
+// runMigrateColumns reconciles the board's column directories with the
+// columns in config.yaml after column dirs were renamed, added or removed
+// by hand.
+func runMigrateColumns(args []string) {
+	fs := flag.NewFlagSet("migrate-columns", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	var maps []string
+	fs.Func("map", "Move an old directory into a column, as old=new (repeatable, or comma-separated)", func(s string) error {
+		maps = append(maps, strings.Split(s, ",")...)
+		return nil
+	})
+	orphans := fs.String("orphans", "", "Column for tickets in directories no column claims (default: leave them)")
+	dryRun := fs.Bool("dry-run", false, "Print what would change without moving tickets")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban migrate-columns [flags]")
+		fmt.Fprintln(os.Stderr, "  Moves tickets from directories that are no longer configured columns into")
+		fmt.Fprintln(os.Stderr, "  the columns given with -map and creates missing column directories.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	mapping := make(map[string]string)
+	for _, m := range maps {
+		from, to, ok := strings.Cut(strings.TrimSpace(m), "=")
+		if !ok || from == "" || to == "" {
+			fmt.Fprintf(os.Stderr, "Invalid -map %q: want old=new\n", m)
+			os.Exit(1)
+		}
+		mapping[from] = to
+	}
+
+	plan, err := migrate.NewPlan(cfg, mapping, *orphans)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if plan.Empty() {
+		fmt.Println("Columns are in sync with config.yaml")
+		return
+	}
+
+	for _, r := range plan.Renames {
+		fmt.Printf("move   %s/ -> %s/ (%d tickets)\n", r.From, r.To, r.Tickets)
+	}
+	for _, dir := range plan.Creates {
+		fmt.Printf("create %s/\n", dir)
+	}
+	for _, o := range plan.Orphans {
+		if o.Match != "" {
+			fmt.Printf("skip   %s/ (%d tickets, looks like column %s; use -map %s=%s)\n", o.Dir, o.Tickets, o.Match, o.Dir, o.Match)
+			continue
+		}
+		fmt.Printf("skip   %s/ (%d tickets, no column given; use -map %s=<column> or -orphans)\n", o.Dir, o.Tickets, o.Dir)
+	}
+	if *dryRun {
+		return
+	}
+
+	if err := plan.Apply(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if len(plan.Orphans) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/printout"
+)
+
+// runPrint renders the board for printing, as plain text or as HTML to
+// print or save as PDF from a browser.
+func runPrint(args []string) {
+	fs := flag.NewFlagSet("print", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	format := fs.String("format", "text", "Output format: text or html")
+	output := fs.String("o", "", "Write to this file instead of stdout")
+	width := fs.Int("width", printout.DefaultWidth, "Line width of the text format")
+	pageLines := fs.Int("page-lines", 0, "Split the text format into pages of this many lines (e.g. 66), separated by form feeds")
+	columns := fs.String("columns", "", "Comma-separated columns to include, by dir or name (default: all)")
+	titlesOnly := fs.Bool("titles-only", false, "Leave out ticket content")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban print [flags]")
+		fmt.Fprintln(os.Stderr, "  Renders the board with columns as sections and every ticket's content.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() > 0 || (*format != "text" && *format != "html") {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	var only []string
+	for _, name := range strings.Split(*columns, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			only = append(only, name)
+		}
+	}
+	now := time.Now()
+	sections, err := printout.Read(cfg, only, now)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading board: %v\n", err)
+		os.Exit(1)
+	}
+	if len(sections) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no column matches %q\n", *columns)
+		os.Exit(1)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		if out, err = os.Create(*output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	w := bufio.NewWriter(out)
+
+	opts := printout.Options{
+		Title:      config.BoardName(cfg.KanbanDir),
+		Date:       now,
+		Width:      *width,
+		PageLines:  *pageLines,
+		TitlesOnly: *titlesOnly,
+	}
+	if *format == "html" {
+		err = printout.HTML(w, sections, opts)
+	} else {
+		err = printout.Text(w, sections, opts)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if *output != "" {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "Wrote %s\n", *output)
+	}
+}
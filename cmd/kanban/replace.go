@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/replace"
+)
+
+// runReplace replaces text in the titles and contents of every ticket,
+// showing each ticket's changes and asking before writing it.
+func runReplace(args []string) {
+	fs := flag.NewFlagSet("replace", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	useRegexp := fs.Bool("regexp", false, "Treat <find> as a regular expression ($1 in <replacement> refers to groups)")
+	ignoreCase := fs.Bool("i", false, "Match regardless of letter case")
+	dryRun := fs.Bool("dry-run", false, "Print the changes without writing them")
+	yes := fs.Bool("yes", false, "Write every change without asking")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban replace [flags] <find> <replacement>")
+		fmt.Fprintln(os.Stderr, "  Replaces text in ticket titles and contents, asking for each ticket:")
+		fmt.Fprintln(os.Stderr, "  y writes it, n skips it, a writes it and all remaining, q stops.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	r, err := replace.New(fs.Arg(0), fs.Arg(1), replace.Options{Regexp: *useRegexp, IgnoreCase: *ignoreCase})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	changes, err := r.Find(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if len(changes) == 0 {
+		fmt.Printf("No tickets contain %q\n", fs.Arg(0))
+		return
+	}
+
+	in := bufio.NewReader(os.Stdin)
+	all := *yes
+	written := 0
+	for i := range changes {
+		c := &changes[i]
+		rel, err := filepath.Rel(cfg.KanbanDir, c.Ticket.FilePath)
+		if err != nil {
+			rel = c.Ticket.FilePath
+		}
+		fmt.Printf("%s (%d/%d)\n", rel, i+1, len(changes))
+		printChangeLines(c.Lines)
+		if *dryRun {
+			continue
+		}
+
+		if !all {
+			fmt.Print("Replace in this ticket? [y/n/a/q] ")
+			answer, _ := in.ReadString('\n')
+			switch strings.ToLower(strings.TrimSpace(answer)) {
+			case "y", "yes":
+			case "a", "all":
+				all = true
+			case "q", "quit":
+				fmt.Printf("Replaced in %d tickets\n", written)
+				return
+			default:
+				continue
+			}
+		}
+		if err := c.Apply(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		written++
+	}
+	if !*dryRun {
+		fmt.Printf("Replaced in %d tickets\n", written)
+	}
+}
+
+// printChangeLines prints a ticket's changed lines as removed and added
+// lines.
+func printChangeLines(lines []replace.Line) {
+	for _, l := range lines {
+		label := "title"
+		if l.Number > 0 {
+			label = fmt.Sprintf("line %d", l.Number)
+		}
+		fmt.Printf("  %s:\n", label)
+		for _, s := range strings.Split(l.Before, "\n") {
+			fmt.Printf("  - %s\n", s)
+		}
+		for _, s := range strings.Split(l.After, "\n") {
+			fmt.Printf("  + %s\n", s)
+		}
+	}
+}
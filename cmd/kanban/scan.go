@@ -0,0 +1,155 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/scan"
+)
+
+// maxScanTitle caps the length of titles generated from comments.
+const maxScanTitle = 80
+
+// runScan imports TODO/FIXME/HACK comments from the project as tickets.
+// Comments imported before (matched by scan_id) only get their source
+// reference refreshed, so re-running the scan is safe.
+func runScan(args []string) {
+	fs := flag.NewFlagSet("scan", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	column := fs.String("column", "", "Column for new tickets (default: first column)")
+	dryRun := fs.Bool("dry-run", false, "Print what would change without writing tickets")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban scan [flags] [project dir]")
+		fmt.Fprintln(os.Stderr, "  Creates tickets for TODO/FIXME/HACK comments (project dir defaults to the board's parent).")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	col, ok := findColumn(cfg, *column)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown column: %s\n", *column)
+		os.Exit(1)
+	}
+
+	root := filepath.Dir(cfg.KanbanDir)
+	if fs.NArg() > 0 {
+		root = fs.Arg(0)
+	}
+	comments, err := scan.Dir(root)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error scanning %s: %v\n", root, err)
+		os.Exit(1)
+	}
+
+	// Full tickets, since updated ones are written back
+	tickets, err := loadBoardTickets(cfg, models.ParseTicket)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tickets: %v\n", err)
+		os.Exit(1)
+	}
+	byID := make(map[string]*models.Ticket)
+	for _, t := range tickets {
+		if t.ScanID != "" {
+			byID[t.ScanID] = t
+		}
+	}
+
+	var created, moved int
+	found := make(map[string]bool)
+	for _, c := range comments {
+		found[c.ID] = true
+		if existing, ok := byID[c.ID]; ok {
+			if existing.Source == c.Ref() {
+				continue
+			}
+			fmt.Printf("update  %s (%s -> %s)\n", existing.Title, existing.Source, c.Ref())
+			moved++
+			if !*dryRun {
+				existing.Source = c.Ref()
+				if err := existing.WriteFile(); err != nil {
+					fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", existing.FilePath, err)
+				}
+			}
+			continue
+		}
+
+		ticket := ticketFromComment(c, col.Dir)
+		if ticket.FilePath, err = ticket.UniqueFilePath(cfg.ColumnPath(col.Dir)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", ticket.Title, err)
+			continue
+		}
+		fmt.Printf("create  %s (%s)\n", ticket.Title, c.Ref())
+		created++
+		byID[c.ID] = ticket
+		if !*dryRun {
+			if err := ticket.Save(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", ticket.FilePath, err)
+			}
+		}
+	}
+
+	var gone int
+	for id := range byID {
+		if !found[id] {
+			gone++
+		}
+	}
+
+	fmt.Printf("%d comments: %d new, %d moved", len(comments), created, moved)
+	if gone > 0 {
+		fmt.Printf(", %d imported tickets no longer found in code", gone)
+	}
+	fmt.Println()
+}
+
+// ticketFromComment builds a new ticket for a marker comment.
+func ticketFromComment(c scan.Comment, column string) *models.Ticket {
+	text := c.Text
+	if text == "" {
+		text = "(no description)"
+	}
+	title := c.Kind + ": " + text
+	if runes := []rune(title); len(runes) > maxScanTitle {
+		title = string(runes[:maxScanTitle-3]) + "..."
+	}
+
+	ticket := models.NewTicket(title, column)
+	ticket.Tags = []string{strings.ToLower(c.Kind)}
+	ticket.Content = fmt.Sprintf("%s\n\nImported from a %s comment in `%s`.", text, c.Kind, c.File)
+	ticket.ContextFiles = []string{c.File}
+	ticket.Source = c.Ref()
+	ticket.ScanID = c.ID
+	return ticket
+}
+
+// loadBoardTickets parses every ticket in the board's columns with parse,
+// models.ParseTicket or models.ParseTicketHeader when bodies aren't needed.
+func loadBoardTickets(cfg *config.Config, parse func(string) (*models.Ticket, error)) ([]*models.Ticket, error) {
+	var tickets []*models.Ticket
+	for _, col := range cfg.Columns {
+		colPath := cfg.ColumnPath(col.Dir)
+		names, err := models.TicketNames(colPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			ticket, err := parse(filepath.Join(colPath, name))
+			if err != nil {
+				continue
+			}
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/server"
+)
+
+// runServe serves the board over HTTP.
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	addr := fs.String("addr", "127.0.0.1:8080", "Address to listen on")
+	token := fs.String("token", os.Getenv("KANBAN_TOKEN"), "Require this bearer token (default $KANBAN_TOKEN)")
+	user := fs.String("user", "kanban", "User name for basic auth")
+	password := fs.String("password", os.Getenv("KANBAN_PASSWORD"), "Require basic auth with this password (default $KANBAN_PASSWORD)")
+	tlsCert := fs.String("tls-cert", "", "TLS certificate file (serve HTTPS with -tls-key)")
+	tlsKey := fs.String("tls-key", "", "TLS private key file")
+	hosts := fs.String("hosts", "", "Comma-separated host names the board is reached by, besides localhost and IP addresses")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban serve [flags]")
+		fmt.Fprintln(os.Stderr, "  Serves a web board and a JSON API, with board changes pushed on /api/events.")
+		fmt.Fprintln(os.Stderr, "  Prefer $KANBAN_TOKEN and $KANBAN_PASSWORD to flags, which other users can see.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if (*tlsCert == "") != (*tlsKey == "") {
+		fmt.Fprintln(os.Stderr, "Error: -tls-cert and -tls-key must be given together")
+		os.Exit(2)
+	}
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	if err := requireBoard(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	srv := server.New(cfg)
+	srv.Auth = server.Auth{Token: *token, User: *user, Password: *password}
+	for _, host := range strings.Split(*hosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			srv.Hosts = append(srv.Hosts, host)
+		}
+	}
+	if host, _, err := net.SplitHostPort(*addr); err == nil && host != "" {
+		srv.Hosts = append(srv.Hosts, host)
+	}
+	if !isLoopback(*addr) {
+		if *token == "" && *password == "" {
+			fmt.Fprintln(os.Stderr, "Warning: serving beyond this machine without authentication; set -token or -password")
+		} else if *tlsCert == "" {
+			fmt.Fprintln(os.Stderr, "Warning: credentials are sent unencrypted; set -tls-cert and -tls-key")
+		}
+	}
+	go func() {
+		if err := srv.Watch(make(chan struct{})); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}()
+
+	if *tlsCert != "" {
+		fmt.Printf("Serving %s on https://%s\n", cfg.KanbanDir, *addr)
+		err = http.ListenAndServeTLS(*addr, *tlsCert, *tlsKey, srv.Handler())
+	} else {
+		fmt.Printf("Serving %s on http://%s\n", cfg.KanbanDir, *addr)
+		err = http.ListenAndServe(*addr, srv.Handler())
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// isLoopback reports whether addr only listens on this machine.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/retag"
+)
+
+// runTag runs the tag subcommands.
+func runTag(args []string) {
+	if len(args) == 0 || (args[0] != "rename" && args[0] != "merge") {
+		fmt.Fprintln(os.Stderr, "Usage: kanban tag rename [flags] <old> <new>")
+		os.Exit(2)
+	}
+	runTagRename(args[1:])
+}
+
+// runTagRename renames a tag in every ticket of the board, merging it into
+// the new tag where a ticket already has both.
+func runTagRename(args []string) {
+	fs := flag.NewFlagSet("tag rename", flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to config file (default: .kanban/config.yaml here or in the nearest parent directory)")
+	kanbanDir := fs.String("dir", "", "Kanban directory (overrides config)")
+	dryRun := fs.Bool("dry-run", false, "Print the tickets that would change without writing them")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: kanban tag rename [flags] <old> <new>")
+		fmt.Fprintln(os.Stderr, "  Renames a tag in every ticket; tickets that already have <new> keep it once,")
+		fmt.Fprintln(os.Stderr, "  so renaming also merges two tags.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := loadCLIConfig(*configPath, *kanbanDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	plan, err := retag.NewPlan(cfg, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if len(plan.Changes) == 0 {
+		fmt.Printf("No tickets are tagged %q\n", plan.From)
+		return
+	}
+
+	for _, c := range plan.Changes {
+		rel, err := filepath.Rel(cfg.KanbanDir, c.Ticket.FilePath)
+		if err != nil {
+			rel = c.Ticket.FilePath
+		}
+		fmt.Printf("%s: %s -> %s\n", rel, strings.Join(c.Before, ", "), strings.Join(c.After, ", "))
+	}
+	if *dryRun {
+		return
+	}
+
+	if err := plan.Apply(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Renamed tag %q to %q in %d tickets\n", plan.From, plan.To, len(plan.Changes))
+}
@@ -0,0 +1,103 @@
+// Package agent runs an external coding agent on a ticket prompt and keeps
+// the session transcripts.
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/platform"
+)
+
+// sessionTimeLayout names transcript files; it sorts chronologically.
+const sessionTimeLayout = "2006-01-02T15-04-05.000"
+
+// Session is a stored agent transcript.
+type Session struct {
+	// Path is the transcript file
+	Path string
+	// Started is when the agent was dispatched
+	Started time.Time
+	// Size is the transcript size in bytes
+	Size int64
+}
+
+// SessionsDir returns the directory holding a ticket's transcripts:
+// <kanbanDir>/sessions/<slug>.
+func SessionsDir(kanbanDir, slug string) string {
+	return filepath.Join(kanbanDir, "sessions", slug)
+}
+
+// NewSessionPath returns the transcript path for a session started at t.
+func NewSessionPath(kanbanDir, slug string, t time.Time) string {
+	return filepath.Join(SessionsDir(kanbanDir, slug), t.Format(sessionTimeLayout)+".log")
+}
+
+// ListSessions returns a ticket's transcripts, newest first.
+func ListSessions(kanbanDir, slug string) ([]Session, error) {
+	dir := SessionsDir(kanbanDir, slug)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sessions []Session
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".log" {
+			continue
+		}
+		started, err := time.ParseInLocation(sessionTimeLayout, strings.TrimSuffix(name, ".log"), time.Local)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		sessions = append(sessions, Session{Path: filepath.Join(dir, name), Started: started, Size: info.Size()})
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Started.After(sessions[j].Started) })
+	return sessions, nil
+}
+
+// Run runs command through the shell in dir with prompt on stdin, writing a
+// transcript to logPath: a header with the command and prompt, the agent's
+// combined output, and a footer with the exit status and duration. The
+// returned error is the agent's failure, if any.
+func Run(ctx context.Context, command, dir, prompt, logPath string) error {
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return err
+	}
+	log, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+
+	started := time.Now()
+	fmt.Fprintf(log, "# Command: %s\n# Directory: %s\n# Started: %s\n\n## Prompt\n\n%s\n\n## Output\n\n",
+		command, dir, started.Format(time.RFC3339), prompt)
+
+	cmd := platform.ShellCommand(ctx, command)
+	cmd.Dir = dir
+	cmd.Stdin = strings.NewReader(prompt)
+	cmd.Stdout = log
+	cmd.Stderr = log
+	runErr := cmd.Run()
+
+	status := "exit 0"
+	if runErr != nil {
+		status = runErr.Error()
+	}
+	fmt.Fprintf(log, "\n\n## Result\n\n%s after %s\n", status, time.Since(started).Round(time.Second))
+	return runErr
+}
@@ -0,0 +1,104 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunWritesTranscript(t *testing.T) {
+	kanbanDir := t.TempDir()
+	started := time.Date(2025, 1, 15, 10, 30, 0, 0, time.Local)
+	logPath := NewSessionPath(kanbanDir, "2025-01-15-fix-auth", started)
+
+	if err := Run(context.Background(), "cat; echo done >&2", kanbanDir, "Fix the auth bug", logPath); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"## Prompt\n\nFix the auth bug", "## Output\n\nFix the auth bugdone", "exit 0"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("transcript missing %q:\n%s", want, data)
+		}
+	}
+
+	if err := Run(context.Background(), "exit 3", kanbanDir, "", NewSessionPath(kanbanDir, "2025-01-15-fix-auth", started.Add(time.Hour))); err == nil {
+		t.Error("expected error for failing command")
+	}
+
+	sessions, err := ListSessions(kanbanDir, "2025-01-15-fix-auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 2 || !sessions[0].Started.Equal(started.Add(time.Hour)) {
+		t.Errorf("sessions = %+v, want 2 newest first", sessions)
+	}
+}
+
+func TestParseUsage(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		pattern string
+		want    Usage
+	}{
+		{
+			name:   "claude json",
+			output: `{"type":"result","total_cost_usd":0.0421,"usage":{"input_tokens":1200,"output_tokens":340}}`,
+			want:   Usage{InputTokens: 1200, OutputTokens: 340, Cost: 0.0421, Runs: 1},
+		},
+		{
+			name:   "running totals keep last",
+			output: "Tokens used: 1,000\nTokens used: 2,500\nTotal cost: $0.12.\n",
+			want:   Usage{Tokens: 2500, Cost: 0.12, Runs: 1},
+		},
+		{
+			name:    "custom pattern",
+			output:  "spent 42 tok for 0.5 USD",
+			pattern: `spent (?P<tokens>\d+) tok for (?P<cost>[\d.]+) USD`,
+			want:    Usage{Tokens: 42, Cost: 0.5, Runs: 1},
+		},
+		{
+			name:   "no usage",
+			output: "all done",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var re *regexp.Regexp
+			if tt.pattern != "" {
+				re = regexp.MustCompile(tt.pattern)
+			}
+			if got := ParseUsage(tt.output, re); got != tt.want {
+				t.Errorf("ParseUsage() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoardUsage(t *testing.T) {
+	kanbanDir := t.TempDir()
+	started := time.Date(2025, 1, 15, 10, 30, 0, 0, time.Local)
+	for i, slug := range []string{"a", "a", "b"} {
+		// The prompt mentions a cost that must not be counted
+		err := Run(context.Background(), `cat >/dev/null; echo "Total cost: \$0.25"`, kanbanDir,
+			"Budget: total cost $99", NewSessionPath(kanbanDir, slug, started.Add(time.Duration(i)*time.Minute)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	usage, err := BoardUsage(kanbanDir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage["a"].Cost != 0.5 || usage["a"].Runs != 2 || usage["b"].Cost != 0.25 {
+		t.Errorf("usage = %+v", usage)
+	}
+}
@@ -0,0 +1,159 @@
+package agent
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Usage is the token usage and cost reported by agent runs.
+type Usage struct {
+	InputTokens  int64
+	OutputTokens int64
+	// Tokens counts tokens reported only as a total
+	Tokens int64
+	// Cost is in US dollars
+	Cost float64
+	// Runs is the number of sessions that reported usage
+	Runs int
+}
+
+// TotalTokens returns all tokens used.
+func (u Usage) TotalTokens() int64 {
+	return u.InputTokens + u.OutputTokens + u.Tokens
+}
+
+// IsZero reports whether no usage was recorded.
+func (u Usage) IsZero() bool {
+	return u.TotalTokens() == 0 && u.Cost == 0
+}
+
+// Add returns the sum of two usages.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		InputTokens:  u.InputTokens + other.InputTokens,
+		OutputTokens: u.OutputTokens + other.OutputTokens,
+		Tokens:       u.Tokens + other.Tokens,
+		Cost:         u.Cost + other.Cost,
+		Runs:         u.Runs + other.Runs,
+	}
+}
+
+// Default usage patterns: the JSON emitted by `claude -p --output-format json`
+// and similar tools, and common plain-text summaries.
+var (
+	inputTokensRe  = regexp.MustCompile(`(?i)"?input_tokens"?\s*[:=]\s*([\d,]+)`)
+	outputTokensRe = regexp.MustCompile(`(?i)"?output_tokens"?\s*[:=]\s*([\d,]+)`)
+	totalTokensRe  = regexp.MustCompile(`(?i)(?:total tokens|tokens used)\s*[:=]?\s*([\d,]+)`)
+	costRe         = regexp.MustCompile(`(?i)(?:"total_cost_usd"|"cost_usd"|total cost)\s*[:=]?\s*\$?([\d.]+)`)
+)
+
+// ParseUsage extracts the usage reported in an agent's output. With a custom
+// pattern, its named groups "input", "output", "tokens" and "cost" are read;
+// otherwise the default patterns are tried. Agents often print running
+// totals, so the last match of each pattern wins.
+func ParseUsage(output string, pattern *regexp.Regexp) Usage {
+	var u Usage
+	if pattern != nil {
+		if m := lastMatch(pattern, output); m != nil {
+			for i, name := range pattern.SubexpNames() {
+				switch name {
+				case "input":
+					u.InputTokens = parseInt(m[i])
+				case "output":
+					u.OutputTokens = parseInt(m[i])
+				case "tokens":
+					u.Tokens = parseInt(m[i])
+				case "cost":
+					u.Cost, _ = strconv.ParseFloat(m[i], 64)
+				}
+			}
+		}
+	} else {
+		if m := lastMatch(inputTokensRe, output); m != nil {
+			u.InputTokens = parseInt(m[1])
+		}
+		if m := lastMatch(outputTokensRe, output); m != nil {
+			u.OutputTokens = parseInt(m[1])
+		}
+		if u.InputTokens+u.OutputTokens == 0 {
+			if m := lastMatch(totalTokensRe, output); m != nil {
+				u.Tokens = parseInt(m[1])
+			}
+		}
+		if m := lastMatch(costRe, output); m != nil {
+			u.Cost, _ = strconv.ParseFloat(strings.TrimRight(m[1], "."), 64)
+		}
+	}
+	if !u.IsZero() {
+		u.Runs = 1
+	}
+	return u
+}
+
+// SessionUsage parses the usage from the output section of a transcript.
+func SessionUsage(path string, pattern *regexp.Regexp) (Usage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Usage{}, err
+	}
+	return ParseUsage(transcriptOutput(string(data)), pattern), nil
+}
+
+// BoardUsage sums the usage of every stored transcript, keyed by ticket slug.
+func BoardUsage(kanbanDir string, pattern *regexp.Regexp) (map[string]Usage, error) {
+	root := filepath.Join(kanbanDir, "sessions")
+	dirs, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	usage := make(map[string]Usage)
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		sessions, err := ListSessions(kanbanDir, dir.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sessions {
+			u, err := SessionUsage(s.Path, pattern)
+			if err != nil || u.IsZero() {
+				continue
+			}
+			usage[dir.Name()] = usage[dir.Name()].Add(u)
+		}
+	}
+	return usage, nil
+}
+
+// transcriptOutput returns the agent output recorded in a transcript, so
+// usage-like text in the prompt is not counted.
+func transcriptOutput(transcript string) string {
+	if i := strings.Index(transcript, "\n## Output\n"); i >= 0 {
+		transcript = transcript[i:]
+	}
+	if i := strings.LastIndex(transcript, "\n## Result\n"); i >= 0 {
+		transcript = transcript[:i]
+	}
+	return transcript
+}
+
+func lastMatch(re *regexp.Regexp, s string) []string {
+	matches := re.FindAllStringSubmatch(s, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[len(matches)-1]
+}
+
+func parseInt(s string) int64 {
+	n, _ := strconv.ParseInt(strings.ReplaceAll(s, ",", ""), 10, 64)
+	return n
+}
@@ -1,22 +1,57 @@
 // Package config handles application configuration loading and management.
 package config
 
-// DefaultAgentInstructions is the content for the AGENT.md file created in the kanban directory.
-const DefaultAgentInstructions = `# Kanban Agent Instructions
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// AgentMdFile is the name of the agent instructions file in the kanban directory.
+const AgentMdFile = "AGENT.md"
+
+// agentInstructionsTemplate is the text/template AGENT.md is generated
+// from; it is rendered with an agentDoc built from the config.
+const agentInstructionsTemplate = `# Kanban Agent Instructions
 
 This directory contains a kanban board stored as markdown files. Each ticket is a markdown file with YAML frontmatter, organized into column directories.
 
+This file is generated from ` + "`config.yaml`" + `; regenerate it with ` + "`kanban agent-md`" + ` (or ` + "`I`" + ` on the board) after changing the configuration.
+
 ## Directory Structure
 
 ` + "```" + `
-.kanban/
+{{.Base}}/
 ├── AGENT.md        # This file
 ├── config.yaml     # Configuration (optional)
-├── todo/           # Tasks to be done
-├── doing/          # Tasks in progress
-└── done/           # Completed tasks
+{{- range $i, $c := .Columns}}
+{{if eq $i $.LastIndex}}└──{{else}}├──{{end}} {{printf "%-15s" (printf "%s/" $c.Dir)}} # {{$c.Label}}
+{{- end}}
 ` + "```" + `
 
+## Columns
+
+Columns in workflow order (the first is where new tickets go):
+
+| Directory | Column | Notes |
+|-----------|--------|-------|
+{{- range .Columns}}
+| ` + "`{{.Dir}}/`" + ` | {{.Label}} | {{.Notes}} |
+{{- end}}
+{{- if .Checklists}}
+
+Moving a ticket into a column with a checklist means its items are met; confirm them before moving the file.
+{{- end}}
+{{- if .MoveReasons}}
+
+These moves need a reason, recorded as ` + "`reason`" + ` on the new ` + "`column_history`" + ` entry:
+{{range .MoveReasons}}
+- {{.}}
+{{- end}}
+{{- end}}
+
 ## Ticket Format
 
 Tickets are markdown files with YAML frontmatter:
@@ -42,6 +77,29 @@ Task description and details in markdown format.
 | created | Yes | ISO 8601 timestamp when ticket was created |
 | updated | Yes | ISO 8601 timestamp when ticket was last modified |
 | agent_feedback | No | Brief summary of changes made (add when completing) |
+| color | No | Hex color (e.g. "#83a598") used to tint the ticket's card |
+| branch | No | Git branch created for the ticket |
+| commits | No | Hashes of commits referencing the ticket (filled in automatically; mention the ticket's filename without .md in commit messages) |
+| worktree | No | Git worktree created for the ticket; work there when set |
+| pr | No | Pull request for the ticket: URL, "owner/repo#123" or number (add when you open one) |
+| issue | No | URL of the GitHub issue created from the ticket |
+| source | No | Where an imported ticket came from: a code comment's file:line or a failing test (set by kanban scan/failures) |
+| scan_id | No | Hash identifying the imported comment or test; do not edit |
+| cover | No | Path to an image previewed in the ticket view |
+| context_files | No | Array of code paths (relative to project root) relevant to the task |
+| criteria | No | Array of acceptance criteria; also listed under an "## Acceptance Criteria" heading in the content. Check off (- [x]) content criteria you have met |
+| parent | No | Filename of the parent ticket this one was split from |
+| agent_instructions | No | Extra constraints for this ticket, appended to its prompts; follow them over the general guidelines |
+| priority | No | 1 is the most urgent; 0 or absent means unset |
+| due | No | Date the ticket is due, as YYYY-MM-DD |
+| snoozed_until | No | Date until which the ticket is hidden from the board, as YYYY-MM-DD |
+| private | No | Notes encrypted with the board key; do not edit |
+| reviewed_at | No | ISO 8601 timestamp when a human accepted the completed work (managed by the TUI) |
+| reviewed_by | No | Who approved the work in the review column (managed by the TUI) |
+| reopened_count | No | Number of times the ticket was reopened after completion (managed by the TUI) |
+| attempts | No | Number of agent runs dispatched for the ticket (managed by the TUI) |
+| moved_at | No | ISO 8601 timestamp when the ticket entered its current column (managed by the TUI) |
+| column_history | No | List of ` + "`{column, entered}`" + ` entries recording each column change, with any ` + "`skipped`" + ` entry checklist items and the move ` + "`reason`" + ` (managed by the TUI) |
 
 ### Filename Convention
 
@@ -57,10 +115,10 @@ Example: ` + "`2025-01-15-implement-user-auth.md`" + `
 
 Example:
 ` + "```" + `bash
-cat > .kanban/todo/$(date +%Y-%m-%d)-my-new-task.md << 'EOF'
+cat > {{.Base}}/{{.First.Dir}}/$(date +%Y-%m-%d)-my-new-task.md << 'EOF'
 ---
 title: "My new task"
-tags: ["feature"]
+tags: [{{.FirstTags}}]
 created: $(date -u +%Y-%m-%dT%H:%M:%SZ)
 updated: $(date -u +%Y-%m-%dT%H:%M:%SZ)
 ---
@@ -75,10 +133,10 @@ Move tickets between columns by moving the file:
 
 ` + "```" + `bash
 # Start working on a ticket
-mv .kanban/todo/2025-01-15-my-task.md .kanban/doing/
+mv {{.Base}}/{{.First.Dir}}/2025-01-15-my-task.md {{.Base}}/{{.Work.Dir}}/
 
 # Complete a ticket
-mv .kanban/doing/2025-01-15-my-task.md .kanban/done/
+mv {{.Base}}/{{.Work.Dir}}/2025-01-15-my-task.md {{.Base}}/{{.Done.Dir}}/
 ` + "```" + `
 
 ## Updating Tickets
@@ -89,13 +147,14 @@ When modifying a ticket:
 
 When completing a ticket:
 1. Add the ` + "`agent_feedback`" + ` field with a brief summary of changes made
-2. Move the ticket to the ` + "`done/`" + ` directory
+2. Move the ticket to the ` + "`{{.Done.Dir}}/`" + ` directory
+{{- if .Review}} ({{.Review.Label}}), where a human accepts or reopens it{{end}}
 
 ## Workflow
 
-1. **Start**: Move ticket from ` + "`todo/`" + ` to ` + "`doing/`" + `
+1. **Start**: Move ticket from ` + "`{{.First.Dir}}/`" + ` to ` + "`{{.Work.Dir}}/`" + `
 2. **Work**: Implement the task as described
-3. **Complete**: Add ` + "`agent_feedback`" + `, move to ` + "`done/`" + `
+3. **Complete**: Add ` + "`agent_feedback`" + `, move to ` + "`{{.Done.Dir}}/`" + `
 
 ## Configuration
 
@@ -103,8 +162,197 @@ The ` + "`config.yaml`" + ` file (if present) can customize:
 - ` + "`kanban_dir`" + `: Root directory for kanban data
 - ` + "`columns`" + `: Column names, directories, and colors
 - ` + "`editor`" + `: External editor command
-- ` + "`single_ticket_prompt`" + `: Template for single ticket AI prompts
-- ` + "`batch_ticket_prompt`" + `: Template for batch AI prompts
+- ` + "`single_ticket_prompt`" + `: Template for single ticket AI prompts{{if .CustomSingle}} (customized on this board){{end}}
+- ` + "`batch_ticket_prompt`" + `: Template for batch AI prompts{{if .CustomBatch}} (customized on this board){{end}}
+- ` + "`verify_prompt`" + `: Template for verifying a ticket against its criteria{{if .CustomVerify}} (customized on this board){{end}}
+
+Prompt templates use Go text/template syntax and can include the partials in ` + "`prompts/<name>.tmpl`" + ` by name. Single ticket and verify prompts can use:
+{{range .TicketVars}}
+- {{.}}
+{{- end}}
 
-Prompt templates use Go text/template syntax with variables like ` + "`{{.TicketPath}}`" + `, ` + "`{{.DoingPath}}`" + `, ` + "`{{.DonePath}}`" + `.
+Batch prompts can use:
+{{range .BatchVars}}
+- {{.}}
+{{- end}}
 `
+
+// agentColumn is a column as described in AGENT.md.
+type agentColumn struct {
+	Column
+	// Notes summarizes the column's settings
+	Notes string
+}
+
+// agentDoc is the data AGENT.md is rendered from.
+type agentDoc struct {
+	// Base is the kanban directory relative to the project root
+	Base      string
+	Columns   []agentColumn
+	LastIndex int
+	// First, Work and Done are where tickets start, are worked on and go
+	// when complete; Done is the review column when there is one
+	First, Work, Done agentColumn
+	Review            *agentColumn
+	FirstTags         string
+	Checklists        bool
+	MoveReasons       []string
+
+	CustomSingle, CustomBatch, CustomVerify bool
+	TicketVars, BatchVars                   []string
+}
+
+// ticketPromptVars and batchPromptVars describe the variables available to
+// prompt templates.
+var (
+	ticketPromptVars = []string{
+		"`{{.Title}}`, `{{.Tags}}`, `{{.Content}}`: the ticket's title, comma-separated tags and content",
+		"`{{.TicketPath}}`: the ticket file, relative to the project root",
+		"`{{.DoingPath}}`, `{{.DonePath}}`: where the ticket file goes when started and when complete",
+		"`{{.AgentMdPath}}`: this file",
+		"`{{.ContextFiles}}`: the ticket's context files, each with `.Path` and `.Content` (set with inline_context_files)",
+		"`{{.Criteria}}`: the ticket's acceptance criteria, each with `.Text` and `.Done`",
+		"`{{.AgentInstructions}}`: the ticket's agent_instructions (also appended to every prompt)",
+	}
+	batchPromptVars = []string{
+		"`{{.Tickets}}`: the tickets, each with the single ticket variables",
+		"`{{.AgentMdPath}}`: this file",
+		"`{{.Part}}`, `{{.Parts}}`: the chunk number and count when batch_chunk_size splits the batch",
+	}
+)
+
+// AgentInstructions renders the AGENT.md content for the config's columns,
+// move reasons and prompts.
+func (c *Config) AgentInstructions() (string, error) {
+	tmpl, err := template.New("agent").Parse(agentInstructionsTemplate)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, c.agentDoc()); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// agentDoc collects the data for AgentInstructions.
+func (c *Config) agentDoc() agentDoc {
+	columns := c.Columns
+	if len(columns) == 0 {
+		columns = DefaultConfig().Columns
+	}
+
+	reviewDir := c.ReviewColumn
+	if reviewDir == "" {
+		reviewDir = "review"
+	}
+	doc := agentDoc{
+		Base:         c.relKanbanDir(),
+		LastIndex:    len(columns) - 1,
+		CustomSingle: c.SingleTicketPrompt != "" && c.SingleTicketPrompt != DefaultSingleTicketPrompt,
+		CustomBatch:  c.BatchTicketPrompt != "" && c.BatchTicketPrompt != DefaultBatchTicketPrompt,
+		CustomVerify: c.VerifyPrompt != "" && c.VerifyPrompt != DefaultVerifyPrompt,
+		TicketVars:   ticketPromptVars,
+		BatchVars:    batchPromptVars,
+	}
+	doneIndex := len(columns) - 1
+	if len(c.Columns) > 0 {
+		doneIndex = c.DoneColumnIndex()
+	}
+	for i, col := range columns {
+		ac := agentColumn{Column: col, Notes: columnNotes(col)}
+		doc.Columns = append(doc.Columns, ac)
+		doc.Checklists = doc.Checklists || len(col.Checklist) > 0
+		// As in the TUI, the review column comes before the done column.
+		if col.Dir == reviewDir && i < doneIndex && doc.Review == nil {
+			doc.Review = &doc.Columns[i]
+		}
+	}
+
+	doc.First = doc.Columns[0]
+	doc.Work = doc.Columns[min(1, len(doc.Columns)-1)]
+	doc.Done = doc.Columns[doneIndex]
+	if doc.Review != nil {
+		doc.Done = *doc.Review
+	}
+
+	tags := []string{`"feature"`}
+	if len(doc.First.DefaultTags) > 0 {
+		tags = tags[:0]
+		for _, tag := range doc.First.DefaultTags {
+			tags = append(tags, fmt.Sprintf("%q", tag))
+		}
+	}
+	doc.FirstTags = strings.Join(tags, ", ")
+
+	for _, t := range c.MoveReasons {
+		doc.MoveReasons = append(doc.MoveReasons, transitionSide(t.From)+" to "+transitionSide(t.To))
+	}
+	return doc
+}
+
+// AgentWorkColumn returns the directory of the column AGENT.md tells agents
+// to move a ticket to when they start it.
+func (c *Config) AgentWorkColumn() string {
+	return c.agentDoc().Work.Dir
+}
+
+// AgentDoneColumn returns the directory of the column AGENT.md tells agents
+// to move a finished ticket to: the review column, else the done column.
+func (c *Config) AgentDoneColumn() string {
+	return c.agentDoc().Done.Dir
+}
+
+// relKanbanDir returns the kanban directory relative to the project root,
+// the directory kanban runs in, e.g. "docs/board" for kanban_dir:
+// docs/board. A kanban directory outside it, such as a .kanban found in a
+// parent directory, is named by its base name.
+func (c *Config) relKanbanDir() string {
+	dir := c.KanbanDir
+	if filepath.IsAbs(dir) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return filepath.Base(dir)
+		}
+		rel, err := filepath.Rel(wd, dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.Base(dir)
+		}
+		dir = rel
+	}
+	return filepath.ToSlash(filepath.Clean(dir))
+}
+
+// columnNotes summarizes a column's WIP limit, checklist and defaults.
+func columnNotes(col Column) string {
+	var notes []string
+	if col.WIPLimit > 0 {
+		notes = append(notes, fmt.Sprintf("at most %d tickets", col.WIPLimit))
+	}
+	if len(col.Checklist) > 0 {
+		notes = append(notes, "checklist: "+strings.Join(col.Checklist, "; "))
+	}
+	if len(col.DefaultTags) > 0 {
+		notes = append(notes, "new tickets tagged "+strings.Join(col.DefaultTags, ", "))
+	}
+	return strings.Join(notes, "; ")
+}
+
+// transitionSide names one side of a move_reasons transition.
+func transitionSide(dir string) string {
+	if dir == "" || dir == "*" {
+		return "any column"
+	}
+	return "`" + dir + "/`"
+}
+
+// WriteAgentInstructions (re)generates AGENT.md in the kanban directory and
+// returns its path.
+func (c *Config) WriteAgentInstructions() (string, error) {
+	text, err := c.AgentInstructions()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(c.KanbanDir, AgentMdFile)
+	return path, os.WriteFile(path, []byte(text), 0644)
+}
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// boardDirName is the directory name that marks a kanban board.
+const boardDirName = ".kanban"
+
+// maxBoardScanDepth limits how deep DiscoverBoards descends into each root.
+const maxBoardScanDepth = 3
+
+// LoadBoard loads the configuration of the board stored in kanbanDir, using
+// its config.yaml when present. The returned config always points at kanbanDir.
+func LoadBoard(kanbanDir string) (*Config, error) {
+	absDir, err := filepath.Abs(kanbanDir)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgPath := filepath.Join(absDir, "config.yaml")
+	cfg := DefaultConfig()
+	if Exists(cfgPath) {
+		if cfg, err = Load(cfgPath); err != nil {
+			return nil, err
+		}
+	}
+	cfg.KanbanDir = absDir
+	cfg.Path = cfgPath
+	return cfg, nil
+}
+
+// BoardName names the board in kanbanDir after its project directory.
+func BoardName(kanbanDir string) string {
+	abs, err := filepath.Abs(kanbanDir)
+	if err != nil {
+		abs = kanbanDir
+	}
+	return filepath.Base(filepath.Dir(abs))
+}
+
+// FindBoard walks up from dir, like git looks for .git, and returns the
+// nearest ".kanban" directory, or "" if no ancestor has one.
+func FindBoard(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		candidate := filepath.Join(dir, boardDirName)
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// DiscoverBoards finds kanban boards (".kanban" directories) under each root,
+// descending at most a few levels and skipping hidden and vendored directories.
+func DiscoverBoards(roots []string) []string {
+	seen := make(map[string]bool)
+	var boards []string
+
+	for _, root := range roots {
+		root = ExpandHome(root)
+		absRoot, err := filepath.Abs(root)
+		if err != nil {
+			continue
+		}
+		baseDepth := strings.Count(absRoot, string(filepath.Separator))
+
+		filepath.WalkDir(absRoot, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || !d.IsDir() {
+				return nil
+			}
+			if d.Name() == boardDirName {
+				if !seen[path] {
+					seen[path] = true
+					boards = append(boards, path)
+				}
+				return filepath.SkipDir
+			}
+			if path != absRoot && (strings.HasPrefix(d.Name(), ".") || d.Name() == "node_modules" || d.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			if strings.Count(path, string(filepath.Separator))-baseDepth >= maxBoardScanDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		})
+	}
+
+	sort.Strings(boards)
+	return boards
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindBoard(t *testing.T) {
+	root := t.TempDir()
+	board := filepath.Join(root, "project", ".kanban")
+	deep := filepath.Join(root, "project", "src", "pkg")
+	for _, dir := range []string{board, deep} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, start := range []string{filepath.Join(root, "project"), deep} {
+		if got := FindBoard(start); got != board {
+			t.Errorf("FindBoard(%q) = %q, want %q", start, got, board)
+		}
+	}
+	if got := FindBoard(root); got != "" && filepath.Dir(got) == root {
+		t.Errorf("FindBoard(%q) = %q, want no board below root", root, got)
+	}
+}
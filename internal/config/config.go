@@ -4,7 +4,10 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
+	"github.com/user/kanban-tui/internal/platform"
 	"gopkg.in/yaml.v3"
 )
 
@@ -13,22 +16,187 @@ type Column struct {
 	Name  string `yaml:"name"`
 	Dir   string `yaml:"dir"`
 	Color string `yaml:"color,omitempty"`
+	// Icon is an optional emoji or symbol shown before the column name
+	Icon string `yaml:"icon,omitempty"`
+	// Checklist lists items to confirm when a ticket is moved into the
+	// column (e.g. a definition of done)
+	Checklist []string `yaml:"checklist,omitempty"`
+	// WIPLimit is the number of tickets the column should hold at most
+	// (0 for no limit); it is only advisory
+	WIPLimit int `yaml:"wip_limit,omitempty"`
+	// FocusHide collapses the column in focus mode
+	FocusHide bool `yaml:"focus_hide,omitempty"`
+	// DefaultTags are given to tickets created directly in the column
+	DefaultTags []string `yaml:"default_tags,omitempty"`
+	// Skeleton is the starting content of tickets created directly in the
+	// column (e.g. steps to reproduce for a bugs column)
+	Skeleton string `yaml:"skeleton,omitempty"`
+}
+
+// Label returns the column name prefixed with its icon, if any.
+func (c Column) Label() string {
+	if c.Icon == "" {
+		return c.Name
+	}
+	return c.Icon + " " + c.Name
+}
+
+// WithDefaults returns tags followed by the column's default tags it lacks,
+// and content, or the column's skeleton when content is empty.
+func (c Column) WithDefaults(tags []string, content string) ([]string, string) {
+	for _, tag := range c.DefaultTags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if strings.TrimSpace(content) == "" {
+		content = strings.TrimSpace(c.Skeleton)
+	}
+	return tags, content
+}
+
+// Transition is a move between two columns, by directory; "*" or an empty
+// side matches any column.
+type Transition struct {
+	From string `yaml:"from,omitempty"`
+	To   string `yaml:"to,omitempty"`
+}
+
+// Matches reports whether moving from one column to another is t.
+func (t Transition) Matches(from, to string) bool {
+	return (t.From == "" || t.From == "*" || t.From == from) &&
+		(t.To == "" || t.To == "*" || t.To == to)
+}
+
+// Hooks are shell commands run after ticket lifecycle events. Each gets
+// the ticket as JSON on stdin and in KANBAN_* environment variables.
+type Hooks struct {
+	// OnCreate runs after a ticket is created
+	OnCreate string `yaml:"on_create,omitempty"`
+	// OnMove runs after a ticket moves to another column
+	OnMove string `yaml:"on_move,omitempty"`
+	// OnDelete runs after a ticket is deleted or moved to the trash
+	OnDelete string `yaml:"on_delete,omitempty"`
+	// OnDone runs after a ticket moves into the done column (the last one
+	// that is not failed_column), after OnMove
+	OnDone string `yaml:"on_done,omitempty"`
 }
 
 // Config holds the application configuration.
 type Config struct {
+	// Path is the file the configuration was loaded from, watched for
+	// live reloads; it is not part of the YAML
+	Path string `yaml:"-"`
 	// KanbanDir is the root directory for kanban data
 	KanbanDir string `yaml:"kanban_dir"`
 	// Columns defines the kanban columns
 	Columns []Column `yaml:"columns"`
-	// Editor is the external editor command (defaults to $EDITOR)
+	// Editor is the external editor command (defaults to $VISUAL, $EDITOR,
+	// then a platform default such as nano or notepad)
 	Editor string `yaml:"editor,omitempty"`
+	// LeaderKey starts multi-key chords on the board (default ",")
+	LeaderKey string `yaml:"leader_key,omitempty"`
 	// SingleTicketPrompt is the template for copying a single ticket's agent prompt
 	SingleTicketPrompt string `yaml:"single_ticket_prompt,omitempty"`
 	// BatchTicketPrompt is the template for copying all todo tickets' agent prompt
 	BatchTicketPrompt string `yaml:"batch_ticket_prompt,omitempty"`
+	// VerifyPrompt is the template asking an agent to check a ticket against
+	// its acceptance criteria
+	VerifyPrompt string `yaml:"verify_prompt,omitempty"`
+	// InlineContextFiles embeds the contents of a ticket's context_files in prompts
+	// instead of only referencing them with @path
+	InlineContextFiles bool `yaml:"inline_context_files,omitempty"`
+	// CharsPerToken is the heuristic used to estimate prompt token counts
+	CharsPerToken float64 `yaml:"chars_per_token,omitempty"`
+	// PromptTokenWarning is the estimated token count above which copied prompts trigger a warning
+	PromptTokenWarning int `yaml:"prompt_token_warning,omitempty"`
+	// BatchChunkSize splits batch prompts into chunks of this many tickets (0 disables chunking)
+	BatchChunkSize int `yaml:"batch_chunk_size,omitempty"`
+	// BatchChunkFiles writes chunked batch prompts to numbered files instead of
+	// copying them to the clipboard one at a time
+	BatchChunkFiles bool `yaml:"batch_chunk_files,omitempty"`
+	// ReopenColumn is the column directory reopened tickets move to (defaults to the first column)
+	ReopenColumn string `yaml:"reopen_column,omitempty"`
+	// ReviewColumn is the column directory where tickets await human review
+	// (defaults to a column with dir "review", if any)
+	ReviewColumn string `yaml:"review_column,omitempty"`
+	// Theme is the color theme: "dark" (default) or "light"
+	Theme string `yaml:"theme,omitempty"`
+	// DateFormat is the Go time layout used to display dates (default "Jan 02")
+	DateFormat string `yaml:"date_format,omitempty"`
+	// RelativeDates displays dates relative to now ("2h ago") instead of DateFormat
+	RelativeDates bool `yaml:"relative_dates,omitempty"`
+	// SortBy orders tickets within a column: "updated" (default), "created" or "title"
+	SortBy string `yaml:"sort_by,omitempty"`
+	// Locale is a BCP 47 tag (e.g. "de", "sv") controlling title collation
+	Locale string `yaml:"locale,omitempty"`
+	// TagColors maps tags to chip colors: a hex color ("#fb4934") or a
+	// palette name ("red"). Keys ending in "/" or "*" match every tag with
+	// that prefix, e.g. "prio/" or "team-*"
+	TagColors map[string]string `yaml:"tag_colors,omitempty"`
+	// HideOldestAge hides the age of each column's oldest ticket in the
+	// column header
+	HideOldestAge bool `yaml:"hide_oldest_age,omitempty"`
+	// DisableAgentMd skips creating AGENT.md in the kanban directory
+	DisableAgentMd bool `yaml:"disable_agent_md,omitempty"`
+	// BoardRoots are directories scanned for other boards in the board picker
+	BoardRoots []string `yaml:"board_roots,omitempty"`
+	// WorkspaceBoards are the kanban directories aggregated in workspace mode
+	// (defaults to the recent boards)
+	WorkspaceBoards []string `yaml:"workspace_boards,omitempty"`
+	// ImagePreviews renders a ticket's cover image in the ticket view
+	ImagePreviews bool `yaml:"image_previews,omitempty"`
+	// SpellCheck lists misspelled words with suggestions in the editor
+	SpellCheck bool `yaml:"spell_check,omitempty"`
+	// SpellDictionary is the word list used for spell checking
+	// (defaults to /usr/share/dict/words)
+	SpellDictionary string `yaml:"spell_dictionary,omitempty"`
+	// GithubRepo is the "owner/repo" bare PR numbers refer to (defaults to
+	// the origin remote of the project's git repository)
+	GithubRepo string `yaml:"github_repo,omitempty"`
+	// BranchPattern is the text/template for branches created from tickets
+	// (default "feat/{{.Slug}}")
+	BranchPattern string `yaml:"branch_pattern,omitempty"`
+	// GitIntegration links recent commits whose messages mention a ticket's
+	// slug to that ticket
+	GitIntegration bool `yaml:"git_integration,omitempty"`
+	// WorktreeDir is where per-ticket git worktrees are created (defaults to
+	// <project>-worktrees next to the project)
+	WorktreeDir string `yaml:"worktree_dir,omitempty"`
+	// AgentCommand is the shell command tickets are dispatched to; it receives
+	// the single ticket prompt on stdin (e.g. "claude -p")
+	AgentCommand string `yaml:"agent_command,omitempty"`
+	// AgentUsagePattern is a regexp with named groups input, output, tokens
+	// and cost that reads usage from agent output (defaults to common formats)
+	AgentUsagePattern string `yaml:"agent_usage_pattern,omitempty"`
+	// AgentConcurrency is the number of queued tickets dispatched to the agent
+	// command at once (default 2)
+	AgentConcurrency int `yaml:"agent_concurrency,omitempty"`
+	// FailedColumn is the column directory tickets move to when their agent
+	// run fails (defaults to the first column); they are also tagged "failed"
+	FailedColumn string `yaml:"failed_column,omitempty"`
+	// Hooks run shell commands after tickets are created, moved or deleted
+	Hooks Hooks `yaml:"hooks,omitempty"`
+	// MoveReasons lists the column transitions that ask why a ticket is
+	// moved (e.g. doing back to todo); the reason is kept in its history
+	MoveReasons []Transition `yaml:"move_reasons,omitempty"`
 }
 
+// DefaultDateFormat is the default Go time layout for displayed dates.
+const DefaultDateFormat = "Jan 02"
+
+// DefaultBranchPattern names branches created from tickets.
+const DefaultBranchPattern = "feat/{{.Slug}}"
+
+// DefaultAgentConcurrency is the default number of parallel agent runs.
+const DefaultAgentConcurrency = 2
+
+// Default prompt token estimation settings.
+const (
+	DefaultCharsPerToken      = 4.0
+	DefaultPromptTokenWarning = 100000
+)
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	// Use current working directory by default
@@ -42,25 +210,33 @@ func DefaultConfig() *Config {
 			{Name: "Doing", Dir: "doing", Color: "#fbbf24"},
 			{Name: "Done", Dir: "done", Color: "#4ade80"},
 		},
-		Editor:             os.Getenv("EDITOR"),
+		Editor:             platform.DefaultEditor(),
 		SingleTicketPrompt: DefaultSingleTicketPrompt,
 		BatchTicketPrompt:  DefaultBatchTicketPrompt,
+		VerifyPrompt:       DefaultVerifyPrompt,
+		CharsPerToken:      DefaultCharsPerToken,
+		PromptTokenWarning: DefaultPromptTokenWarning,
+		DateFormat:         DefaultDateFormat,
+		BranchPattern:      DefaultBranchPattern,
+		AgentConcurrency:   DefaultAgentConcurrency,
 	}
 }
 
+// Exists reports whether a config file exists at path.
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
 // Load reads configuration from a YAML file.
-// If the file doesn't exist, it creates a default configuration file.
+// If the file doesn't exist, the defaults are returned; nothing is written.
 func Load(path string) (*Config, error) {
 	cfg := DefaultConfig()
+	cfg.Path = path
 
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			// Create config file with defaults on first run
-			if saveErr := cfg.Save(path); saveErr != nil {
-				// Non-fatal: continue with defaults if we can't save
-				return cfg, nil
-			}
 			return cfg, nil
 		}
 		return nil, err
@@ -69,6 +245,8 @@ func Load(path string) (*Config, error) {
 	if err := yaml.Unmarshal(data, cfg); err != nil {
 		return nil, err
 	}
+	cfg.Path = path
+	cfg.expandPaths()
 
 	// Apply defaults for missing values
 	if cfg.KanbanDir == "" {
@@ -78,7 +256,7 @@ func Load(path string) (*Config, error) {
 		cfg.Columns = DefaultConfig().Columns
 	}
 	if cfg.Editor == "" {
-		cfg.Editor = os.Getenv("EDITOR")
+		cfg.Editor = platform.DefaultEditor()
 	}
 	if cfg.SingleTicketPrompt == "" {
 		cfg.SingleTicketPrompt = DefaultSingleTicketPrompt
@@ -86,6 +264,24 @@ func Load(path string) (*Config, error) {
 	if cfg.BatchTicketPrompt == "" {
 		cfg.BatchTicketPrompt = DefaultBatchTicketPrompt
 	}
+	if cfg.VerifyPrompt == "" {
+		cfg.VerifyPrompt = DefaultVerifyPrompt
+	}
+	if cfg.CharsPerToken <= 0 {
+		cfg.CharsPerToken = DefaultCharsPerToken
+	}
+	if cfg.PromptTokenWarning <= 0 {
+		cfg.PromptTokenWarning = DefaultPromptTokenWarning
+	}
+	if cfg.DateFormat == "" {
+		cfg.DateFormat = DefaultDateFormat
+	}
+	if cfg.BranchPattern == "" {
+		cfg.BranchPattern = DefaultBranchPattern
+	}
+	if cfg.AgentConcurrency <= 0 {
+		cfg.AgentConcurrency = DefaultAgentConcurrency
+	}
 
 	return cfg, nil
 }
@@ -112,9 +308,9 @@ func (c *Config) EnsureDirectories() error {
 	}
 
 	// Create AGENT.md if it doesn't exist
-	agentMdPath := filepath.Join(c.KanbanDir, "AGENT.md")
-	if _, err := os.Stat(agentMdPath); os.IsNotExist(err) {
-		if err := os.WriteFile(agentMdPath, []byte(DefaultAgentInstructions), 0644); err != nil {
+	agentMdPath := filepath.Join(c.KanbanDir, AgentMdFile)
+	if _, err := os.Stat(agentMdPath); os.IsNotExist(err) && !c.DisableAgentMd {
+		if _, err := c.WriteAgentInstructions(); err != nil {
 			return err
 		}
 	}
@@ -129,6 +325,95 @@ func (c *Config) EnsureDirectories() error {
 	return nil
 }
 
+// PromptsDir returns the directory where chunked batch prompts are written
+// and prompt partials (*.tmpl) are read from.
+func (c *Config) PromptsDir() string {
+	return filepath.Join(c.KanbanDir, PromptsDirName)
+}
+
+// PromptsDirName is the name of the prompts directory in a kanban dir.
+const PromptsDirName = "prompts"
+
+// ReservedDirs are the directories of a kanban dir that hold board data
+// rather than a column: agent sessions, prompts, ticket templates and
+// plugins.
+var ReservedDirs = []string{"sessions", PromptsDirName, "templates", "plugins"}
+
+// IsReservedDir reports whether a directory in a kanban dir never holds a
+// column: one of ReservedDirs or a hidden directory such as .trash or
+// .drafts.
+func IsReservedDir(name string) bool {
+	return strings.HasPrefix(name, ".") || slices.Contains(ReservedDirs, name)
+}
+
+// FocusHidden reports whether column i is collapsed in focus mode. Unless
+// some column sets focus_hide, those are a "backlog" column and the done
+// column, where finished tickets collect.
+func (c *Config) FocusHidden(i int) bool {
+	for _, col := range c.Columns {
+		if col.FocusHide {
+			return c.Columns[i].FocusHide
+		}
+	}
+	return c.Columns[i].Dir == "backlog" || i == c.DoneColumnIndex()
+}
+
+// TagColor returns the configured color of tag: an exact entry, else the
+// longest matching prefix entry, else "".
+func (c *Config) TagColor(tag string) string {
+	if color, ok := c.TagColors[tag]; ok {
+		return color
+	}
+	color, longest := "", -1
+	for key, value := range c.TagColors {
+		prefix := strings.TrimSuffix(key, "*")
+		if prefix == key && !strings.HasSuffix(key, "/") {
+			continue
+		}
+		if strings.HasPrefix(tag, prefix) && len(prefix) > longest {
+			color, longest = value, len(prefix)
+		}
+	}
+	return color
+}
+
+// ReasonRequired reports whether moving a ticket between the columns from
+// and to asks for a reason.
+func (c *Config) ReasonRequired(from, to string) bool {
+	for _, t := range c.MoveReasons {
+		if t.Matches(from, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// DoneColumn returns the directory of the column finished tickets move to:
+// the last column that is not the failed column.
+func (c *Config) DoneColumn() string {
+	return c.Columns[c.DoneColumnIndex()].Dir
+}
+
+// DoneColumnIndex returns the index of the done column in Columns.
+func (c *Config) DoneColumnIndex() int {
+	for i := len(c.Columns) - 1; i > 0; i-- {
+		if c.Columns[i].Dir != c.FailedColumn {
+			return i
+		}
+	}
+	return len(c.Columns) - 1
+}
+
+// ColumnByDir returns the column whose directory is dir.
+func (c *Config) ColumnByDir(dir string) (Column, bool) {
+	for _, col := range c.Columns {
+		if col.Dir == dir {
+			return col, true
+		}
+	}
+	return Column{}, false
+}
+
 // ColumnPath returns the full path for a column directory.
 func (c *Config) ColumnPath(colDir string) string {
 	return filepath.Join(c.KanbanDir, colDir)
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTagColor(t *testing.T) {
+	cfg := &Config{TagColors: map[string]string{
+		"bug":       "red",
+		"prio/":     "#fabd2f",
+		"prio/high": "#fb4934",
+		"team-*":    "blue",
+	}}
+	tests := map[string]string{
+		"bug":       "red",
+		"bugs":      "",
+		"prio/low":  "#fabd2f",
+		"prio/high": "#fb4934",
+		"prio":      "",
+		"team-web":  "blue",
+		"docs":      "",
+	}
+	for tag, want := range tests {
+		if got := cfg.TagColor(tag); got != want {
+			t.Errorf("TagColor(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
+
+func TestColumnWithDefaults(t *testing.T) {
+	col := Column{DefaultTags: []string{"bug", "triage"}, Skeleton: "## Steps to reproduce\n"}
+
+	tags, content := col.WithDefaults([]string{"ui", "bug"}, "")
+	if !reflect.DeepEqual(tags, []string{"ui", "bug", "triage"}) || content != "## Steps to reproduce" {
+		t.Errorf("WithDefaults = %q, %q", tags, content)
+	}
+	if _, content := col.WithDefaults(nil, "Crashes on save"); content != "Crashes on save" {
+		t.Errorf("WithDefaults replaced content with %q", content)
+	}
+	if tags, content := (Column{}).WithDefaults(nil, ""); tags != nil || content != "" {
+		t.Errorf("WithDefaults without defaults = %q, %q", tags, content)
+	}
+}
+
+func TestReasonRequired(t *testing.T) {
+	cfg := &Config{MoveReasons: []Transition{{From: "doing", To: "todo"}, {To: "backlog"}}}
+	for _, tt := range []struct {
+		from, to string
+		want     bool
+	}{
+		{"doing", "todo", true},
+		{"todo", "doing", false},
+		{"review", "backlog", true},
+		{"review", "todo", false},
+	} {
+		if got := cfg.ReasonRequired(tt.from, tt.to); got != tt.want {
+			t.Errorf("ReasonRequired(%s, %s) = %v", tt.from, tt.to, got)
+		}
+	}
+}
+
+func TestDoneColumn(t *testing.T) {
+	cfg := &Config{Columns: []Column{{Dir: "todo"}, {Dir: "done"}, {Dir: "failed"}}}
+	if got := cfg.DoneColumn(); got != "failed" {
+		t.Errorf("DoneColumn without failed_column = %q", got)
+	}
+	cfg.FailedColumn = "failed"
+	if got := cfg.DoneColumn(); got != "done" {
+		t.Errorf("DoneColumn = %q, want done", got)
+	}
+	if !cfg.FocusHidden(1) || cfg.FocusHidden(2) {
+		t.Errorf("FocusHidden should collapse done, not the trailing failed column")
+	}
+}
+
+func TestAgentInstructions(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	cfg.Columns = []Column{
+		{Name: "Backlog", Dir: "backlog", DefaultTags: []string{"triage"}},
+		{Name: "Doing", Dir: "doing", WIPLimit: 3},
+		{Name: "Review", Dir: "review", Checklist: []string{"tests pass"}},
+		{Name: "Done", Dir: "done"},
+	}
+	cfg.MoveReasons = []Transition{{From: "doing", To: "backlog"}}
+	cfg.BatchTicketPrompt = "Do {{.Tickets}}"
+
+	path, err := cfg.WriteAgentInstructions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := string(data)
+	for _, want := range []string{
+		"├── backlog/        # Backlog",
+		"└── done/           # Done",
+		"| `doing/` | Doing | at most 3 tickets |",
+		"checklist: tests pass",
+		"- `doing/` to `backlog/`",
+		`tags: ["triage"]`,
+		"move to `review/`",
+		"Template for batch AI prompts (customized on this board)",
+		"`{{.TicketPath}}`",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("AGENT.md lacks %q", want)
+		}
+	}
+	if strings.Contains(text, "single ticket AI prompts (customized") {
+		t.Error("default single ticket prompt reported as customized")
+	}
+
+	// A nested kanban dir keeps its path from the project root
+	cfg.KanbanDir = filepath.Join("docs", "board")
+	if text, err = cfg.AgentInstructions(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "mv docs/board/backlog/") {
+		t.Errorf("AGENT.md does not use docs/board:\n%s", text)
+	}
+}
+
+func TestLoadSMTP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if _, err := loadSMTP(path); !errors.Is(err, ErrNoSMTP) {
+		t.Errorf("missing config: err = %v, want ErrNoSMTP", err)
+	}
+
+	data := "smtp:\n  host: mail.example.com\n  from: me@example.com\n  to: [team@example.com]\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(SMTPPasswordEnv, "hunter2")
+	s, err := loadSMTP(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Addr() != "mail.example.com:587" || s.Password != "hunter2" || !reflect.DeepEqual(s.To, []string{"team@example.com"}) {
+		t.Errorf("loadSMTP = %+v", s)
+	}
+}
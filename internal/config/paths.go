@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExpandHome replaces a leading "~" with the user's home directory.
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// ExpandPath expands $VAR and ${VAR} environment variables, then a leading
+// "~", so configs can be shared across machines.
+func ExpandPath(path string) string {
+	return ExpandHome(os.ExpandEnv(path))
+}
+
+// UserConfigDir returns the directory for user-wide configuration:
+// $XDG_CONFIG_HOME/kanban-tui, falling back to ~/.config/kanban-tui.
+func UserConfigDir() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			home = "."
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "kanban-tui")
+}
+
+// UserConfigPath returns the user-wide config file, used when the current
+// directory has no board config.
+func UserConfigPath() string {
+	return filepath.Join(UserConfigDir(), "config.yaml")
+}
+
+// expandPaths expands environment variables and "~" in the path settings.
+func (c *Config) expandPaths() {
+	c.KanbanDir = ExpandPath(c.KanbanDir)
+	c.Editor = ExpandPath(c.Editor)
+	c.WorktreeDir = ExpandPath(c.WorktreeDir)
+	c.SpellDictionary = ExpandPath(c.SpellDictionary)
+	for i, root := range c.BoardRoots {
+		c.BoardRoots[i] = ExpandPath(root)
+	}
+	for i, board := range c.WorkspaceBoards {
+		c.WorkspaceBoards[i] = ExpandPath(board)
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+	t.Setenv("KANBAN_TEST_ROOT", "/srv/boards")
+
+	tests := map[string]string{
+		"~":                           home,
+		"~/.kanban":                   filepath.Join(home, ".kanban"),
+		"$HOME/code":                  home + "/code",
+		"${KANBAN_TEST_ROOT}/.kanban": "/srv/boards/.kanban",
+		"relative/dir":                "relative/dir",
+		"nvim":                        "nvim",
+	}
+	for in, want := range tests {
+		if got := ExpandPath(in); got != want {
+			t.Errorf("ExpandPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestLoadExpandsPaths(t *testing.T) {
+	t.Setenv("KANBAN_TEST_ROOT", "/srv/boards")
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := "kanban_dir: $KANBAN_TEST_ROOT/.kanban\nboard_roots: [\"${KANBAN_TEST_ROOT}/code\"]\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.KanbanDir != "/srv/boards/.kanban" || cfg.BoardRoots[0] != "/srv/boards/code" {
+		t.Errorf("KanbanDir = %q, BoardRoots = %q", cfg.KanbanDir, cfg.BoardRoots)
+	}
+}
@@ -3,6 +3,18 @@ package config
 
 // DefaultSingleTicketPrompt is the default template for copying a single ticket prompt.
 const DefaultSingleTicketPrompt = `Implement the task described in this ticket: @{{.TicketPath}}
+{{- if .ContextFiles}}
+
+Relevant code for this ticket:
+{{- range .ContextFiles}}
+- @{{.Path}}
+{{- if .Content}}
+` + "```" + `
+{{.Content}}
+` + "```" + `
+{{- end}}
+{{- end}}
+{{- end}}
 
 First, read @{{.AgentMdPath}} to understand how to interact with this kanban system.
 
@@ -25,6 +37,9 @@ First, read @{{.AgentMdPath}} to understand how to interact with this kanban sys
 const DefaultBatchTicketPrompt = `Implement the tasks described in the following tickets, in order:
 {{range .Tickets}}
 - @{{.TicketPath}}
+{{- range .ContextFiles}}
+  - context: @{{.Path}}
+{{- end}}
 {{- end}}
 
 First, read @{{.AgentMdPath}} to understand how to interact with this kanban system.
@@ -46,3 +61,22 @@ First, read @{{.AgentMdPath}} to understand how to interact with this kanban sys
 
 Process tickets in the order listed above.
 `
+
+// DefaultVerifyPrompt is the default template for asking an agent to check a
+// ticket's implementation against its acceptance criteria.
+const DefaultVerifyPrompt = `Verify the implementation of the ticket @{{.TicketPath}} against its acceptance criteria.
+
+First, read @{{.AgentMdPath}} to understand how to interact with this kanban system.
+
+## Acceptance Criteria
+{{- range .Criteria}}
+- {{.Text}}
+{{- end}}
+
+## Instructions
+- Inspect the code, and run the tests if the project has them, to check each criterion
+- Do not change the implementation; only report what you find
+- For each criterion, answer PASS or FAIL with a one-line justification
+- Write the results, one criterion per line, into the agent_feedback field in the ticket's YAML frontmatter
+- Do not move the ticket
+`
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SMTPPasswordEnv names the environment variable holding the SMTP password
+// when the config doesn't set one.
+const SMTPPasswordEnv = "KANBAN_SMTP_PASSWORD"
+
+// DefaultSMTPPort is the mail submission port.
+const DefaultSMTPPort = 587
+
+// ErrNoSMTP is returned when the user-wide config has no mail server.
+var ErrNoSMTP = errors.New("no smtp settings")
+
+// SMTP is the mail server tickets are emailed through. It is read from the
+// user-wide config only, so credentials stay out of board configs that are
+// committed and shared.
+type SMTP struct {
+	Host string `yaml:"host"`
+	// Port defaults to 587; STARTTLS is used when the server offers it
+	Port     int    `yaml:"port,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	// Password defaults to $KANBAN_SMTP_PASSWORD
+	Password string `yaml:"password,omitempty"`
+	// From is the sender address
+	From string `yaml:"from"`
+	// To are the default recipients
+	To []string `yaml:"to,omitempty"`
+}
+
+// Addr returns the server's host:port.
+func (s SMTP) Addr() string {
+	return fmt.Sprintf("%s:%d", s.Host, s.Port)
+}
+
+// LoadSMTP reads the smtp section of the user-wide config.
+func LoadSMTP() (SMTP, error) {
+	return loadSMTP(UserConfigPath())
+}
+
+// loadSMTP reads the smtp section of the config file at path.
+func loadSMTP(path string) (SMTP, error) {
+	var file struct {
+		SMTP SMTP `yaml:"smtp"`
+	}
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return SMTP{}, err
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return SMTP{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	s := file.SMTP
+	if s.Host == "" || s.From == "" {
+		return SMTP{}, fmt.Errorf("%w: add smtp host and from to %s", ErrNoSMTP, path)
+	}
+	if s.Port == 0 {
+		s.Port = DefaultSMTPPort
+	}
+	if s.Password == "" {
+		s.Password = os.Getenv(SMTPPasswordEnv)
+	}
+	return s, nil
+}
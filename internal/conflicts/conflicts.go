@@ -0,0 +1,162 @@
+// Package conflicts finds the conflict copies file sync tools leave next to
+// ticket files edited on two machines at once (Syncthing's
+// "*.sync-conflict-*.md", Dropbox's "* (conflicted copy).md") and resolves
+// them by keeping one version or merging the two.
+package conflicts
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Original returns the name of the file a conflict copy was made from, and
+// whether name is a conflict copy at all.
+func Original(name string) (string, bool) {
+	return models.ConflictOriginal(name)
+}
+
+// IsCopy reports whether name is a sync conflict copy.
+func IsCopy(name string) bool {
+	_, ok := Original(name)
+	return ok
+}
+
+// Conflict is a conflict copy and the ticket file it diverged from.
+type Conflict struct {
+	// Copy is the path of the conflict copy
+	Copy string
+	// Original is the path of the ticket it was copied from, which may
+	// have been moved or deleted since
+	Original string
+	Column   string
+	// Orphaned is set when the original was missing as the copy was found
+	Orphaned bool
+}
+
+// HasOriginal reports whether the original ticket file still exists.
+func (c Conflict) HasOriginal() bool {
+	f, err := models.Files.Read(c.Original)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+// Find lists the conflict copies in cfg's columns.
+func Find(cfg *config.Config) ([]Conflict, error) {
+	var found []Conflict
+	for _, col := range cfg.Columns {
+		colPath := cfg.ColumnPath(col.Dir)
+		names, err := models.Files.List(colPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if orig, ok := Original(name); ok {
+				found = append(found, Conflict{
+					Copy:     filepath.Join(colPath, name),
+					Original: filepath.Join(colPath, orig),
+					Column:   col.Dir,
+					Orphaned: !slices.Contains(names, orig),
+				})
+			}
+		}
+	}
+	return found, nil
+}
+
+// KeepOriginal discards the conflict copy, moving it to the board's trash.
+func (c Conflict) KeepOriginal(kanbanDir string) error {
+	_, err := (&models.Ticket{FilePath: c.Copy}).Trash(kanbanDir)
+	return err
+}
+
+// KeepCopy makes the conflict copy the ticket: the original is moved to the
+// trash and the copy takes its name.
+func (c Conflict) KeepCopy(kanbanDir string) error {
+	if c.HasOriginal() {
+		if _, err := (&models.Ticket{FilePath: c.Original}).Trash(kanbanDir); err != nil {
+			return err
+		}
+	}
+	return models.Files.Move(c.Copy, c.Original)
+}
+
+// Merge folds the conflict copy into the original and trashes the copy:
+// tags and context files are unioned, the later updated date wins, and a
+// differing copy's content is appended under a note for manual cleanup.
+// Without an original, the copy simply takes its name.
+func (c Conflict) Merge(kanbanDir string, at time.Time) error {
+	if !c.HasOriginal() {
+		return models.Files.Move(c.Copy, c.Original)
+	}
+	orig, err := models.ParseTicket(c.Original)
+	if err != nil {
+		return err
+	}
+	cp, err := models.ParseTicket(c.Copy)
+	if err != nil {
+		return err
+	}
+	MergeTickets(orig, cp, at)
+	if err := orig.WriteFile(); err != nil {
+		return err
+	}
+	return c.KeepOriginal(kanbanDir)
+}
+
+// MergeTickets folds the conflict copy cp into orig.
+func MergeTickets(orig, cp *models.Ticket, at time.Time) {
+	orig.Tags = union(orig.Tags, cp.Tags)
+	orig.ContextFiles = union(orig.ContextFiles, cp.ContextFiles)
+	if cp.Updated.After(orig.Updated) {
+		orig.Updated = cp.Updated
+	}
+
+	content, copyContent := strings.TrimSpace(orig.Content), strings.TrimSpace(cp.Content)
+	switch {
+	case copyContent == "" || copyContent == content || strings.Contains(content, copyContent):
+	case content == "" || strings.Contains(copyContent, content):
+		orig.Content = cp.Content
+	default:
+		note := fmt.Sprintf("Content of the conflicted copy %s:", cp.Filename())
+		if cp.Title != orig.Title {
+			note += fmt.Sprintf("\n\nTitle: %s", cp.Title)
+		}
+		orig.AppendNote("Sync conflict", note+"\n\n"+cp.Content, at)
+	}
+}
+
+// union appends the values of b missing from a, preserving order.
+func union(a, b []string) []string {
+	seen := make(map[string]bool, len(a))
+	for _, s := range a {
+		seen[s] = true
+	}
+	for _, s := range b {
+		if !seen[s] {
+			a = append(a, s)
+			seen[s] = true
+		}
+	}
+	return a
+}
+
+// Read returns the raw contents of the file at path, for previews.
+func Read(path string) (string, error) {
+	f, err := models.Files.Read(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	return string(data), err
+}
@@ -0,0 +1,121 @@
+package conflicts
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func TestOriginal(t *testing.T) {
+	for _, tt := range []struct {
+		name, want string
+	}{
+		{"2025-01-02-fix.sync-conflict-20250103-101112-ABCDEFG.md", "2025-01-02-fix.md"},
+		{"2025-01-02-fix.sync-conflict-20250103-101112.md", "2025-01-02-fix.md"},
+		{"2025-01-02-fix (conflicted copy).md", "2025-01-02-fix.md"},
+		{"2025-01-02-fix (Ana's conflicted copy 2025-01-03).md", "2025-01-02-fix.md"},
+		{"2025-01-02-fix.md", ""},
+		{"notes (copy).md", ""},
+		{"2025-01-02-fix.sync-conflict-20250103-101112-ABCDEFG.txt", ""},
+	} {
+		got, ok := Original(tt.name)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("Original(%q) = %q, %v; want %q", tt.name, got, ok, tt.want)
+		}
+	}
+}
+
+// newConflict writes a ticket and a conflict copy of it with other tags
+// and content.
+func newConflict(t *testing.T, cfg *config.Config, content, copyContent string) Conflict {
+	t.Helper()
+	ticket := models.NewTicket("Fix login", "todo")
+	ticket.Tags = []string{"bug"}
+	ticket.Content = content
+	path, err := ticket.UniqueFilePath(cfg.ColumnPath("todo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket.FilePath = path
+	if err := ticket.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+	original := ticket.FilePath
+	ticket.Tags = []string{"bug", "auth"}
+	ticket.Content = copyContent
+	ticket.FilePath = strings.TrimSuffix(original, ".md") + ".sync-conflict-20250103-101112-ABCDEFG.md"
+	if err := ticket.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := Find(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Conflict{{Copy: ticket.FilePath, Original: original, Column: "todo"}}
+	if !reflect.DeepEqual(found, want) {
+		t.Fatalf("Find = %+v, want %+v", found, want)
+	}
+	return found[0]
+}
+
+func TestResolve(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	if err := cfg.EnsureDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	trashed := func() int {
+		entries, _ := os.ReadDir(filepath.Join(cfg.KanbanDir, models.TrashDir))
+		return len(entries)
+	}
+
+	c := newConflict(t, cfg, "Steps", "Steps\n\nMore steps")
+	if err := c.KeepCopy(cfg.KanbanDir); err != nil {
+		t.Fatal(err)
+	}
+	got, err := models.ParseTicket(c.Original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Content != "Steps\n\nMore steps" || trashed() != 1 || fileExists(c.Copy) {
+		t.Errorf("KeepCopy: content %q, %d trashed", got.Content, trashed())
+	}
+	os.Remove(c.Original)
+
+	c = newConflict(t, cfg, "Mine", "Theirs")
+	if err := c.Merge(cfg.KanbanDir, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	got, err = models.ParseTicket(c.Original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Tags, []string{"bug", "auth"}) || !strings.HasPrefix(got.Content, "Mine\n\n## Sync conflict") ||
+		!strings.HasSuffix(got.Content, "Theirs") {
+		t.Errorf("Merge: tags %q, content %q", got.Tags, got.Content)
+	}
+	if fileExists(c.Copy) || trashed() != 2 {
+		t.Errorf("Merge left the copy, %d trashed", trashed())
+	}
+	os.Remove(c.Original)
+
+	c = newConflict(t, cfg, "Mine", "Theirs")
+	if err := c.KeepOriginal(cfg.KanbanDir); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := models.ParseTicket(c.Original); got.Content != "Mine" || fileExists(c.Copy) {
+		t.Errorf("KeepOriginal: content %q", got.Content)
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
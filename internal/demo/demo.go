@@ -0,0 +1,169 @@
+// Package demo generates a sample kanban board for exploring the application.
+package demo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Columns is the column layout used for demo boards.
+var Columns = []config.Column{
+	{Name: "Backlog", Dir: "backlog", Color: "#a78bfa"},
+	{Name: "To Do", Dir: "todo", Color: "#f87171"},
+	{Name: "Doing", Dir: "doing", Color: "#fbbf24"},
+	{Name: "Review", Dir: "review", Color: "#60a5fa"},
+	{Name: "Done", Dir: "done", Color: "#4ade80"},
+}
+
+// sample describes a demo ticket relative to the time the board is generated.
+type sample struct {
+	title    string
+	tags     []string
+	content  string
+	feedback string
+	context  []string
+	parent   string // title of the parent sample
+	// path lists the columns the ticket passed through, ending in its current one
+	path []string
+	// age is how long ago the ticket was created; each move happens a step later
+	age, step time.Duration
+	reviewed  bool
+}
+
+var samples = []sample{
+	{
+		title: "User authentication", tags: []string{"epic", "backend"},
+		content: "Let users sign up and log in.\n\n" +
+			"- [ ] Password hashing\n- [ ] Login endpoint\n- [ ] Session cookies",
+		path: []string{"todo", "doing"}, age: 14 * 24 * time.Hour, step: 3 * 24 * time.Hour,
+	},
+	{
+		title: "Hash passwords with bcrypt", tags: []string{"backend", "security"},
+		content:  "Replace the plain SHA-256 hashing with bcrypt (cost 12).",
+		feedback: "Switched to golang.org/x/crypto/bcrypt, added a migration that rehashes on next login. Tests added.",
+		context:  []string{"internal/auth/password.go"},
+		parent:   "User authentication",
+		path:     []string{"todo", "doing", "done"}, age: 10 * 24 * time.Hour, step: 2 * 24 * time.Hour, reviewed: true,
+	},
+	{
+		title: "Add login endpoint", tags: []string{"backend", "api"},
+		content:  "POST /api/login returning a session cookie.\n\n## Acceptance\n- [x] Returns 401 on bad credentials\n- [ ] Rate limited",
+		feedback: "Implemented POST /api/login with a 5 req/min limiter. Rate limiting needs a follow-up for distributed setups.",
+		parent:   "User authentication",
+		path:     []string{"todo", "doing", "done"}, age: 6 * 24 * time.Hour, step: 36 * time.Hour,
+	},
+	{
+		title: "Session cookie hardening", tags: []string{"security"},
+		content: "Set HttpOnly, Secure and SameSite=Lax on the session cookie.",
+		parent:  "User authentication",
+		path:    []string{"todo", "doing"}, age: 4 * 24 * time.Hour, step: 24 * time.Hour,
+	},
+	{
+		title: "Dark mode for settings page", tags: []string{"frontend", "ui"},
+		content: "The settings page ignores the theme toggle.",
+		path:    []string{"backlog"}, age: 30 * 24 * time.Hour,
+	},
+	{
+		title: "Investigate flaky CI job", tags: []string{"ci"},
+		content: "`integration-tests` fails ~1 in 10 runs with a timeout in the DB setup step.",
+		path:    []string{"backlog", "todo"}, age: 9 * 24 * time.Hour, step: 5 * 24 * time.Hour,
+	},
+	{
+		title: "Write onboarding docs", tags: []string{"docs"},
+		content: "Cover local setup, running tests, and the release process.",
+		path:    []string{"todo"}, age: 2 * 24 * time.Hour,
+	},
+	{
+		title: "Übersicht der API-Endpunkte", tags: []string{"docs", "i18n"},
+		content: "Generate an overview of all endpoints for the German docs site.",
+		path:    []string{"todo"}, age: 26 * time.Hour,
+	},
+	{
+		title: "Cache rendered markdown", tags: []string{"performance"},
+		content:  "Rendering large tickets is slow; cache by content hash.",
+		feedback: "Added an LRU cache keyed by SHA-1 of the content. Benchmarks show 8x faster re-renders.",
+		context:  []string{"internal/render/markdown.go"},
+		path:     []string{"todo", "doing", "review"}, age: 5 * 24 * time.Hour, step: 2 * 24 * time.Hour,
+	},
+	{
+		title: "Upgrade Go toolchain", tags: []string{"chore"},
+		content:  "Bump go.mod and CI images to the latest Go release.",
+		feedback: "Bumped to the latest Go release; no code changes needed.",
+		path:     []string{"todo", "doing", "done"}, age: 20 * 24 * time.Hour, step: 24 * time.Hour,
+	},
+	{
+		title: "修复 登录 页面", tags: []string{"frontend", "i18n"},
+		content: "Chinese login page overflows on narrow screens.",
+		path:    []string{"backlog"}, age: 12 * time.Hour,
+	},
+}
+
+// Populate writes the sample tickets into the board described by cfg, whose
+// columns should include the demo column directories.
+func Populate(cfg *config.Config) error {
+	return populate(cfg, time.Now())
+}
+
+func populate(cfg *config.Config, now time.Time) error {
+	filenames := make(map[string]string)
+
+	// Parents first so children can reference their filenames
+	ordered := make([]sample, 0, len(samples))
+	for _, s := range samples {
+		if s.parent == "" {
+			ordered = append(ordered, s)
+		}
+	}
+	for _, s := range samples {
+		if s.parent != "" {
+			ordered = append(ordered, s)
+		}
+	}
+
+	for _, s := range ordered {
+		ticket := build(s, now)
+		if s.parent != "" {
+			ticket.Parent = filenames[s.parent]
+		}
+		path, err := ticket.UniqueFilePath(cfg.ColumnPath(ticket.Column))
+		if err == nil {
+			ticket.FilePath = path
+			err = ticket.WriteFile()
+		}
+		if err != nil {
+			return fmt.Errorf("writing %s: %w", s.title, err)
+		}
+		filenames[s.title] = ticket.Filename()
+	}
+	return nil
+}
+
+// build creates the ticket for a sample, replaying its column history.
+func build(s sample, now time.Time) *models.Ticket {
+	created := now.Add(-s.age)
+	ticket := models.NewTicket(s.title, s.path[0])
+	ticket.Tags = s.tags
+	ticket.Content = s.content
+	ticket.AgentFeedback = s.feedback
+	ticket.ContextFiles = s.context
+	ticket.Created = created
+	ticket.ColumnHistory = nil
+
+	at := created
+	for i, col := range s.path {
+		if i > 0 {
+			at = at.Add(s.step)
+			ticket.MovedAt = at
+		}
+		ticket.ColumnHistory = append(ticket.ColumnHistory, models.ColumnEntry{Column: col, Entered: at})
+	}
+	ticket.Column = s.path[len(s.path)-1]
+	ticket.Updated = at
+	if s.reviewed {
+		ticket.ReviewedAt = at.Add(time.Hour)
+	}
+	return ticket
+}
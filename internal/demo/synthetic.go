@@ -0,0 +1,79 @@
+package demo
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// syntheticWords are combined into titles, tags and bodies of synthetic tickets.
+var syntheticWords = strings.Fields(`auth cache config crash deploy docs editor export
+	filter import index layout login memory parser render review search session
+	sort sync theme upload watcher webhook`)
+
+// SyntheticColumns returns a layout of n columns named "Column 1" to "Column n".
+func SyntheticColumns(n int) []config.Column {
+	columns := make([]config.Column, n)
+	for i := range columns {
+		columns[i] = config.Column{Name: fmt.Sprintf("Column %d", i+1), Dir: fmt.Sprintf("col-%d", i+1)}
+	}
+	return columns
+}
+
+// Synthetic writes n generated tickets spread round-robin across cfg's
+// columns, for load testing and benchmarks. Tickets get tags, a few
+// paragraphs of markdown and a column history; the same seed always
+// produces the same board.
+func Synthetic(cfg *config.Config, n int, seed int64) error {
+	rng := rand.New(rand.NewSource(seed))
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < n; i++ {
+		ticket := SyntheticTicket(rng, i, cfg.Columns, now)
+		path, err := ticket.UniqueFilePath(cfg.ColumnPath(ticket.Column))
+		if err == nil {
+			ticket.FilePath = path
+			err = ticket.WriteFile()
+		}
+		if err != nil {
+			return fmt.Errorf("writing ticket %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SyntheticTicket generates the i-th synthetic ticket, placed in column
+// i modulo len(columns) after passing through the columns before it.
+func SyntheticTicket(rng *rand.Rand, i int, columns []config.Column, now time.Time) *models.Ticket {
+	word := func() string { return syntheticWords[rng.Intn(len(syntheticWords))] }
+
+	col := i % len(columns)
+	ticket := models.NewTicket(fmt.Sprintf("Fix %s %s in %s (%d)", word(), word(), word(), i), columns[0].Dir)
+	ticket.Tags = []string{word(), word()}
+	ticket.Created = now.Add(-time.Duration(rng.Intn(90*24)) * time.Hour)
+
+	var body strings.Builder
+	for p := 0; p < 1+rng.Intn(4); p++ {
+		fmt.Fprintf(&body, "## Notes %d\n\n", p+1)
+		for s := 0; s < 3+rng.Intn(5); s++ {
+			fmt.Fprintf(&body, "The %s %s should handle %s before %s. ", word(), word(), word(), word())
+		}
+		body.WriteString("\n\n- [ ] " + word() + "\n- [x] " + word() + "\n\n")
+	}
+	ticket.Content = strings.TrimSpace(body.String())
+
+	at := ticket.Created
+	ticket.ColumnHistory = []models.ColumnEntry{{Column: columns[0].Dir, Entered: at}}
+	for c := 1; c <= col; c++ {
+		at = at.Add(time.Duration(1+rng.Intn(48)) * time.Hour)
+		ticket.ColumnHistory = append(ticket.ColumnHistory, models.ColumnEntry{Column: columns[c].Dir, Entered: at})
+		ticket.MovedAt = at
+	}
+	ticket.Column = columns[col].Dir
+	ticket.Updated = at
+	return ticket
+}
@@ -0,0 +1,129 @@
+// Package git runs the git commands used by the board's git integration.
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Run runs git with args in dir and returns its trimmed stdout. Failures
+// report git's error message.
+func Run(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		// git prints progress before the error; report only the last line
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			lines := strings.Split(msg, "\n")
+			return "", errors.New(lines[len(lines)-1])
+		}
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// UserName returns the configured git user.name, or "" if unset.
+func UserName(dir string) string {
+	name, _ := Run(dir, "config", "user.name")
+	return name
+}
+
+// DefaultBranch returns the branch the origin remote's HEAD points to, or
+// else "main" or "master" if one exists locally. It returns "" if none is found.
+func DefaultBranch(dir string) string {
+	if ref, err := Run(dir, "symbolic-ref", "--short", "refs/remotes/origin/HEAD"); err == nil {
+		return strings.TrimPrefix(ref, "origin/")
+	}
+	for _, branch := range []string{"main", "master"} {
+		if BranchExists(dir, branch) {
+			return branch
+		}
+	}
+	return ""
+}
+
+// BranchDiff returns the stat and patch of the changes on branch since it
+// diverged from base.
+func BranchDiff(dir, base, branch string) (string, error) {
+	return Run(dir, "diff", "--no-color", "--stat", "--patch", base+"..."+branch)
+}
+
+// WorkingDiff returns the stat and patch of uncommitted changes in dir.
+func WorkingDiff(dir string) (string, error) {
+	return Run(dir, "diff", "--no-color", "--stat", "--patch", "HEAD")
+}
+
+// Show returns a commit's message, stat and patch.
+func Show(dir, hash string) (string, error) {
+	return Run(dir, "show", "--no-color", "--stat", "--patch", hash)
+}
+
+// BranchExists reports whether a local branch exists.
+func BranchExists(dir, branch string) bool {
+	_, err := Run(dir, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	return err == nil
+}
+
+// Checkout switches to branch, creating it from HEAD if it doesn't exist.
+// It reports whether the branch was created.
+func Checkout(dir, branch string) (created bool, err error) {
+	if BranchExists(dir, branch) {
+		_, err = Run(dir, "checkout", branch)
+		return false, err
+	}
+	_, err = Run(dir, "checkout", "-b", branch)
+	return err == nil, err
+}
+
+// CurrentBranch returns the checked-out branch name.
+func CurrentBranch(dir string) (string, error) {
+	return Run(dir, "rev-parse", "--abbrev-ref", "HEAD")
+}
+
+// Commit is a commit's hash and full message.
+type Commit struct {
+	Hash    string
+	Message string
+}
+
+// Log returns up to n of the most recent commits reachable from HEAD.
+func Log(dir string, n int) ([]Commit, error) {
+	out, err := Run(dir, "log", fmt.Sprintf("-n%d", n), "--format=%H%x00%B%x1e")
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(out, "\x1e") {
+		hash, message, ok := strings.Cut(strings.TrimSpace(record), "\x00")
+		if !ok {
+			continue
+		}
+		commits = append(commits, Commit{Hash: hash, Message: strings.TrimSpace(message)})
+	}
+	return commits, nil
+}
+
+// AddWorktree creates a worktree at path checked out to branch, creating the
+// branch from HEAD if it doesn't exist.
+func AddWorktree(dir, path, branch string) error {
+	if BranchExists(dir, branch) {
+		_, err := Run(dir, "worktree", "add", path, branch)
+		return err
+	}
+	_, err := Run(dir, "worktree", "add", "-b", branch, path)
+	return err
+}
+
+// RemoveWorktree removes the worktree at path. Worktrees with uncommitted
+// changes are refused by git.
+func RemoveWorktree(dir, path string) error {
+	_, err := Run(dir, "worktree", "remove", path)
+	return err
+}
@@ -0,0 +1,299 @@
+// Package github fetches pull request state from the GitHub REST API and
+// creates issues from tickets.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the GitHub REST API base URL.
+const DefaultAPIURL = "https://api.github.com"
+
+// PR identifies a pull request.
+type PR struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// URL returns the pull request's web URL.
+func (p PR) URL() string {
+	return fmt.Sprintf("https://github.com/%s/%s/pull/%d", p.Owner, p.Repo, p.Number)
+}
+
+// String returns "owner/repo#number".
+func (p PR) String() string {
+	return fmt.Sprintf("%s/%s#%d", p.Owner, p.Repo, p.Number)
+}
+
+var (
+	prURLRe  = regexp.MustCompile(`github\.com/([^/\s]+)/([^/\s]+)/pull/(\d+)`)
+	prRefRe  = regexp.MustCompile(`^([^/\s]+)/([^/\s#]+)#(\d+)$`)
+	remoteRe = regexp.MustCompile(`github\.com[:/]([^/\s]+)/([^/\s]+?)(?:\.git)?/?$`)
+)
+
+// ParsePR parses a PR reference: a pull request URL, "owner/repo#123", or a
+// bare number ("123" or "#123") resolved against defaultRepo ("owner/repo").
+func ParsePR(ref, defaultRepo string) (PR, error) {
+	ref = strings.TrimSpace(ref)
+	if m := prURLRe.FindStringSubmatch(ref); m != nil {
+		n, _ := strconv.Atoi(m[3])
+		return PR{m[1], m[2], n}, nil
+	}
+	if m := prRefRe.FindStringSubmatch(ref); m != nil {
+		n, _ := strconv.Atoi(m[3])
+		return PR{m[1], m[2], n}, nil
+	}
+	if n, err := strconv.Atoi(strings.TrimPrefix(ref, "#")); err == nil && n > 0 {
+		owner, repo, ok := strings.Cut(defaultRepo, "/")
+		if !ok || owner == "" || repo == "" {
+			return PR{}, fmt.Errorf("PR %q needs a repository (set github_repo)", ref)
+		}
+		return PR{owner, repo, n}, nil
+	}
+	return PR{}, fmt.Errorf("unrecognized PR reference %q", ref)
+}
+
+// RepoFromGit returns "owner/repo" for the origin remote of the git
+// repository containing dir, or "" if it is not a GitHub remote.
+func RepoFromGit(dir string) string {
+	cmd := exec.Command("git", "remote", "get-url", "origin")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	m := remoteRe.FindStringSubmatch(strings.TrimSpace(string(out)))
+	if m == nil {
+		return ""
+	}
+	return m[1] + "/" + m[2]
+}
+
+// Status summarizes a pull request.
+type Status struct {
+	// State is "open", "closed", "merged" or "draft"
+	State string
+	// Checks is "success", "failure", "pending", or "" when there are no checks
+	Checks string
+}
+
+// Client calls the GitHub API.
+type Client struct {
+	BaseURL string
+	Token   string
+	HTTP    *http.Client
+}
+
+// NewClient returns a client for api.github.com authenticated with
+// $GITHUB_TOKEN (or $GH_TOKEN) when set.
+func NewClient() *Client {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		token = os.Getenv("GH_TOKEN")
+	}
+	return &Client{
+		BaseURL: DefaultAPIURL,
+		Token:   token,
+		HTTP:    &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Status fetches the pull request's state and the combined result of the
+// check runs on its head commit.
+func (c *Client) Status(ctx context.Context, pr PR) (Status, error) {
+	var pull struct {
+		State  string `json:"state"`
+		Merged bool   `json:"merged"`
+		Draft  bool   `json:"draft"`
+		Head   struct {
+			SHA string `json:"sha"`
+		} `json:"head"`
+	}
+	if err := c.get(ctx, fmt.Sprintf("/repos/%s/%s/pulls/%d", pr.Owner, pr.Repo, pr.Number), &pull); err != nil {
+		return Status{}, err
+	}
+
+	status := Status{State: pull.State}
+	switch {
+	case pull.Merged:
+		status.State = "merged"
+	case pull.Draft && pull.State == "open":
+		status.State = "draft"
+	}
+
+	var runs struct {
+		CheckRuns []struct {
+			Status     string `json:"status"`
+			Conclusion string `json:"conclusion"`
+		} `json:"check_runs"`
+	}
+	if err := c.get(ctx, fmt.Sprintf("/repos/%s/%s/commits/%s/check-runs", pr.Owner, pr.Repo, pull.Head.SHA), &runs); err != nil {
+		return status, err
+	}
+	for _, run := range runs.CheckRuns {
+		switch {
+		case run.Status != "completed":
+			if status.Checks != "failure" {
+				status.Checks = "pending"
+			}
+		case run.Conclusion == "failure" || run.Conclusion == "timed_out" ||
+			run.Conclusion == "cancelled" || run.Conclusion == "action_required":
+			status.Checks = "failure"
+		case status.Checks == "":
+			status.Checks = "success"
+		}
+	}
+	return status, nil
+}
+
+// Issue is an issue to create.
+type Issue struct {
+	Title  string   `json:"title"`
+	Body   string   `json:"body,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// ErrNoCredentials is returned when an issue can be created neither through
+// the API nor the gh CLI.
+var ErrNoCredentials = errors.New("creating issues needs $GITHUB_TOKEN or the gh CLI")
+
+// CreateIssue opens issue in repo ("owner/repo") and returns its URL.
+// Without a token it falls back to the gh CLI and its login.
+func (c *Client) CreateIssue(ctx context.Context, repo string, issue Issue) (string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" {
+		return "", fmt.Errorf("no GitHub repository %q (set github_repo)", repo)
+	}
+	if c.Token == "" {
+		if _, err := exec.LookPath("gh"); err != nil {
+			return "", ErrNoCredentials
+		}
+		return createIssueGH(ctx, repo, issue)
+	}
+
+	var created struct {
+		HTMLURL string `json:"html_url"`
+	}
+	path := fmt.Sprintf("/repos/%s/%s/issues", owner, name)
+	if err := c.do(ctx, http.MethodPost, path, issue, http.StatusCreated, &created); err != nil {
+		return "", err
+	}
+	return created.HTMLURL, nil
+}
+
+// createIssueGH creates an issue with gh issue create, which prints the
+// issue's URL. gh refuses labels the repository doesn't have, so only
+// existing ones are passed (none if they can't be listed).
+func createIssueGH(ctx context.Context, repo string, issue Issue) (string, error) {
+	args := []string{"issue", "create", "--repo", repo, "--title", issue.Title, "--body-file", "-"}
+	if len(issue.Labels) > 0 {
+		existing, _ := labelsGH(ctx, repo)
+		for _, label := range knownLabels(issue.Labels, existing) {
+			args = append(args, "--label", label)
+		}
+	}
+	cmd := exec.CommandContext(ctx, "gh", args...)
+	cmd.Stdin = strings.NewReader(issue.Body)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("gh issue create: %s", msg)
+		}
+		return "", fmt.Errorf("gh issue create: %w", err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return "", errors.New("gh issue create printed no URL")
+	}
+	return fields[len(fields)-1], nil
+}
+
+// labelsGH lists the names of repo's labels with gh label list.
+func labelsGH(ctx context.Context, repo string) ([]string, error) {
+	out, err := exec.CommandContext(ctx, "gh", "label", "list", "--repo", repo, "--json", "name", "--limit", "1000").Output()
+	if err != nil {
+		return nil, fmt.Errorf("gh label list: %w", err)
+	}
+	var labels []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(out, &labels); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(labels))
+	for i, l := range labels {
+		names[i] = l.Name
+	}
+	return names, nil
+}
+
+// knownLabels returns the labels found in existing, compared
+// case-insensitively like GitHub does, spelled as the repository has them.
+func knownLabels(labels, existing []string) []string {
+	var known []string
+	for _, label := range labels {
+		for _, e := range existing {
+			if strings.EqualFold(label, e) {
+				known = append(known, e)
+				break
+			}
+		}
+	}
+	return known
+}
+
+// get fetches path and decodes the JSON response into v.
+func (c *Client) get(ctx context.Context, path string, v any) error {
+	return c.do(ctx, http.MethodGet, path, nil, http.StatusOK, v)
+}
+
+// do sends a request to path, with body (if any) as JSON, and decodes the
+// response into v; any status other than want is an error.
+func (c *Client) do(ctx context.Context, method, path string, body any, want int, v any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != want {
+		return fmt.Errorf("GitHub API %s: %s", path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParsePR(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    PR
+		wantErr bool
+	}{
+		{"https://github.com/acme/app/pull/42", PR{"acme", "app", 42}, false},
+		{"acme/app#7", PR{"acme", "app", 7}, false},
+		{"#12", PR{"own", "repo", 12}, false},
+		{"12", PR{"own", "repo", 12}, false},
+		{"not a pr", PR{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePR(tt.ref, "own/repo")
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParsePR(%q) = %v, %v; want %v, err %v", tt.ref, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if _, err := ParsePR("12", ""); err == nil {
+		t.Error("bare number without a default repo should fail")
+	}
+}
+
+func TestStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/app/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"state":"closed","merged":true,"head":{"sha":"abc"}}`))
+	})
+	mux.HandleFunc("/repos/acme/app/commits/abc/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"check_runs":[{"status":"completed","conclusion":"success"},{"status":"completed","conclusion":"failure"},{"status":"in_progress"}]}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTP: srv.Client()}
+	got, err := c.Status(context.Background(), PR{"acme", "app", 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Status{State: "merged", Checks: "failure"}); got != want {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCreateIssue(t *testing.T) {
+	var got Issue
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/app/issues", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"html_url":"https://github.com/acme/app/issues/5"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, Token: "secret", HTTP: srv.Client()}
+	issue := Issue{Title: "Fix login", Body: "Steps", Labels: []string{"bug"}}
+	url, err := c.CreateIssue(context.Background(), "acme/app", issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url != "https://github.com/acme/app/issues/5" {
+		t.Errorf("CreateIssue() = %q", url)
+	}
+	if got.Title != issue.Title || got.Body != issue.Body || len(got.Labels) != 1 || got.Labels[0] != "bug" {
+		t.Errorf("server got %+v", got)
+	}
+
+	if _, err := c.CreateIssue(context.Background(), "", issue); err == nil {
+		t.Error("CreateIssue without a repository should fail")
+	}
+}
+
+func TestKnownLabels(t *testing.T) {
+	got := knownLabels([]string{"Bug", "backend", "urgent"}, []string{"bug", "urgent", "docs"})
+	if strings.Join(got, ",") != "bug,urgent" {
+		t.Errorf("knownLabels() = %q", got)
+	}
+}
@@ -0,0 +1,115 @@
+// Package hooks runs the user's shell commands after ticket lifecycle
+// events, so boards can be wired to notifications, scripts and syncs.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/platform"
+)
+
+// Timeout bounds how long a hook may run.
+const Timeout = 30 * time.Second
+
+// Event is a ticket lifecycle event.
+type Event string
+
+// Events hooks can run on.
+const (
+	Create Event = "create"
+	Move   Event = "move"
+	Delete Event = "delete"
+	Done   Event = "done"
+)
+
+// Payload describes the ticket an event happened to. Hooks read it as JSON
+// on stdin.
+type Payload struct {
+	Event Event    `json:"event"`
+	Path  string   `json:"path"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
+	// Column is the ticket's column directory after the event
+	Column string `json:"column"`
+	// FromColumn is the column a moved ticket left
+	FromColumn string `json:"from_column,omitempty"`
+	// KanbanDir is the board's directory; hooks run in its parent
+	KanbanDir string `json:"kanban_dir"`
+}
+
+// NewPayload describes event on ticket, which moved from the column from
+// (empty for events other than moves).
+func NewPayload(event Event, ticket *models.Ticket, from string) Payload {
+	tags := ticket.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return Payload{
+		Event:      event,
+		Path:       ticket.FilePath,
+		Title:      ticket.Title,
+		Tags:       tags,
+		Column:     ticket.Column,
+		FromColumn: from,
+		KanbanDir:  ticket.KanbanDir(),
+	}
+}
+
+// Command returns the hook configured for event, or "".
+func Command(h config.Hooks, event Event) string {
+	switch event {
+	case Create:
+		return h.OnCreate
+	case Move:
+		return h.OnMove
+	case Delete:
+		return h.OnDelete
+	case Done:
+		return h.OnDone
+	}
+	return ""
+}
+
+// Run runs command through the shell in the board's project directory,
+// with p as JSON on stdin and in KANBAN_* environment variables. A failed
+// hook's error includes the last line it printed.
+func Run(command string, p Payload) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+	cmd := platform.ShellCommand(ctx, command)
+	cmd.Dir = filepath.Dir(p.KanbanDir)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Env = append(os.Environ(),
+		"KANBAN_EVENT="+string(p.Event),
+		"KANBAN_TICKET="+p.Path,
+		"KANBAN_TITLE="+p.Title,
+		"KANBAN_TAGS="+strings.Join(p.Tags, ","),
+		"KANBAN_COLUMN="+p.Column,
+		"KANBAN_FROM_COLUMN="+p.FromColumn,
+		"KANBAN_DIR="+p.KanbanDir,
+	)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+			return fmt.Errorf("%s hook: %w: %s", p.Event, err, last)
+		}
+		return fmt.Errorf("%s hook: %w", p.Event, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package hooks
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands use sh")
+	}
+	kanbanDir := filepath.Join(t.TempDir(), ".kanban")
+	ticket := models.NewTicket("Ship it", "done")
+	ticket.Tags = []string{"release", "ops"}
+	ticket.FilePath = filepath.Join(kanbanDir, "done", "2025-01-02-ship-it.md")
+
+	out := filepath.Join(t.TempDir(), "out")
+	command := `cat > ` + out + `.json; echo "$KANBAN_EVENT $KANBAN_FROM_COLUMN>$KANBAN_COLUMN $KANBAN_TAGS $PWD" > ` + out
+	if err := os.MkdirAll(kanbanDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := Run(command, NewPayload(Move, ticket, "doing")); err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "move doing>done release,ops " + filepath.Dir(kanbanDir) + "\n"; string(env) != want {
+		t.Errorf("env = %q, want %q", env, want)
+	}
+	data, err := os.ReadFile(out + ".json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p Payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Event != Move || p.Title != "Ship it" || p.Path != ticket.FilePath || p.KanbanDir != kanbanDir {
+		t.Errorf("payload = %+v", p)
+	}
+
+	err = Run("echo working; echo 'no token' >&2; exit 3", NewPayload(Create, ticket, ""))
+	if err == nil || !strings.Contains(err.Error(), "create hook") || !strings.HasSuffix(err.Error(), "no token") {
+		t.Errorf("err = %v, want the hook's last output line", err)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	h := config.Hooks{OnCreate: "a", OnMove: "b", OnDelete: "c", OnDone: "d"}
+	for event, want := range map[Event]string{Create: "a", Move: "b", Delete: "c", Done: "d", "other": ""} {
+		if got := Command(h, event); got != want {
+			t.Errorf("Command(%s) = %q, want %q", event, got, want)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Package logging configures the optional debug log enabled with -log, which
+// records watcher events, file operations and UI state transitions for bug
+// reports.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultPath returns the log file location: $XDG_STATE_HOME/kanban-tui/kanban.log,
+// falling back to ~/.local/state/kanban-tui/kanban.log.
+func DefaultPath() string {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			home = "."
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "kanban-tui", "kanban.log")
+}
+
+// ParseLevel parses a level name: debug, info, warn or error.
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.ToLower(name))); err != nil {
+		return 0, fmt.Errorf("unknown log level %q (want debug, info, warn or error)", name)
+	}
+	return level, nil
+}
+
+// Setup installs the default slog logger. The most recent records, at any
+// level, are always kept in memory for crash reports (see Recent). With an
+// empty level nothing else is written, so nothing reaches the terminal the
+// TUI draws on; otherwise records at level and above are appended to path.
+// The returned closer closes the log file.
+func Setup(level, path string) (io.Closer, error) {
+	recent := slog.NewTextHandler(recentLines, &slog.HandlerOptions{Level: slog.LevelDebug})
+	if level == "" {
+		slog.SetDefault(slog.New(recent))
+		return io.NopCloser(nil), nil
+	}
+
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	file := slog.NewTextHandler(f, &slog.HandlerOptions{Level: lvl})
+	slog.SetDefault(slog.New(teeHandler{recent, file}))
+	slog.Info("logging started", "level", lvl.String(), "pid", os.Getpid())
+	return f, nil
+}
+
+// Recent returns the most recent log records, oldest first.
+func Recent() []string {
+	return recentLines.lines()
+}
+
+// recentSize is the number of log records kept for crash reports.
+const recentSize = 200
+
+// recentLines keeps the last recentSize records written by the default logger.
+var recentLines = &ring{}
+
+// ring is an io.Writer keeping the last recentSize lines written to it.
+type ring struct {
+	mu   sync.Mutex
+	buf  []string
+	next int
+}
+
+// Write records p, one log record as written by a slog handler.
+func (r *ring) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	line := strings.TrimRight(string(p), "\n")
+	if len(r.buf) < recentSize {
+		r.buf = append(r.buf, line)
+	} else {
+		r.buf[r.next] = line
+		r.next = (r.next + 1) % recentSize
+	}
+	return len(p), nil
+}
+
+// lines returns the kept lines, oldest first.
+func (r *ring) lines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append(append([]string{}, r.buf[r.next:]...), r.buf[:r.next]...)
+}
+
+// teeHandler passes records to every handler that accepts their level.
+type teeHandler []slog.Handler
+
+// Enabled reports whether any handler accepts level.
+func (t teeHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range t {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle passes r to the handlers that accept its level.
+func (t teeHandler) Handle(ctx context.Context, r slog.Record) error {
+	var first error
+	for _, h := range t {
+		if h.Enabled(ctx, r.Level) {
+			if err := h.Handle(ctx, r.Clone()); err != nil && first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
+
+// WithAttrs returns a teeHandler of the handlers with attrs added.
+func (t teeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	hs := make(teeHandler, len(t))
+	for i, h := range t {
+		hs[i] = h.WithAttrs(attrs)
+	}
+	return hs
+}
+
+// WithGroup returns a teeHandler of the handlers with the group opened.
+func (t teeHandler) WithGroup(name string) slog.Handler {
+	hs := make(teeHandler, len(t))
+	for i, h := range t {
+		hs[i] = h.WithGroup(name)
+	}
+	return hs
+}
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseLevel(t *testing.T) {
+	for name, want := range map[string]slog.Level{"debug": slog.LevelDebug, "INFO": slog.LevelInfo, "warn": slog.LevelWarn, "error": slog.LevelError} {
+		got, err := ParseLevel(name)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("ParseLevel(verbose) succeeded")
+	}
+}
+
+func TestSetup(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+	path := filepath.Join(t.TempDir(), "logs", "kanban.log")
+	closer, err := Setup("info", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slog.Debug("hidden")
+	slog.Info("moved ticket", "to", "done")
+	closer.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := string(data)
+	if !strings.Contains(log, `msg="moved ticket" to=done`) || strings.Contains(log, "hidden") {
+		t.Errorf("unexpected log:\n%s", log)
+	}
+}
+
+func TestRecent(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+	if _, err := Setup("", ""); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < recentSize+5; i++ {
+		slog.Debug("event", "n", i)
+	}
+	lines := Recent()
+	if len(lines) != recentSize {
+		t.Fatalf("kept %d lines, want %d", len(lines), recentSize)
+	}
+	if !strings.HasSuffix(lines[0], "n=5") || !strings.HasSuffix(lines[len(lines)-1], fmt.Sprintf("n=%d", recentSize+4)) {
+		t.Errorf("lines[0] = %q, last = %q", lines[0], lines[len(lines)-1])
+	}
+}
@@ -0,0 +1,230 @@
+// Package mail emails a ticket or a summary of the board through the SMTP
+// server in the user-wide config, for handing work to someone outside the
+// terminal.
+package mail
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Message is an email to send.
+type Message struct {
+	From    string
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Bytes formats the message as a plain text email dated date.
+func (m Message) Bytes(date time.Time) []byte {
+	var b bytes.Buffer
+	header := func(key, value string) {
+		// A line break in a header would start another one
+		value = strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+		fmt.Fprintf(&b, "%s: %s\r\n", key, value)
+	}
+	header("From", m.From)
+	header("To", strings.Join(m.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+	body := strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n")
+	b.WriteString(body)
+	return b.Bytes()
+}
+
+// ParseRecipients parses a comma-separated address list.
+func ParseRecipients(list string) ([]string, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, errors.New("no recipients")
+	}
+	addrs, err := netmail.ParseAddressList(list)
+	if err != nil {
+		return nil, fmt.Errorf("recipients: %w", err)
+	}
+	to := make([]string, len(addrs))
+	for i, a := range addrs {
+		to[i] = a.String()
+	}
+	return to, nil
+}
+
+// Send delivers msg through the server s, upgrading to TLS when the server
+// offers STARTTLS and logging in when s has a username.
+func Send(s config.SMTP, msg Message) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+	from, err := netmail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("sender: %w", err)
+	}
+	rcpt := make([]string, len(msg.To))
+	for i, to := range msg.To {
+		a, err := netmail.ParseAddress(to)
+		if err != nil {
+			return fmt.Errorf("recipient: %w", err)
+		}
+		rcpt[i] = a.Address
+	}
+	return sendMail(s.Addr(), s.Host, auth, from.Address, rcpt, msg.Bytes(time.Now()))
+}
+
+// sendTimeout bounds connecting to the SMTP server and the whole exchange,
+// so an unresponsive server fails the send instead of hanging it.
+var sendTimeout = 30 * time.Second
+
+// sendMail is smtp.SendMail with a deadline on the connection.
+func sendMail(addr, host string, auth smtp.Auth, from string, to []string, data []byte) error {
+	conn, err := net.DialTimeout("tcp", addr, sendTimeout)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(sendTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// TicketSummary returns the subject and body of an email about ticket,
+// which is in the column named column.
+func TicketSummary(ticket *models.Ticket, column string) (subject, body string) {
+	var b strings.Builder
+	b.WriteString(ticket.Title + "\n")
+	b.WriteString(strings.Repeat("=", min(len([]rune(ticket.Title)), 72)) + "\n\n")
+
+	details := []string{"Column: " + column}
+	if len(ticket.Tags) > 0 {
+		details = append(details, "Tags: "+strings.Join(ticket.Tags, ", "))
+	}
+	if ticket.Priority > 0 {
+		details = append(details, fmt.Sprintf("Priority: %d", ticket.Priority))
+	}
+	if ticket.Due != "" {
+		details = append(details, "Due: "+ticket.Due)
+	}
+	if ticket.PR != "" {
+		details = append(details, "PR: "+ticket.PR)
+	}
+	if ticket.Issue != "" {
+		details = append(details, "Issue: "+ticket.Issue)
+	}
+	details = append(details, "File: "+ticket.Filename())
+	b.WriteString(strings.Join(details, "\n") + "\n")
+
+	if content := strings.TrimSpace(ticket.Content); content != "" {
+		b.WriteString("\n" + content + "\n")
+	}
+	if feedback := strings.TrimSpace(ticket.AgentFeedback); feedback != "" {
+		b.WriteString("\nAgent feedback:\n" + feedback + "\n")
+	}
+	return "[kanban] " + ticket.Title, b.String()
+}
+
+// Column is a board column and its tickets, for BoardSummary.
+type Column struct {
+	Name    string
+	Tickets []*models.Ticket
+}
+
+// ReadBoard reads the headers of the tickets in cfg's columns.
+func ReadBoard(cfg *config.Config) ([]Column, error) {
+	var columns []Column
+	for _, col := range cfg.Columns {
+		c := Column{Name: col.Name}
+		names, err := models.TicketNames(cfg.ColumnPath(col.Dir))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			ticket, err := models.ParseTicketHeader(filepath.Join(cfg.ColumnPath(col.Dir), name))
+			if err != nil {
+				return nil, err
+			}
+			c.Tickets = append(c.Tickets, ticket)
+		}
+		models.SortTickets(c.Tickets, cfg.SortBy, cfg.Locale)
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+// BoardSummary returns the subject and body of an email listing the
+// tickets of the board named board, column by column, as of now.
+func BoardSummary(board string, columns []Column, now time.Time) (subject, body string) {
+	var b strings.Builder
+	total := 0
+	for _, col := range columns {
+		total += len(col.Tickets)
+		fmt.Fprintf(&b, "%s (%d)\n", col.Name, len(col.Tickets))
+		for _, t := range col.Tickets {
+			line := "- " + t.Title
+			if len(t.Tags) > 0 {
+				line += " [" + strings.Join(t.Tags, ", ") + "]"
+			}
+			if t.Due != "" {
+				line += " due " + t.Due
+			}
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%d tickets, %s\n", total, now.Format("Mon Jan 2, 2006 15:04"))
+	return fmt.Sprintf("[kanban] %s board summary, %s", board, now.Format("Jan 2")), b.String()
+}
@@ -0,0 +1,140 @@
+package mail
+
+import (
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func TestTicketSummary(t *testing.T) {
+	ticket := &models.Ticket{
+		Title:         "Fix login",
+		Tags:          []string{"bug", "auth"},
+		Due:           "2025-03-14",
+		Content:       "Steps to reproduce\n",
+		AgentFeedback: "Fixed the redirect",
+		FilePath:      "/b/.kanban/doing/2025-03-01-fix-login.md",
+	}
+	subject, body := TicketSummary(ticket, "Doing")
+	if subject != "[kanban] Fix login" {
+		t.Errorf("subject = %q", subject)
+	}
+	for _, want := range []string{"Column: Doing", "Tags: bug, auth", "Due: 2025-03-14", "File: 2025-03-01-fix-login.md", "Steps to reproduce", "Agent feedback:\nFixed the redirect"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("body lacks %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestBoardSummary(t *testing.T) {
+	columns := []Column{
+		{Name: "To Do", Tickets: []*models.Ticket{{Title: "Write docs", Tags: []string{"docs"}}}},
+		{Name: "Done"},
+	}
+	now := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	subject, body := BoardSummary("app", columns, now)
+	if subject != "[kanban] app board summary, Mar 14" {
+		t.Errorf("subject = %q", subject)
+	}
+	want := "To Do (1)\n- Write docs [docs]\n\nDone (0)\n\n1 tickets, Fri Mar 14, 2025 09:30\n"
+	if body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestParseRecipients(t *testing.T) {
+	to, err := ParseRecipients("Ann <ann@example.com>, bob@example.com")
+	if err != nil || len(to) != 2 || to[1] != "<bob@example.com>" {
+		t.Errorf("ParseRecipients = %q, %v", to, err)
+	}
+	if _, err := ParseRecipients(" "); err == nil {
+		t.Error("empty recipients should fail")
+	}
+	if _, err := ParseRecipients("not an address"); err == nil {
+		t.Error("invalid recipients should fail")
+	}
+}
+
+func TestSend(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	got := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		c := textproto.NewConn(conn)
+		c.PrintfLine("220 test")
+		for {
+			line, err := c.ReadLine()
+			if err != nil {
+				return
+			}
+			switch verb := strings.ToUpper(strings.Fields(line)[0]); verb {
+			case "DATA":
+				c.PrintfLine("354 go ahead")
+				data, _ := c.ReadDotLines()
+				got <- strings.Join(data, "\n")
+				c.PrintfLine("250 ok")
+			case "QUIT":
+				c.PrintfLine("221 bye")
+				return
+			default:
+				c.PrintfLine("250 ok")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	s := config.SMTP{Host: host, Port: p, From: "me@example.com"}
+	msg := Message{From: s.From, To: []string{"Ann <ann@example.com>"}, Subject: "Übersicht", Body: "Hello\n.\nBye"}
+	if err := Send(s, msg); err != nil {
+		t.Fatal(err)
+	}
+	data := <-got
+	for _, want := range []string{"To: Ann <ann@example.com>", "Subject: =?utf-8?q?=C3=9Cbersicht?=", "Hello\n.\nBye"} {
+		if !strings.Contains(data, want) {
+			t.Errorf("message lacks %q:\n%s", want, data)
+		}
+	}
+}
+
+func TestSendTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	// Accepts the connection but never greets
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(time.Second)
+		}
+	}()
+
+	defer func(d time.Duration) { sendTimeout = d }(sendTimeout)
+	sendTimeout = 50 * time.Millisecond
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	s := config.SMTP{Host: host, Port: p, From: "me@example.com"}
+	msg := Message{From: s.From, To: []string{"ann@example.com"}, Subject: "Hi", Body: "Hello"}
+	if err := Send(s, msg); err == nil {
+		t.Fatal("Send to a silent server succeeded")
+	}
+}
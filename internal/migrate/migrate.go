@@ -0,0 +1,254 @@
+// Package migrate reconciles a board's column directories with the columns
+// defined in its config, for when column dirs were edited by hand.
+package migrate
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Rename moves the tickets of an unconfigured directory into a column.
+type Rename struct {
+	From    string
+	To      string
+	Tickets int
+}
+
+// Orphan is an unconfigured directory holding tickets that no column
+// claimed; it is left untouched.
+type Orphan struct {
+	Dir     string
+	Tickets int
+	// Match is an empty column whose name or dir looks like Dir, offered
+	// as a -map suggestion; it is never moved without one
+	Match string
+}
+
+// Plan lists the changes that bring the directories on disk in line with
+// the configured columns.
+type Plan struct {
+	Renames []Rename
+	// Creates lists configured column dirs missing on disk
+	Creates []string
+	Orphans []Orphan
+}
+
+// Empty reports whether the board is already in sync.
+func (p *Plan) Empty() bool {
+	return len(p.Renames) == 0 && len(p.Creates) == 0 && len(p.Orphans) == 0
+}
+
+// NewPlan compares cfg's columns with the directories in its kanban dir.
+// Only mapping, which pairs old directory names with column dirs or names,
+// moves a directory into a column; unmatched directories go to
+// orphanColumn when it is set and are reported as orphans otherwise.
+func NewPlan(cfg *config.Config, mapping map[string]string, orphanColumn string) (*Plan, error) {
+	configured := make(map[string]bool)
+	for _, col := range cfg.Columns {
+		configured[col.Dir] = true
+	}
+
+	// The store lists files only; the column directories themselves are
+	// found on disk
+	entries, err := os.ReadDir(cfg.KanbanDir)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	var extra []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || configured[name] || config.IsReservedDir(name) {
+			continue
+		}
+		n, err := countTickets(filepath.Join(cfg.KanbanDir, name))
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+		counts[name] = n
+		extra = append(extra, name)
+	}
+
+	// Columns without tickets are suggested for a renamed directory; a
+	// running board may already have created the new, empty directory
+	var missing, empty []string
+	for _, col := range cfg.Columns {
+		if _, err := os.Stat(cfg.ColumnPath(col.Dir)); os.IsNotExist(err) {
+			missing = append(missing, col.Dir)
+			empty = append(empty, col.Dir)
+			continue
+		}
+		n, err := countTickets(cfg.ColumnPath(col.Dir))
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			empty = append(empty, col.Dir)
+		}
+	}
+
+	plan := &Plan{}
+	claimed := make(map[string]bool)
+	target := make(map[string]string)
+
+	for from, to := range mapping {
+		col, ok := lookupColumn(cfg, to)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q for %s", to, from)
+		}
+		if _, ok := counts[from]; !ok {
+			return nil, fmt.Errorf("%s is not an unconfigured directory with tickets", from)
+		}
+		target[from] = col.Dir
+		claimed[col.Dir] = true
+	}
+
+	var leftDirs []string
+	for _, name := range extra {
+		if target[name] == "" {
+			leftDirs = append(leftDirs, name)
+		}
+	}
+
+	if orphanColumn != "" {
+		col, ok := lookupColumn(cfg, orphanColumn)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", orphanColumn)
+		}
+		for _, name := range leftDirs {
+			target[name] = col.Dir
+		}
+		leftDirs = nil
+	}
+
+	for _, name := range extra {
+		if to := target[name]; to != "" {
+			plan.Renames = append(plan.Renames, Rename{From: name, To: to, Tickets: counts[name]})
+		}
+	}
+	for _, name := range leftDirs {
+		orphan := Orphan{Dir: name, Tickets: counts[name]}
+		for _, dir := range empty {
+			if col, _ := lookupColumn(cfg, dir); !claimed[dir] && matches(name, col) {
+				orphan.Match = dir
+				break
+			}
+		}
+		plan.Orphans = append(plan.Orphans, orphan)
+	}
+	for _, dir := range missing {
+		if !claimed[dir] {
+			plan.Creates = append(plan.Creates, dir)
+		}
+	}
+	sort.Slice(plan.Renames, func(i, j int) bool { return plan.Renames[i].From < plan.Renames[j].From })
+	return plan, nil
+}
+
+// Apply carries out the plan: renamed directories are moved into their
+// column (file by file when the column already has tickets), missing
+// columns are created, and column history in every ticket is rewritten to
+// the new directory names. Orphans are left alone. A running board picks up
+// the moves through its file watcher.
+func (p *Plan) Apply(cfg *config.Config) error {
+	for _, r := range p.Renames {
+		if err := moveDir(cfg.ColumnPath(r.From), cfg.ColumnPath(r.To)); err != nil {
+			return fmt.Errorf("moving %s to %s: %w", r.From, r.To, err)
+		}
+	}
+	for _, dir := range p.Creates {
+		if err := os.MkdirAll(cfg.ColumnPath(dir), 0755); err != nil {
+			return err
+		}
+	}
+	if len(p.Renames) == 0 {
+		return nil
+	}
+	return p.rewriteHistory(cfg)
+}
+
+// rewriteHistory renames old column dirs in the history of every ticket.
+// Tickets are written without bumping their updated time.
+func (p *Plan) rewriteHistory(cfg *config.Config) error {
+	for _, col := range cfg.Columns {
+		tickets, err := models.ReadColumn(cfg.ColumnPath(col.Dir))
+		if err != nil {
+			return err
+		}
+		for _, ticket := range tickets {
+			changed := false
+			for _, r := range p.Renames {
+				if ticket.RenameColumn(r.From, r.To) {
+					changed = true
+				}
+			}
+			if changed {
+				if err := ticket.WriteFile(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// moveDir moves every file from src into dst through the ticket store,
+// renaming files whose name is taken in dst. src is removed once empty.
+func moveDir(src, dst string) error {
+	names, err := models.Files.List(src)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		path, err := models.UniquePath(dst, name)
+		if err != nil {
+			return err
+		}
+		slog.Debug("move ticket", "from", filepath.Join(src, name), "to", path)
+		if err := models.Files.Move(filepath.Join(src, name), path); err != nil {
+			return err
+		}
+	}
+	// Leaves src in place if it still holds subdirectories
+	if err := os.Remove(src); err != nil && !os.IsNotExist(err) {
+		slog.Warn("old column dir not removed", "dir", src, "err", err)
+	}
+	return nil
+}
+
+// countTickets returns the number of tickets in dir (see
+// models.TicketNames).
+func countTickets(dir string) (int, error) {
+	names, err := models.TicketNames(dir)
+	return len(names), err
+}
+
+// lookupColumn finds a column by directory or name (case-insensitive).
+func lookupColumn(cfg *config.Config, name string) (config.Column, bool) {
+	for _, col := range cfg.Columns {
+		if strings.EqualFold(col.Dir, name) || strings.EqualFold(col.Name, name) {
+			return col, true
+		}
+	}
+	return config.Column{}, false
+}
+
+// matches reports whether a directory name looks like the column: its
+// name with spaces, hyphens and underscores ignored.
+func matches(dir string, col config.Column) bool {
+	return normalize(dir) == normalize(col.Name) || normalize(dir) == normalize(col.Dir)
+}
+
+func normalize(s string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(s))
+}
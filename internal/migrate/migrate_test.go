@@ -0,0 +1,108 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/user/kanban-tui/internal/agent"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/plugins"
+	"github.com/user/kanban-tui/internal/templates"
+)
+
+func TestPlanApply(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	cfg.Columns = []config.Column{
+		{Name: "To Do", Dir: "todo"},
+		{Name: "In Progress", Dir: "in-progress"},
+		{Name: "Done", Dir: "finished"},
+	}
+
+	add := func(title, dir string) *models.Ticket {
+		ticket := models.NewTicket(title, dir)
+		os.MkdirAll(filepath.Join(cfg.KanbanDir, dir), 0755)
+		path, err := ticket.UniqueFilePath(filepath.Join(cfg.KanbanDir, dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.FilePath = path
+		if err := ticket.Save(); err != nil {
+			t.Fatal(err)
+		}
+		return ticket
+	}
+	add("Write tests", "todo")
+	add("Fix auth", "in_progress")
+	shipped := add("Ship it", "done")
+	add("Old idea", "someday")
+	os.MkdirAll(filepath.Join(cfg.KanbanDir, "sessions"), 0755)
+	os.WriteFile(filepath.Join(cfg.KanbanDir, "sessions", "log.md"), nil, 0644)
+
+	// Directories are only moved when mapped, even when a column matches
+	plan, err := NewPlan(cfg, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Renames) != 0 {
+		t.Errorf("Renames = %+v, want none without -map", plan.Renames)
+	}
+	for _, o := range plan.Orphans {
+		if o.Dir == "in_progress" && o.Match != "in-progress" {
+			t.Errorf("in_progress Match = %q, want in-progress", o.Match)
+		}
+	}
+
+	plan, err = NewPlan(cfg, map[string]string{"done": "Done", "in_progress": "in-progress"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Rename{{From: "done", To: "finished", Tickets: 1}, {From: "in_progress", To: "in-progress", Tickets: 1}}
+	if len(plan.Renames) != len(want) {
+		t.Fatalf("Renames = %+v, want %+v", plan.Renames, want)
+	}
+	for i := range want {
+		if plan.Renames[i] != want[i] {
+			t.Errorf("Renames[%d] = %+v, want %+v", i, plan.Renames[i], want[i])
+		}
+	}
+	if len(plan.Orphans) != 1 || plan.Orphans[0].Dir != "someday" {
+		t.Errorf("Orphans = %+v, want someday", plan.Orphans)
+	}
+	if len(plan.Creates) != 0 {
+		t.Errorf("Creates = %v, want none", plan.Creates)
+	}
+
+	if err := plan.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(cfg.KanbanDir, "done")); !os.IsNotExist(err) {
+		t.Error("old done directory still exists")
+	}
+	moved, err := models.ParseTicket(filepath.Join(cfg.KanbanDir, "finished", shipped.Filename()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := moved.ColumnHistory[0].Column; got != "finished" {
+		t.Errorf("history column = %q, want finished", got)
+	}
+
+	// Unmatched directories can be collected into a column
+	plan, err = NewPlan(cfg, nil, "todo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Renames) != 1 || plan.Renames[0].To != "todo" || len(plan.Orphans) != 0 {
+		t.Errorf("plan = %+v, want someday moved to todo", plan)
+	}
+}
+
+func TestReservedDirs(t *testing.T) {
+	for _, name := range []string{templates.DirName, plugins.DirName, filepath.Base(filepath.Dir(agent.SessionsDir("", "x"))), models.TrashDir} {
+		if !config.IsReservedDir(name) {
+			t.Errorf("%s is not reserved", name)
+		}
+	}
+}
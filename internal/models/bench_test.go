@@ -0,0 +1,79 @@
+package models_test
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/demo"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func BenchmarkParseTicket(b *testing.B) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = b.TempDir()
+	cfg.Columns = demo.SyntheticColumns(1)
+	if err := cfg.EnsureDirectories(); err != nil {
+		b.Fatal(err)
+	}
+	if err := demo.Synthetic(cfg, 1, 1); err != nil {
+		b.Fatal(err)
+	}
+	entries, _ := os.ReadDir(cfg.ColumnPath("col-1"))
+	path := filepath.Join(cfg.ColumnPath("col-1"), entries[0].Name())
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := models.ParseTicket(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseTicketHeader(b *testing.B) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = b.TempDir()
+	cfg.Columns = demo.SyntheticColumns(1)
+	if err := cfg.EnsureDirectories(); err != nil {
+		b.Fatal(err)
+	}
+	if err := demo.Synthetic(cfg, 1, 1); err != nil {
+		b.Fatal(err)
+	}
+	entries, _ := os.ReadDir(cfg.ColumnPath("col-1"))
+	path := filepath.Join(cfg.ColumnPath("col-1"), entries[0].Name())
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := models.ParseTicketHeader(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseTicketContent(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	ticket := demo.SyntheticTicket(rng, 3, demo.SyntheticColumns(5), time.Now())
+	data := ticket.ToMarkdown()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		if _, err := models.ParseTicketContent(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkToMarkdown(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	ticket := demo.SyntheticTicket(rng, 3, demo.SyntheticColumns(5), time.Now())
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ticket.ToMarkdown()
+	}
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ChecklistItem is a markdown task list item ("- [ ] text") in ticket content.
+type ChecklistItem struct {
+	Text string
+	Done bool
+	// Line is the zero-based line index within the content
+	Line int
+}
+
+var checklistRe = regexp.MustCompile(`^\s*[-*+] \[([ xX])\] (.+)$`)
+
+// Checklist returns the task list items in the ticket content.
+func (t *Ticket) Checklist() []ChecklistItem {
+	var items []ChecklistItem
+	for i, line := range strings.Split(t.Content, "\n") {
+		if match := checklistRe.FindStringSubmatch(line); match != nil {
+			items = append(items, ChecklistItem{
+				Text: strings.TrimSpace(match[2]),
+				Done: match[1] != " ",
+				Line: i,
+			})
+		}
+	}
+	return items
+}
+
+// Relink points the ticket's references to a ticket renamed from oldName to
+// newName at the new name: its parent field and [[oldName]] links in the
+// content. It reports whether anything changed.
+func (t *Ticket) Relink(oldName, newName string) bool {
+	changed := false
+	if t.Parent == oldName {
+		t.Parent = newName
+		changed = true
+	}
+	if link := "[[" + oldName + "]]"; strings.Contains(t.Content, link) {
+		t.Content = strings.ReplaceAll(t.Content, link, "[["+newName+"]]")
+		changed = true
+	}
+	return changed
+}
+
+// ReplaceLines replaces the given content lines (by index) with new text.
+func (t *Ticket) ReplaceLines(replacements map[int]string) {
+	lines := strings.Split(t.Content, "\n")
+	for i, text := range replacements {
+		if i >= 0 && i < len(lines) {
+			lines[i] = text
+		}
+	}
+	t.Content = strings.Join(lines, "\n")
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"log/slog"
+	"path/filepath"
+	"regexp"
+)
+
+var (
+	// syncthingCopy matches "name.sync-conflict-20250102-030405-ABCDEFG.md"
+	syncthingCopy = regexp.MustCompile(`^(.+)\.sync-conflict-\d{8}-\d{6}(?:-[A-Z0-9]+)?(\.md)$`)
+	// dropboxCopy matches "name (conflicted copy).md" and variants such as
+	// "name (Ana's conflicted copy 2025-01-02).md"
+	dropboxCopy = regexp.MustCompile(`^(.+) \([^()]*conflicted copy[^()]*\)(\.md)$`)
+)
+
+// ConflictOriginal returns the name of the file a sync conflict copy was
+// made from, and whether name is a conflict copy at all.
+func ConflictOriginal(name string) (string, bool) {
+	for _, re := range []*regexp.Regexp{syncthingCopy, dropboxCopy} {
+		if m := re.FindStringSubmatch(name); m != nil {
+			return m[1] + m[2], true
+		}
+	}
+	return "", false
+}
+
+// TicketNames returns the names of the ticket files in a column directory
+// of Files: its markdown files, without sync conflict copies, which are
+// resolved separately instead of counting as duplicate tickets.
+func TicketNames(dir string) ([]string, error) {
+	names, err := Files.List(dir)
+	if err != nil {
+		return nil, err
+	}
+	var tickets []string
+	for _, name := range names {
+		if _, isCopy := ConflictOriginal(name); filepath.Ext(name) == ".md" && !isCopy {
+			tickets = append(tickets, name)
+		}
+	}
+	return tickets, nil
+}
+
+// ReadColumn parses the tickets in a column directory (see TicketNames).
+// Files that fail to parse are logged and skipped.
+func ReadColumn(dir string) ([]*Ticket, error) {
+	names, err := TicketNames(dir)
+	if err != nil {
+		return nil, err
+	}
+	tickets := []*Ticket{}
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		ticket, err := ParseTicket(path)
+		if err != nil {
+			slog.Warn("skipping unreadable ticket", "path", path, "err", err)
+			continue
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets, nil
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	criteriaHeadingRe = regexp.MustCompile(`(?i)^#{1,6}\s+acceptance criteria\s*:?\s*$`)
+	headingRe         = regexp.MustCompile(`^#{1,6}\s`)
+	bulletRe          = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(.+)$`)
+)
+
+// AcceptanceCriteria returns the ticket's criteria: the frontmatter criteria
+// list followed by the items of an "Acceptance Criteria" section in the
+// content. Section items may be task list items or plain bullets; Line is -1
+// for frontmatter criteria.
+func (t *Ticket) AcceptanceCriteria() []ChecklistItem {
+	var items []ChecklistItem
+	for _, c := range t.Criteria {
+		if c = strings.TrimSpace(c); c != "" {
+			items = append(items, ChecklistItem{Text: c, Line: -1})
+		}
+	}
+
+	inSection := false
+	for i, line := range strings.Split(t.Content, "\n") {
+		switch {
+		case criteriaHeadingRe.MatchString(strings.TrimSpace(line)):
+			inSection = true
+		case headingRe.MatchString(line):
+			inSection = false
+		case !inSection:
+		case checklistRe.MatchString(line):
+			match := checklistRe.FindStringSubmatch(line)
+			items = append(items, ChecklistItem{Text: strings.TrimSpace(match[2]), Done: match[1] != " ", Line: i})
+		case bulletRe.MatchString(line):
+			match := bulletRe.FindStringSubmatch(line)
+			items = append(items, ChecklistItem{Text: strings.TrimSpace(match[1]), Line: i})
+		}
+	}
+	return items
+}
@@ -0,0 +1,36 @@
+package models
+
+import "testing"
+
+func TestAcceptanceCriteria(t *testing.T) {
+	ticket := &Ticket{
+		Criteria: []string{"Login works with SSO", " "},
+		Content: `Fix the auth flow.
+
+- [ ] not a criterion
+
+## Acceptance Criteria
+- [x] Tokens refresh before expiry
+- [ ] Logout clears the session
+1. Errors are logged
+
+## Notes
+- unrelated`,
+	}
+
+	got := ticket.AcceptanceCriteria()
+	want := []ChecklistItem{
+		{Text: "Login works with SSO", Line: -1},
+		{Text: "Tokens refresh before expiry", Done: true, Line: 5},
+		{Text: "Logout clears the session", Line: 6},
+		{Text: "Errors are logged", Line: 7},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("AcceptanceCriteria() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
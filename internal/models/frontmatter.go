@@ -0,0 +1,102 @@
+package models
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+)
+
+// maxFrontmatterSize bounds how far into a file the closing "---" is
+// looked for; ticket bodies after the frontmatter may be of any size.
+const maxFrontmatterSize = 256 << 10
+
+// Line endings and the byte order mark of files saved by Windows editors,
+// normalized away on parse and restored on write.
+var (
+	utf8BOM = []byte("\xef\xbb\xbf")
+	crlf    = []byte("\r\n")
+	lf      = []byte("\n")
+)
+
+// errFrontmatterTooLarge stops the scan for a closing delimiter.
+var errFrontmatterTooLarge = errors.New("frontmatter too large")
+
+// ParseTicketHeader reads only the frontmatter of a ticket file, leaving
+// Content empty. Use it to list tickets without reading their bodies.
+func ParseTicketHeader(path string) (*Ticket, error) {
+	f, err := Files.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ticket, err := parseTicket(bufio.NewReader(f), false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	ticket.FilePath = path
+	ticket.Column = filepath.Base(filepath.Dir(path))
+	return ticket, nil
+}
+
+// readFrontmatter reads the YAML block between "---" lines at the start of
+// r, after any blank lines, and leaves r positioned at the content. When
+// the input doesn't open with "---", or the closing "---" is missing within
+// maxFrontmatterSize, ok is false and read holds everything consumed so the
+// caller can treat it as content.
+func readFrontmatter(r *bufio.Reader) (frontmatter, read []byte, ok bool, err error) {
+	var fm bytes.Buffer
+	opened := false
+	for {
+		line, err := readLine(r, maxFrontmatterSize-len(read))
+		read = append(read, line...)
+		if err != nil && err != io.EOF && err != errFrontmatterTooLarge {
+			return nil, read, false, err
+		}
+
+		if err != errFrontmatterTooLarge {
+			trimmed := bytes.TrimSpace(line)
+			switch {
+			case !opened && len(trimmed) == 0:
+				// Blank lines before the opening delimiter are ignored
+			case !opened && string(trimmed) == "---":
+				opened = true
+			case !opened:
+				return nil, read, false, nil
+			case string(trimmed) == "---":
+				return fm.Bytes(), read, true, nil
+			default:
+				fm.Write(line)
+			}
+		}
+
+		if err != nil {
+			if opened {
+				slog.Warn("frontmatter has no closing delimiter", "bytes", len(read))
+			}
+			return nil, read, false, nil
+		}
+	}
+}
+
+// readLine reads one line including its newline, handling lines longer than
+// the reader's buffer. It returns errFrontmatterTooLarge once more than limit
+// bytes were read, and io.EOF with the final unterminated line.
+func readLine(r *bufio.Reader, limit int) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, err := r.ReadSlice('\n')
+		line = append(line, chunk...)
+		if len(line) > limit {
+			return line, errFrontmatterTooLarge
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		return line, err
+	}
+}
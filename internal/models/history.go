@@ -0,0 +1,166 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ColumnEntry records when a ticket entered a column.
+type ColumnEntry struct {
+	Column  string    `yaml:"column"`
+	Entered time.Time `yaml:"entered"`
+	// Skipped lists the column's entry checklist items that were not
+	// confirmed when the ticket entered it
+	Skipped []string `yaml:"skipped,omitempty"`
+	// Reason is why the ticket was moved into the column, asked for on
+	// the transitions listed in the move_reasons config
+	Reason string `yaml:"reason,omitempty"`
+}
+
+// SetSkippedChecks records the checklist items skipped when the ticket
+// entered its current column.
+func (t *Ticket) SetSkippedChecks(items []string) {
+	if len(t.ColumnHistory) > 0 {
+		t.ColumnHistory[len(t.ColumnHistory)-1].Skipped = items
+	}
+}
+
+// SkippedChecks returns the checklist items skipped when the ticket entered
+// its current column.
+func (t *Ticket) SkippedChecks() []string {
+	if len(t.ColumnHistory) == 0 {
+		return nil
+	}
+	last := t.ColumnHistory[len(t.ColumnHistory)-1]
+	if last.Column != t.Column {
+		return nil
+	}
+	return last.Skipped
+}
+
+// SetMoveReason records why the ticket entered its current column.
+func (t *Ticket) SetMoveReason(reason string) {
+	if len(t.ColumnHistory) > 0 {
+		t.ColumnHistory[len(t.ColumnHistory)-1].Reason = reason
+	}
+}
+
+// MoveReason returns why the ticket entered its current column, if a reason
+// was given.
+func (t *Ticket) MoveReason() string {
+	if len(t.ColumnHistory) == 0 {
+		return ""
+	}
+	last := t.ColumnHistory[len(t.ColumnHistory)-1]
+	if last.Column != t.Column {
+		return ""
+	}
+	return last.Reason
+}
+
+// EnteredCurrentColumn returns when the ticket entered its current column.
+// Tickets that were never moved are considered to have entered at creation.
+func (t *Ticket) EnteredCurrentColumn() time.Time {
+	if !t.MovedAt.IsZero() {
+		return t.MovedAt
+	}
+	return t.Created
+}
+
+// TimeInColumn returns how long the ticket has been in its current column.
+func (t *Ticket) TimeInColumn(now time.Time) time.Duration {
+	return now.Sub(t.EnteredCurrentColumn())
+}
+
+// FirstEntered returns the first time the ticket entered the given column,
+// or the zero time if it never did.
+func (t *Ticket) FirstEntered(column string) time.Time {
+	for _, e := range t.ColumnHistory {
+		if e.Column == column {
+			return e.Entered
+		}
+	}
+	return time.Time{}
+}
+
+// LastEntered returns the most recent time the ticket entered the given
+// column, or the zero time if it never did.
+func (t *Ticket) LastEntered(column string) time.Time {
+	for i := len(t.ColumnHistory) - 1; i >= 0; i-- {
+		if t.ColumnHistory[i].Column == column {
+			return t.ColumnHistory[i].Entered
+		}
+	}
+	return time.Time{}
+}
+
+// recordColumnEntry stamps the ticket as having entered column at the given time.
+func (t *Ticket) recordColumnEntry(column string, at time.Time) {
+	t.MovedAt = at
+	t.ColumnHistory = append(t.ColumnHistory, ColumnEntry{Column: column, Entered: at})
+}
+
+// NeedsReview reports whether the ticket changed after it was last reviewed.
+func (t *Ticket) NeedsReview() bool {
+	return t.ReviewedAt.IsZero() || t.ReviewedAt.Before(t.EnteredCurrentColumn())
+}
+
+// AppendNote appends a dated note section to the ticket content.
+func (t *Ticket) AppendNote(heading, note string, at time.Time) {
+	section := fmt.Sprintf("## %s (%s)", heading, at.Format("2006-01-02 15:04"))
+	if note != "" {
+		section += "\n\n" + note
+	}
+	if t.Content == "" {
+		t.Content = section
+		return
+	}
+	t.Content += "\n\n" + section
+}
+
+// MergeFrom folds src into t: content is concatenated under a provenance
+// note, tags and context files are unioned, and the earliest created date wins.
+func (t *Ticket) MergeFrom(src *Ticket, at time.Time) {
+	note := fmt.Sprintf("Merged from %q (%s)", src.Title, src.Filename())
+	if src.Content != "" {
+		note += "\n\n" + src.Content
+	}
+	t.AppendNote("Merged", note, at)
+
+	t.Tags = unionStrings(t.Tags, src.Tags)
+	t.ContextFiles = unionStrings(t.ContextFiles, src.ContextFiles)
+	if src.Created.Before(t.Created) {
+		t.Created = src.Created
+	}
+	if t.AgentFeedback == "" {
+		t.AgentFeedback = src.AgentFeedback
+	}
+}
+
+// unionStrings appends the values of b missing from a, preserving order.
+func unionStrings(a, b []string) []string {
+	seen := make(map[string]bool, len(a))
+	for _, s := range a {
+		seen[s] = true
+	}
+	for _, s := range b {
+		if !seen[s] {
+			a = append(a, s)
+			seen[s] = true
+		}
+	}
+	return a
+}
+
+// RenameColumn rewrites column history entries for oldDir to newDir, e.g.
+// after a column directory was renamed. It reports whether anything changed.
+func (t *Ticket) RenameColumn(oldDir, newDir string) bool {
+	changed := false
+	for i := range t.ColumnHistory {
+		if t.ColumnHistory[i].Column == oldDir {
+			t.ColumnHistory[i].Column = newDir
+			changed = true
+		}
+	}
+	return changed
+}
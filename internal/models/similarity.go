@@ -0,0 +1,74 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DuplicateThreshold is the minimum title similarity treated as a likely duplicate.
+const DuplicateThreshold = 0.85
+
+// NormalizeTitle lowercases a title and reduces it to letters and digits
+// separated by single spaces, for fuzzy comparison.
+func NormalizeTitle(title string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && b.Len() > 0 {
+				b.WriteRune(' ')
+			}
+			b.WriteRune(r)
+			space = false
+		} else {
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// TitleSimilarity returns a score between 0 and 1 for how alike two titles
+// are, based on the Levenshtein distance of their normalized forms.
+func TitleSimilarity(a, b string) float64 {
+	ra := []rune(NormalizeTitle(a))
+	rb := []rune(NormalizeTitle(b))
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// FindSimilar returns the ticket whose title is most similar to title, if
+// the similarity reaches DuplicateThreshold.
+func FindSimilar(title string, tickets []*Ticket) *Ticket {
+	var best *Ticket
+	bestScore := DuplicateThreshold
+	for _, t := range tickets {
+		if score := TitleSimilarity(title, t.Title); score >= bestScore {
+			best, bestScore = t, score
+		}
+	}
+	return best
+}
+
+// levenshtein computes the edit distance between two rune slices.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
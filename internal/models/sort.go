@@ -0,0 +1,69 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Ticket sort orders.
+const (
+	SortByUpdated = "updated"
+	SortByCreated = "created"
+	SortByTitle   = "title"
+)
+
+// SortTickets orders tickets in place. Titles are compared with the collation
+// rules of locale (a BCP 47 tag such as "de" or "sv"); dates sort newest first.
+func SortTickets(tickets []*Ticket, by, locale string) {
+	switch by {
+	case SortByTitle:
+		col := collate.New(parseLocale(locale), collate.IgnoreCase)
+		sort.SliceStable(tickets, func(i, j int) bool {
+			return col.CompareString(tickets[i].Title, tickets[j].Title) < 0
+		})
+	case SortByCreated:
+		sort.SliceStable(tickets, func(i, j int) bool {
+			return tickets[i].Created.After(tickets[j].Created)
+		})
+	default:
+		sort.SliceStable(tickets, func(i, j int) bool {
+			return tickets[i].Updated.After(tickets[j].Updated)
+		})
+	}
+}
+
+// parseLocale parses a BCP 47 tag, falling back to undetermined (root collation).
+func parseLocale(locale string) language.Tag {
+	if locale == "" {
+		return language.Und
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
+
+// FoldForSearch case-folds s and strips diacritics so that, for example,
+// "ubersicht" matches "Übersicht" and "strasse" matches "Straße".
+func FoldForSearch(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		folded = s
+	}
+	return cases.Fold().String(folded)
+}
+
+// MatchesQuery reports whether text contains query, ignoring case and diacritics.
+func MatchesQuery(text, query string) bool {
+	return strings.Contains(FoldForSearch(text), FoldForSearch(query))
+}
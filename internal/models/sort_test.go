@@ -0,0 +1,51 @@
+package models
+
+import "testing"
+
+func TestMatchesQuery(t *testing.T) {
+	tests := []struct {
+		text, query string
+		want        bool
+	}{
+		{"Übersicht öffnen", "ubersicht", true},
+		{"Fix login", "LOGIN", true},
+		{"Straße umbenennen", "strasse", true},
+		{"Café menu", "cafe", true},
+		{"Deploy", "review", false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchesQuery(tt.text, tt.query); got != tt.want {
+			t.Errorf("MatchesQuery(%q, %q) = %v, want %v", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSortTicketsByTitleUsesLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   []string
+	}{
+		{"de", []string{"apple", "Ärlig", "zebra"}},
+		{"sv", []string{"apple", "zebra", "Ärlig"}},
+	}
+
+	for _, tt := range tests {
+		tickets := []*Ticket{{Title: "zebra"}, {Title: "Ärlig"}, {Title: "apple"}}
+		SortTickets(tickets, SortByTitle, tt.locale)
+		for i, title := range tt.want {
+			if tickets[i].Title != title {
+				t.Errorf("%s order = %v, want %v", tt.locale, titles(tickets), tt.want)
+				break
+			}
+		}
+	}
+}
+
+func titles(tickets []*Ticket) []string {
+	var out []string
+	for _, t := range tickets {
+		out = append(out, t.Title)
+	}
+	return out
+}
@@ -4,17 +4,25 @@ package models
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
-	"os"
+	"io"
+	"log/slog"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 	"unicode"
 
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/store"
 	"gopkg.in/yaml.v3"
 )
 
+// Files is the store ticket files are read from and written to: the local
+// file system unless a program or test installs another backend.
+var Files store.BoardStore = store.OS{}
+
 // Ticket represents a kanban ticket.
 type Ticket struct {
 	// Metadata from frontmatter
@@ -24,6 +32,73 @@ type Ticket struct {
 	Updated       time.Time `yaml:"updated"`
 	AgentFeedback string    `yaml:"agent_feedback,omitempty"`
 
+	// Color is an optional hex color (e.g. "#83a598") tinting the ticket's card
+	Color string `yaml:"color,omitempty"`
+
+	// Cover is an optional image path shown as a preview in the ticket view
+	Cover string `yaml:"cover,omitempty"`
+
+	// ContextFiles lists code paths (relative to the project root) relevant to the ticket
+	ContextFiles []string `yaml:"context_files,omitempty"`
+
+	// Parent is the filename of the ticket this one was split from (its epic)
+	Parent string `yaml:"parent,omitempty"`
+
+	// Branch is the git branch created for the ticket
+	Branch string `yaml:"branch,omitempty"`
+
+	// Worktree is the path of the git worktree created for the ticket
+	Worktree string `yaml:"worktree,omitempty"`
+
+	// Commits lists short hashes of commits whose messages reference the ticket
+	Commits []string `yaml:"commits,omitempty"`
+
+	// PR is the ticket's pull request: a URL, "owner/repo#123" or a number
+	PR string `yaml:"pr,omitempty"`
+	// Issue is the URL of the GitHub issue created from the ticket
+	Issue string `yaml:"issue,omitempty"`
+
+	// Source is where an imported ticket came from: a code comment's file:line
+	// or a failing test's package.Test
+	Source string `yaml:"source,omitempty"`
+	// ScanID is a stable hash of the imported item, used to deduplicate imports
+	ScanID string `yaml:"scan_id,omitempty"`
+
+	// ReviewedAt is when a human last accepted the agent's work
+	ReviewedAt time.Time `yaml:"reviewed_at,omitempty"`
+	// ReviewedBy is who approved the work in the review column
+	ReviewedBy string `yaml:"reviewed_by,omitempty"`
+
+	// ReopenedCount counts how often the ticket was reopened after completion
+	ReopenedCount int `yaml:"reopened_count,omitempty"`
+
+	// Attempts counts the agent runs dispatched for the ticket
+	Attempts int `yaml:"attempts,omitempty"`
+
+	// Criteria are acceptance criteria, in addition to any "Acceptance
+	// Criteria" section in the content
+	Criteria []string `yaml:"criteria,omitempty"`
+	// AgentInstructions are extra constraints for agents working on this
+	// ticket, appended to its rendered prompts
+	AgentInstructions string `yaml:"agent_instructions,omitempty"`
+
+	// Priority orders the today view: 1 is the most urgent, 0 means unset
+	Priority int `yaml:"priority,omitempty"`
+	// Due is the date the ticket is due, as YYYY-MM-DD
+	Due string `yaml:"due,omitempty"`
+	// SnoozedUntil hides the ticket from the board until this date, as
+	// YYYY-MM-DD
+	SnoozedUntil string `yaml:"snoozed_until,omitempty"`
+
+	// MovedAt is when the ticket entered its current column
+	MovedAt time.Time `yaml:"moved_at,omitempty"`
+	// ColumnHistory lists every column entry in order
+	ColumnHistory []ColumnEntry `yaml:"column_history,omitempty"`
+
+	// Private holds notes encrypted with the board key (see package
+	// secret); it is kept sealed here and only opened for display
+	Private string `yaml:"private,omitempty"`
+
 	// Content is the markdown body (excluding frontmatter)
 	Content string `yaml:"-"`
 
@@ -32,28 +107,35 @@ type Ticket struct {
 
 	// Column is the directory name of the column this ticket belongs to
 	Column string `yaml:"-"`
+
+	// crlf and bom record a file saved with Windows line endings or a
+	// UTF-8 byte order mark, so writing it back keeps that style
+	crlf bool
+	bom  bool
 }
 
 // NewTicket creates a new ticket with default values.
 func NewTicket(title, column string) *Ticket {
 	now := time.Now()
 	return &Ticket{
-		Title:   title,
-		Tags:    []string{},
-		Created: now,
-		Updated: now,
-		Column:  column,
+		Title:         title,
+		Tags:          []string{},
+		Created:       now,
+		Updated:       now,
+		Column:        column,
+		ColumnHistory: []ColumnEntry{{Column: column, Entered: now}},
 	}
 }
 
 // ParseTicket reads a markdown file and parses it into a Ticket.
 func ParseTicket(path string) (*Ticket, error) {
-	data, err := os.ReadFile(path)
+	f, err := Files.Read(path)
 	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
 
-	ticket, err := ParseTicketContent(data)
+	ticket, err := parseTicket(bufio.NewReader(f), true)
 	if err != nil {
 		return nil, fmt.Errorf("parsing %s: %w", path, err)
 	}
@@ -66,20 +148,44 @@ func ParseTicket(path string) (*Ticket, error) {
 
 // ParseTicketContent parses ticket content from bytes.
 func ParseTicketContent(data []byte) (*Ticket, error) {
+	return parseTicket(bufio.NewReader(bytes.NewReader(data)), true)
+}
+
+// parseTicket parses the frontmatter at the start of r and, when
+// withContent is set, the markdown body after it. Input without complete
+// frontmatter is read entirely as content rather than rejected. A leading
+// byte order mark and CRLF line endings are normalized away.
+func parseTicket(r *bufio.Reader, withContent bool) (*Ticket, error) {
 	ticket := &Ticket{}
 
-	frontmatter, content, err := splitFrontmatter(data)
+	if prefix, _ := r.Peek(len(utf8BOM)); bytes.Equal(prefix, utf8BOM) {
+		r.Discard(len(utf8BOM))
+		ticket.bom = true
+	}
+
+	frontmatter, read, ok, err := readFrontmatter(r)
 	if err != nil {
 		return nil, err
 	}
+	ticket.crlf = bytes.Contains(read, crlf)
 
 	if len(frontmatter) > 0 {
+		frontmatter = bytes.ReplaceAll(frontmatter, crlf, lf)
 		if err := yaml.Unmarshal(frontmatter, ticket); err != nil {
 			return nil, fmt.Errorf("parsing frontmatter: %w", err)
 		}
 	}
 
-	ticket.Content = strings.TrimSpace(string(content))
+	if withContent {
+		rest, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			rest = append(read, rest...)
+		}
+		ticket.Content = strings.TrimSpace(string(bytes.ReplaceAll(rest, crlf, lf)))
+	}
 
 	// Set defaults for missing values
 	if ticket.Created.IsZero() {
@@ -92,41 +198,6 @@ func ParseTicketContent(data []byte) (*Ticket, error) {
 	return ticket, nil
 }
 
-// splitFrontmatter separates YAML frontmatter from markdown content.
-func splitFrontmatter(data []byte) (frontmatter, content []byte, err error) {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	var inFrontmatter bool
-	var fmLines, contentLines []string
-
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if !inFrontmatter && strings.TrimSpace(line) == "---" {
-			inFrontmatter = true
-			continue
-		}
-
-		if inFrontmatter {
-			if strings.TrimSpace(line) == "---" {
-				inFrontmatter = false
-				continue
-			}
-			fmLines = append(fmLines, line)
-		} else {
-			contentLines = append(contentLines, line)
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, nil, err
-	}
-
-	frontmatter = []byte(strings.Join(fmLines, "\n"))
-	content = []byte(strings.Join(contentLines, "\n"))
-
-	return frontmatter, content, nil
-}
-
 // ToMarkdown converts the ticket to markdown format with frontmatter.
 func (t *Ticket) ToMarkdown() []byte {
 	var buf bytes.Buffer
@@ -134,21 +205,7 @@ func (t *Ticket) ToMarkdown() []byte {
 	// Write frontmatter
 	buf.WriteString("---\n")
 
-	fm := struct {
-		Title         string    `yaml:"title"`
-		Tags          []string  `yaml:"tags,omitempty"`
-		Created       time.Time `yaml:"created"`
-		Updated       time.Time `yaml:"updated"`
-		AgentFeedback string    `yaml:"agent_feedback,omitempty"`
-	}{
-		Title:         t.Title,
-		Tags:          t.Tags,
-		Created:       t.Created,
-		Updated:       t.Updated,
-		AgentFeedback: t.AgentFeedback,
-	}
-
-	fmData, _ := yaml.Marshal(fm)
+	fmData, _ := yaml.Marshal(t.frontmatter())
 	buf.Write(fmData)
 	buf.WriteString("---\n\n")
 
@@ -161,6 +218,86 @@ func (t *Ticket) ToMarkdown() []byte {
 	return buf.Bytes()
 }
 
+// ToJSON converts the ticket to indented JSON: the frontmatter fields under
+// their YAML names, plus its content, column and file name.
+func (t *Ticket) ToJSON() ([]byte, error) {
+	data, err := yaml.Marshal(t.frontmatter())
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["content"] = t.Content
+	fields["column"] = t.Column
+	fields["file"] = t.Filename()
+	return json.MarshalIndent(fields, "", "  ")
+}
+
+// frontmatter returns the ticket's frontmatter fields in file order.
+func (t *Ticket) frontmatter() any {
+	return struct {
+		Title             string        `yaml:"title"`
+		Tags              []string      `yaml:"tags,omitempty"`
+		Created           time.Time     `yaml:"created"`
+		Updated           time.Time     `yaml:"updated"`
+		AgentFeedback     string        `yaml:"agent_feedback,omitempty"`
+		Color             string        `yaml:"color,omitempty"`
+		Cover             string        `yaml:"cover,omitempty"`
+		ContextFiles      []string      `yaml:"context_files,omitempty"`
+		Parent            string        `yaml:"parent,omitempty"`
+		Branch            string        `yaml:"branch,omitempty"`
+		Worktree          string        `yaml:"worktree,omitempty"`
+		Commits           []string      `yaml:"commits,omitempty"`
+		PR                string        `yaml:"pr,omitempty"`
+		Issue             string        `yaml:"issue,omitempty"`
+		Source            string        `yaml:"source,omitempty"`
+		ScanID            string        `yaml:"scan_id,omitempty"`
+		ReviewedAt        time.Time     `yaml:"reviewed_at,omitempty"`
+		ReviewedBy        string        `yaml:"reviewed_by,omitempty"`
+		ReopenedCount     int           `yaml:"reopened_count,omitempty"`
+		Attempts          int           `yaml:"attempts,omitempty"`
+		Criteria          []string      `yaml:"criteria,omitempty"`
+		AgentInstructions string        `yaml:"agent_instructions,omitempty"`
+		Priority          int           `yaml:"priority,omitempty"`
+		Due               string        `yaml:"due,omitempty"`
+		SnoozedUntil      string        `yaml:"snoozed_until,omitempty"`
+		MovedAt           time.Time     `yaml:"moved_at,omitempty"`
+		ColumnHistory     []ColumnEntry `yaml:"column_history,omitempty"`
+		Private           string        `yaml:"private,omitempty"`
+	}{
+		Title:             t.Title,
+		Tags:              t.Tags,
+		Created:           t.Created,
+		Updated:           t.Updated,
+		AgentFeedback:     t.AgentFeedback,
+		Color:             t.Color,
+		Cover:             t.Cover,
+		ContextFiles:      t.ContextFiles,
+		Parent:            t.Parent,
+		Branch:            t.Branch,
+		Worktree:          t.Worktree,
+		Commits:           t.Commits,
+		PR:                t.PR,
+		Issue:             t.Issue,
+		Source:            t.Source,
+		ScanID:            t.ScanID,
+		ReviewedAt:        t.ReviewedAt,
+		ReviewedBy:        t.ReviewedBy,
+		ReopenedCount:     t.ReopenedCount,
+		Attempts:          t.Attempts,
+		Criteria:          t.Criteria,
+		AgentInstructions: t.AgentInstructions,
+		Priority:          t.Priority,
+		Due:               t.Due,
+		SnoozedUntil:      t.SnoozedUntil,
+		MovedAt:           t.MovedAt,
+		ColumnHistory:     t.ColumnHistory,
+		Private:           t.Private,
+	}
+}
+
 // Save writes the ticket to its file path.
 func (t *Ticket) Save() error {
 	if t.FilePath == "" {
@@ -168,14 +305,26 @@ func (t *Ticket) Save() error {
 	}
 
 	t.Updated = time.Now()
-	data := t.ToMarkdown()
+	return t.WriteFile()
+}
 
-	dir := filepath.Dir(t.FilePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return err
+// WriteFile writes the ticket to its file path as-is, without touching the
+// updated timestamp.
+func (t *Ticket) WriteFile() error {
+	if t.FilePath == "" {
+		return fmt.Errorf("ticket has no file path")
 	}
 
-	return os.WriteFile(t.FilePath, data, 0644)
+	data := t.ToMarkdown()
+	if t.crlf {
+		data = bytes.ReplaceAll(data, lf, crlf)
+	}
+	if t.bom {
+		data = append(append([]byte{}, utf8BOM...), data...)
+	}
+
+	slog.Debug("write ticket", "path", t.FilePath, "bytes", len(data))
+	return Files.Write(t.FilePath, data)
 }
 
 // Delete removes the ticket file.
@@ -183,7 +332,51 @@ func (t *Ticket) Delete() error {
 	if t.FilePath == "" {
 		return fmt.Errorf("ticket has no file path")
 	}
-	return os.Remove(t.FilePath)
+	slog.Debug("delete ticket", "path", t.FilePath)
+	return Files.Delete(t.FilePath)
+}
+
+// TrashDir is the directory within a board that trashed tickets are moved to.
+const TrashDir = ".trash"
+
+// Trash moves the ticket file into the board's trash directory, keeping it
+// recoverable, and returns its new path.
+func (t *Ticket) Trash(kanbanDir string) (string, error) {
+	if t.FilePath == "" {
+		return "", fmt.Errorf("ticket has no file path")
+	}
+	path, err := UniquePath(filepath.Join(kanbanDir, TrashDir), filepath.Base(t.FilePath))
+	if err != nil {
+		return "", err
+	}
+	slog.Debug("trash ticket", "from", t.FilePath, "to", path)
+	if err := Files.Move(t.FilePath, path); err != nil {
+		return "", err
+	}
+	t.FilePath = path
+	return path, nil
+}
+
+// Filename returns the base name of the ticket file, which identifies the
+// ticket across column moves.
+func (t *Ticket) Filename() string {
+	return filepath.Base(t.FilePath)
+}
+
+// Slug returns the ticket's filename without the .md extension, e.g.
+// "2025-01-15-fix-auth".
+func (t *Ticket) Slug() string {
+	return strings.TrimSuffix(t.Filename(), ".md")
+}
+
+// TitleSlug returns the slugified title, e.g. "fix-auth".
+func (t *Ticket) TitleSlug() string {
+	return slugify(t.Title)
+}
+
+// KanbanDir returns the board directory containing the ticket's column.
+func (t *Ticket) KanbanDir() string {
+	return filepath.Dir(filepath.Dir(t.FilePath))
 }
 
 // GenerateFilename creates a filename for the ticket based on date and title.
@@ -193,6 +386,37 @@ func (t *Ticket) GenerateFilename() string {
 	return fmt.Sprintf("%s-%s.md", date, slug)
 }
 
+// UniqueFilePath returns a path in dir for the ticket that does not collide
+// with an existing file, appending a numeric suffix (-2, -3, ...) when needed.
+func (t *Ticket) UniqueFilePath(dir string) (string, error) {
+	return UniquePath(dir, t.GenerateFilename())
+}
+
+// UniquePath returns dir/name, or dir/name with a numeric suffix before the
+// extension if that file already exists. Listing dir must succeed, or the
+// path could collide with a file that wasn't seen.
+func UniquePath(dir, name string) (string, error) {
+	names, err := Files.List(dir)
+	if err != nil {
+		return "", err
+	}
+	existing := make(map[string]bool)
+	for _, n := range names {
+		existing[n] = true
+	}
+	if !existing[name] {
+		return filepath.Join(dir, name), nil
+	}
+
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	for i := 2; ; i++ {
+		if candidate := fmt.Sprintf("%s-%d%s", stem, i, ext); !existing[candidate] {
+			return filepath.Join(dir, candidate), nil
+		}
+	}
+}
+
 // slugify converts a string to a URL-friendly slug.
 func slugify(s string) string {
 	s = strings.ToLower(s)
@@ -217,9 +441,9 @@ func slugify(s string) string {
 	// Trim hyphens from ends
 	s = strings.Trim(s, "-")
 
-	// Limit length
-	if len(s) > 50 {
-		s = s[:50]
+	// Limit length without splitting multi-byte characters
+	if runes := []rune(s); len(runes) > 50 {
+		s = string(runes[:50])
 		// Don't end with a hyphen
 		s = strings.TrimSuffix(s, "-")
 	}
@@ -231,36 +455,103 @@ func slugify(s string) string {
 	return s
 }
 
-// Move moves the ticket to a different column.
+// Move moves the ticket to a different column and records the column entry
+// time. The ticket is re-read after the rename so edits made to the file
+// since it was loaded survive, and the updated timestamp is left alone.
 func (t *Ticket) Move(kanbanDir, newColumn string) error {
 	if t.FilePath == "" {
 		return fmt.Errorf("ticket has no file path")
 	}
 
 	oldPath := t.FilePath
-	newDir := filepath.Join(kanbanDir, newColumn)
-	newPath := filepath.Join(newDir, filepath.Base(t.FilePath))
 
-	// Ensure target directory exists
-	if err := os.MkdirAll(newDir, 0755); err != nil {
+	// Never overwrite a ticket with the same filename in the target column
+	newPath, err := UniquePath(filepath.Join(kanbanDir, newColumn), filepath.Base(t.FilePath))
+	if err != nil {
 		return err
 	}
 
 	// Move the file
-	if err := os.Rename(oldPath, newPath); err != nil {
+	slog.Debug("move ticket", "from", oldPath, "to", newPath)
+	if err := Files.Move(oldPath, newPath); err != nil {
 		return err
 	}
 
-	t.FilePath = newPath
-	t.Column = newColumn
+	moved, err := ParseTicket(newPath)
+	if err != nil {
+		return err
+	}
+	moved.Column = newColumn
+
+	// Stamp the column entry; if the ticket predates history tracking,
+	// seed it with the original column at creation time
+	if len(moved.ColumnHistory) == 0 {
+		moved.ColumnHistory = append(moved.ColumnHistory, ColumnEntry{
+			Column:  filepath.Base(filepath.Dir(oldPath)),
+			Entered: moved.Created,
+		})
+	}
+	moved.recordColumnEntry(newColumn, time.Now())
 
+	if err := moved.WriteFile(); err != nil {
+		return err
+	}
+	*t = *moved
 	return nil
 }
 
-// ShortTitle returns a truncated title for display.
-func (t *Ticket) ShortTitle(maxLen int) string {
-	if len(t.Title) <= maxLen {
-		return t.Title
+// HasTag reports whether the ticket has tag.
+func (t *Ticket) HasTag(tag string) bool {
+	for _, existing := range t.Tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds tag unless the ticket already has it.
+func (t *Ticket) AddTag(tag string) {
+	if !t.HasTag(tag) {
+		t.Tags = append(t.Tags, tag)
 	}
-	return t.Title[:maxLen-3] + "..."
+}
+
+// RemoveTag removes every occurrence of tag.
+func (t *Ticket) RemoveTag(tag string) {
+	tags := t.Tags[:0]
+	for _, existing := range t.Tags {
+		if existing != tag {
+			tags = append(tags, existing)
+		}
+	}
+	t.Tags = tags
+}
+
+// DueDate returns the due date at midnight local time, and false when the
+// ticket has no due date or it isn't a YYYY-MM-DD date.
+func (t *Ticket) DueDate() (time.Time, bool) {
+	return parseDay(t.Due)
+}
+
+// SnoozedAt reports whether the ticket is still snoozed at now: tickets
+// wake up at midnight local time on their snoozed_until date.
+func (t *Ticket) SnoozedAt(now time.Time) bool {
+	until, ok := parseDay(t.SnoozedUntil)
+	return ok && now.Before(until)
+}
+
+// parseDay parses a YYYY-MM-DD date as midnight local time.
+func parseDay(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	day, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	return day, err == nil
+}
+
+// ShortTitle returns the title truncated to maxWidth terminal cells, so wide
+// (CJK, emoji) and multi-byte characters are never split.
+func (t *Ticket) ShortTitle(maxWidth int) string {
+	return runewidth.Truncate(t.Title, max(maxWidth, 0), "...")
 }
@@ -0,0 +1,467 @@
+package models
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{"ascii", "Implement User Auth", "implement-user-auth"},
+		{"punctuation", "Fix: crash (again)!", "fix-crash-again"},
+		{"accents kept", "Übersicht öffnen", "übersicht-öffnen"},
+		{"cjk kept", "修复 登录", "修复-登录"},
+		{"emoji only", "🚀🔥", "untitled"},
+		{"symbols only", "!!! ???", "untitled"},
+		{"empty", "", "untitled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slugify(tt.title); got != tt.want {
+				t.Errorf("slugify(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugifyTruncatesOnRuneBoundary(t *testing.T) {
+	got := slugify(strings.Repeat("ü", 80))
+	if !utf8.ValidString(got) {
+		t.Fatalf("slugify produced invalid UTF-8: %q", got)
+	}
+	if n := utf8.RuneCountInString(got); n != 50 {
+		t.Errorf("slug length = %d runes, want 50", n)
+	}
+}
+
+func TestUniqueFilePathAvoidsCollisions(t *testing.T) {
+	dir := t.TempDir()
+	created := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	var paths []string
+	for i := 0; i < 3; i++ {
+		ticket := NewTicket("Same title", "todo")
+		ticket.Created = created
+		path, err := ticket.UniqueFilePath(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.FilePath = path
+		if err := ticket.Save(); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		paths = append(paths, filepath.Base(ticket.FilePath))
+	}
+
+	want := []string{
+		"2025-01-15-same-title.md",
+		"2025-01-15-same-title-2.md",
+		"2025-01-15-same-title-3.md",
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("path %d = %q, want %q", i, paths[i], want[i])
+		}
+	}
+}
+
+func TestUniqueFilePathForEmptySlugs(t *testing.T) {
+	dir := t.TempDir()
+
+	first := NewTicket("🎉", "todo")
+	path, err := first.UniqueFilePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first.FilePath = path
+	if err := first.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	second := NewTicket("✨✨", "todo")
+	second.Created = first.Created
+	path, err = second.UniqueFilePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second.FilePath = path
+	if second.FilePath == first.FilePath {
+		t.Fatalf("second ticket reused path %q", first.FilePath)
+	}
+	if !strings.HasSuffix(second.FilePath, "-untitled-2.md") {
+		t.Errorf("second path = %q, want -untitled-2.md suffix", second.FilePath)
+	}
+}
+
+func TestUniquePathListError(t *testing.T) {
+	// A file where the directory should be can't be listed
+	file := filepath.Join(t.TempDir(), "todo")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if path, err := UniquePath(file, "ticket.md"); err == nil {
+		t.Errorf("UniquePath = %q, want an error", path)
+	}
+}
+
+func TestMoveDoesNotOverwrite(t *testing.T) {
+	kanbanDir := t.TempDir()
+	for _, col := range []string{"todo", "done"} {
+		if err := os.MkdirAll(filepath.Join(kanbanDir, col), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	existing := NewTicket("Dup", "done")
+	path, err := existing.UniqueFilePath(filepath.Join(kanbanDir, "done"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing.FilePath = path
+	if err := existing.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	moving := NewTicket("Dup", "todo")
+	moving.Created = existing.Created
+	path, err = moving.UniqueFilePath(filepath.Join(kanbanDir, "todo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	moving.FilePath = path
+	if err := moving.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := moving.Move(kanbanDir, "done"); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	if moving.FilePath == existing.FilePath {
+		t.Fatalf("Move overwrote %q", existing.FilePath)
+	}
+	if _, err := os.Stat(existing.FilePath); err != nil {
+		t.Errorf("existing ticket missing after move: %v", err)
+	}
+}
+
+func TestMoveKeepsFileEdits(t *testing.T) {
+	kanbanDir := t.TempDir()
+	ticket := NewTicket("Fix login", "todo")
+	path, err := ticket.UniqueFilePath(filepath.Join(kanbanDir, "todo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket.FilePath = path
+	ticket.Updated = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := ticket.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Someone else writes the file after it was loaded
+	edited, err := ParseTicket(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited.AgentFeedback = "did it"
+	if err := edited.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ticket.Move(kanbanDir, "done"); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	moved, err := ParseTicket(ticket.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.AgentFeedback != "did it" {
+		t.Errorf("agent_feedback = %q, want the edit kept", moved.AgentFeedback)
+	}
+	if !moved.Updated.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("updated = %v, want it unchanged by the move", moved.Updated)
+	}
+	if n := len(moved.ColumnHistory); n == 0 || moved.ColumnHistory[n-1].Column != "done" {
+		t.Errorf("history = %+v, want a done entry", moved.ColumnHistory)
+	}
+}
+
+func TestReadColumnSkipsConflictCopies(t *testing.T) {
+	dir := t.TempDir()
+	ticket := NewTicket("Fix login", "todo")
+	path, err := ticket.UniqueFilePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket.FilePath = path
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	copyPath := strings.TrimSuffix(ticket.FilePath, ".md") + ".sync-conflict-20250103-101112-ABCDEFG.md"
+	if err := os.WriteFile(copyPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0644)
+
+	tickets, err := ReadColumn(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != 1 || tickets[0].FilePath != ticket.FilePath {
+		t.Errorf("ReadColumn = %v, want only %s", tickets, ticket.Filename())
+	}
+}
+
+func TestRelink(t *testing.T) {
+	child := &Ticket{Parent: "epic.md"}
+	epic := &Ticket{Content: "- [x] Docs → [[docs.md]]\n- [ ] Tests → [[docs.md.bak]]"}
+	if !child.Relink("epic.md", "epic-2.md") || child.Parent != "epic-2.md" {
+		t.Errorf("child parent = %q", child.Parent)
+	}
+	if !epic.Relink("docs.md", "docs-2.md") || epic.Content != "- [x] Docs → [[docs-2.md]]\n- [ ] Tests → [[docs.md.bak]]" {
+		t.Errorf("epic content = %q", epic.Content)
+	}
+	if child.Relink("other.md", "x.md") {
+		t.Error("Relink changed a ticket without references")
+	}
+}
+
+func TestTrash(t *testing.T) {
+	kanbanDir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		ticket := NewTicket("Gone", "todo")
+		path, err := ticket.UniqueFilePath(filepath.Join(kanbanDir, "todo"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.FilePath = path
+		if err := ticket.Save(); err != nil {
+			t.Fatal(err)
+		}
+		old := ticket.FilePath
+		path, err = ticket.Trash(kanbanDir)
+		if err != nil {
+			t.Fatalf("Trash: %v", err)
+		}
+		if filepath.Dir(path) != filepath.Join(kanbanDir, TrashDir) {
+			t.Errorf("trashed to %q", path)
+		}
+		if _, err := os.Stat(old); !os.IsNotExist(err) {
+			t.Errorf("%q still exists", old)
+		}
+	}
+	entries, _ := os.ReadDir(filepath.Join(kanbanDir, TrashDir))
+	if len(entries) != 2 {
+		t.Errorf("trash has %d files, want 2", len(entries))
+	}
+}
+
+func TestShortTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		width int
+		want  string
+	}{
+		{"Short", 10, "Short"},
+		{"A much longer title", 10, "A much ..."},
+		{"日本語のタイトルです", 10, "日本語..."},
+		{"🚀 Launch rocket", 8, "🚀 La..."},
+	}
+
+	for _, tt := range tests {
+		ticket := &Ticket{Title: tt.title}
+		got := ticket.ShortTitle(tt.width)
+		if got != tt.want {
+			t.Errorf("ShortTitle(%q, %d) = %q, want %q", tt.title, tt.width, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("ShortTitle(%q, %d) produced invalid UTF-8", tt.title, tt.width)
+		}
+	}
+}
+
+func TestTagHelpers(t *testing.T) {
+	ticket := &Ticket{Tags: []string{"bug", "failed"}}
+	ticket.AddTag("failed")
+	ticket.AddTag("urgent")
+	if got := strings.Join(ticket.Tags, ","); got != "bug,failed,urgent" {
+		t.Errorf("after AddTag, tags = %q", got)
+	}
+	ticket.RemoveTag("failed")
+	if ticket.HasTag("failed") || !ticket.HasTag("bug") {
+		t.Errorf("after RemoveTag, tags = %v", ticket.Tags)
+	}
+}
+
+func TestParseTicketContent(t *testing.T) {
+	longLine := strings.Repeat("x", 200<<10)
+	tests := []struct {
+		name        string
+		data        string
+		wantTitle   string
+		wantContent string
+	}{
+		{"frontmatter", "---\ntitle: Fix auth\n---\n\nBody text\n", "Fix auth", "Body text"},
+		{"leading blank lines", "\n\n---\ntitle: Fix auth\n---\nBody", "Fix auth", "Body"},
+		{"horizontal rule in body", "---\ntitle: Rules\n---\nAbove\n\n---\n\nBelow\n", "Rules", "Above\n\n---\n\nBelow"},
+		{"no closing delimiter", "---\ntitle: Broken\nBody without end", "", "---\ntitle: Broken\nBody without end"},
+		{"no frontmatter", "Just notes\n---\nmore", "", "Just notes\n---\nmore"},
+		{"closing delimiter at EOF", "---\ntitle: Empty\n---", "Empty", ""},
+		{"long body line", "---\ntitle: Long\n---\n" + longLine + "\n", "Long", longLine},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket, err := ParseTicketContent([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ticket.Title != tt.wantTitle {
+				t.Errorf("title = %q, want %q", ticket.Title, tt.wantTitle)
+			}
+			if ticket.Content != tt.wantContent {
+				t.Errorf("content = %.60q, want %.60q", ticket.Content, tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestParseTicketHeader(t *testing.T) {
+	ticket := NewTicket("Header only", "todo")
+	ticket.Content = strings.Repeat("Long body line.\n", 10000)
+	ticket.FilePath = filepath.Join(t.TempDir(), "todo", "header.md")
+	if err := ticket.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	header, err := ParseTicketHeader(ticket.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Title != "Header only" || header.Column != "todo" || header.Content != "" {
+		t.Errorf("header = %q in %q with %d content bytes", header.Title, header.Column, len(header.Content))
+	}
+}
+
+func TestParseTicketKeepsWindowsLineEndings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "todo", "windows.md")
+	os.MkdirAll(filepath.Dir(path), 0755)
+	data := "\xef\xbb\xbf---\r\ntitle: Edited on Windows\r\ntags: [bug]\r\n---\r\n\r\nFirst line\r\nSecond line\r\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ticket, err := ParseTicket(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.Title != "Edited on Windows" || !ticket.HasTag("bug") {
+		t.Errorf("frontmatter not parsed: title %q, tags %v", ticket.Title, ticket.Tags)
+	}
+	if ticket.Content != "First line\nSecond line" {
+		t.Errorf("content = %q", ticket.Content)
+	}
+
+	if err := ticket.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+	written, _ := os.ReadFile(path)
+	if !strings.HasPrefix(string(written), "\xef\xbb\xbf---\r\n") {
+		t.Errorf("written file lost BOM or CRLF: %.20q", written)
+	}
+	if strings.Count(string(written), "\n") != strings.Count(string(written), "\r\n") {
+		t.Errorf("written file mixes line endings: %q", written)
+	}
+}
+
+func TestDueDate(t *testing.T) {
+	// An unquoted date, as typed by hand, must still read as a string
+	ticket, err := ParseTicketContent([]byte("---\ntitle: Report\ndue: 2025-03-14\npriority: 1\n---\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	due, ok := ticket.DueDate()
+	if !ok || due.Format("2006-01-02") != "2025-03-14" || ticket.Priority != 1 {
+		t.Errorf("due = %v (%t), priority %d", due, ok, ticket.Priority)
+	}
+
+	parsed, err := ParseTicketContent(ticket.ToMarkdown())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Due != "2025-03-14" {
+		t.Errorf("due after round trip = %q", parsed.Due)
+	}
+
+	for _, bad := range []string{"", "next week"} {
+		if _, ok := (&Ticket{Due: bad}).DueDate(); ok {
+			t.Errorf("DueDate(%q) ok", bad)
+		}
+	}
+}
+
+func TestSnoozedAt(t *testing.T) {
+	ticket := &Ticket{SnoozedUntil: "2025-03-14"}
+	for _, tt := range []struct {
+		now  time.Time
+		want bool
+	}{
+		{time.Date(2025, 3, 13, 23, 59, 0, 0, time.Local), true},
+		{time.Date(2025, 3, 14, 0, 0, 0, 0, time.Local), false},
+		{time.Date(2025, 4, 1, 12, 0, 0, 0, time.Local), false},
+	} {
+		if got := ticket.SnoozedAt(tt.now); got != tt.want {
+			t.Errorf("SnoozedAt(%v) = %t, want %t", tt.now, got, tt.want)
+		}
+	}
+	if (&Ticket{}).SnoozedAt(time.Now()) {
+		t.Error("ticket without snoozed_until is snoozed")
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	created := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	ticket := &Ticket{
+		Title:    "Fix login",
+		Tags:     []string{"bug"},
+		Created:  created,
+		Updated:  created,
+		Content:  "Steps to reproduce",
+		FilePath: filepath.Join("todo", "2025-01-02-fix-login.md"),
+		Column:   "todo",
+	}
+	data, err := ticket.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("invalid JSON %s: %v", data, err)
+	}
+	for key, want := range map[string]any{
+		"title":   "Fix login",
+		"created": "2025-01-02T10:00:00Z",
+		"content": "Steps to reproduce",
+		"column":  "todo",
+		"file":    "2025-01-02-fix-login.md",
+	} {
+		if got[key] != want {
+			t.Errorf("%s = %v, want %v", key, got[key], want)
+		}
+	}
+	if tags, _ := got["tags"].([]any); len(tags) != 1 || tags[0] != "bug" {
+		t.Errorf("tags = %v", got["tags"])
+	}
+	if _, ok := got["agent_feedback"]; ok {
+		t.Error("empty fields should be omitted")
+	}
+}
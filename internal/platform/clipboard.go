@@ -0,0 +1,67 @@
+// Package platform wraps OS-specific helpers: the system clipboard, the
+// default text editor and the keychain.
+package platform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/atotto/clipboard"
+)
+
+// command is an external program with its arguments.
+type command []string
+
+// available reports whether the program is on PATH.
+func (c command) available() bool {
+	_, err := exec.LookPath(c[0])
+	return err == nil
+}
+
+// errNoClipboard is returned when no clipboard mechanism works.
+var errNoClipboard = errors.New("no clipboard available (install wl-clipboard, xclip or xsel)")
+
+// Copy writes text to the system clipboard. When the native clipboard fails
+// (no X server, WSL, minimal containers), the platform's clipboard commands
+// are tried in order.
+func Copy(text string) error {
+	if err := clipboard.WriteAll(text); err == nil {
+		return nil
+	}
+	for _, c := range copyCommands() {
+		if !c.available() {
+			continue
+		}
+		cmd := exec.Command(c[0], c[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err == nil {
+			return nil
+		}
+	}
+	return errNoClipboard
+}
+
+// Paste returns the system clipboard's text, falling back to the
+// platform's clipboard commands like Copy.
+func Paste() (string, error) {
+	if text, err := clipboard.ReadAll(); err == nil {
+		return text, nil
+	}
+	for _, c := range pasteCommands() {
+		if !c.available() {
+			continue
+		}
+		var out bytes.Buffer
+		cmd := exec.Command(c[0], c[1:]...)
+		cmd.Stdout = &out
+		if err := cmd.Run(); err != nil {
+			continue
+		}
+		// PowerShell's Get-Clipboard ends with CRLF
+		return strings.TrimSuffix(strings.ReplaceAll(out.String(), "\r\n", "\n"), "\n"), nil
+	}
+	return "", fmt.Errorf("reading clipboard: %w", errNoClipboard)
+}
@@ -0,0 +1,31 @@
+package platform
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultEditor returns the editor command to use when none is configured:
+// $VISUAL, then $EDITOR, then the first of the platform's usual editors
+// found on PATH. It returns "" if there is none.
+func DefaultEditor() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	for _, editor := range fallbackEditors {
+		if _, err := exec.LookPath(strings.Fields(editor)[0]); err == nil {
+			return editor
+		}
+	}
+	return ""
+}
+
+// EditorCommand builds the command that opens path in editor, which may
+// include arguments (e.g. "code --wait").
+func EditorCommand(editor, path string) *exec.Cmd {
+	args := strings.Fields(editor)
+	return exec.Command(args[0], append(args[1:], path)...)
+}
@@ -0,0 +1,27 @@
+package platform
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultEditorPrefersVisual(t *testing.T) {
+	t.Setenv("VISUAL", "code --wait")
+	t.Setenv("EDITOR", "vim")
+	if got := DefaultEditor(); got != "code --wait" {
+		t.Errorf("DefaultEditor() = %q, want $VISUAL", got)
+	}
+
+	t.Setenv("VISUAL", "")
+	if got := DefaultEditor(); got != "vim" {
+		t.Errorf("DefaultEditor() = %q, want $EDITOR", got)
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	cmd := EditorCommand("code --wait", "/tmp/ticket.md")
+	want := []string{"code", "--wait", "/tmp/ticket.md"}
+	if !reflect.DeepEqual(cmd.Args, want) {
+		t.Errorf("args = %q, want %q", cmd.Args, want)
+	}
+}
@@ -0,0 +1,51 @@
+package platform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ErrNoKeychain is returned when the platform has no keychain command.
+var ErrNoKeychain = errors.New("no keychain available")
+
+// KeychainGet reads the secret stored for service and account in the
+// system keychain: the macOS login keychain or the Secret Service
+// (secret-tool) elsewhere.
+func KeychainGet(service, account string) (string, error) {
+	c := keychainLookup(service, account)
+	if c == nil || !c.available() {
+		return "", ErrNoKeychain
+	}
+	var out, stderr bytes.Buffer
+	cmd := exec.Command(c[0], c[1:]...)
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("no %s secret for %s in the keychain: %v", service, account, err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// KeychainSet stores secret for service and account in the system
+// keychain, replacing any earlier one. The secret is passed on stdin, never
+// as an argument.
+func KeychainSet(service, account, secret string) error {
+	c, input := keychainStore(service, account, secret)
+	if c == nil || !c.available() {
+		return ErrNoKeychain
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(c[0], c[1:]...)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("storing secret in the keychain: %s", msg)
+		}
+		return fmt.Errorf("storing secret in the keychain: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package platform
+
+import "fmt"
+
+// copyCommands lists clipboard writers tried after the native clipboard.
+func copyCommands() []command {
+	return []command{{"pbcopy"}}
+}
+
+// pasteCommands lists clipboard readers tried after the native clipboard.
+func pasteCommands() []command {
+	return []command{{"pbpaste"}}
+}
+
+// fallbackEditors are tried in order when neither $VISUAL nor $EDITOR is
+// set; TextEdit via open is always present.
+var fallbackEditors = []string{"nano", "vi", "open -W -n -t"}
+
+// keychainLookup reads a generic password from the login keychain.
+func keychainLookup(service, account string) command {
+	return command{"security", "find-generic-password", "-s", service, "-a", account, "-w"}
+}
+
+// keychainStore adds or updates a generic password through security's
+// interactive mode, so the secret doesn't appear in the process list.
+func keychainStore(service, account, secret string) (command, string) {
+	return command{"security", "-i"},
+		fmt.Sprintf("add-generic-password -U -s %q -a %q -w %q\n", service, account, secret)
+}
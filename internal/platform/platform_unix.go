@@ -0,0 +1,46 @@
+//go:build !windows && !darwin
+
+package platform
+
+import "os"
+
+// copyCommands lists clipboard writers: Wayland, X11, then the Windows
+// clipboard when running under WSL.
+func copyCommands() []command {
+	var cmds []command
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		cmds = append(cmds, command{"wl-copy"})
+	}
+	return append(cmds,
+		command{"xclip", "-in", "-selection", "clipboard"},
+		command{"xsel", "--input", "--clipboard"},
+		command{"clip.exe"},
+	)
+}
+
+// pasteCommands lists clipboard readers in the same order as copyCommands.
+func pasteCommands() []command {
+	var cmds []command
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		cmds = append(cmds, command{"wl-paste", "--no-newline"})
+	}
+	return append(cmds,
+		command{"xclip", "-out", "-selection", "clipboard"},
+		command{"xsel", "--output", "--clipboard"},
+		command{"powershell.exe", "-NoProfile", "-Command", "Get-Clipboard"},
+	)
+}
+
+// fallbackEditors are tried in order when neither $VISUAL nor $EDITOR is set.
+var fallbackEditors = []string{"sensible-editor", "nano", "vi"}
+
+// keychainLookup reads a secret from the Secret Service (GNOME Keyring,
+// KWallet) with secret-tool.
+func keychainLookup(service, account string) command {
+	return command{"secret-tool", "lookup", "service", service, "account", account}
+}
+
+// keychainStore stores a secret with secret-tool, which reads it on stdin.
+func keychainStore(service, account, secret string) (command, string) {
+	return command{"secret-tool", "store", "--label", service + " " + account, "service", service, "account", account}, secret
+}
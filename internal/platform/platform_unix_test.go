@@ -0,0 +1,63 @@
+//go:build !windows && !darwin
+
+package platform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fakeBin puts executable shell scripts on a PATH of their own, hiding the
+// real clipboard tools.
+func fakeBin(t *testing.T, scripts map[string]string) string {
+	dir := t.TempDir()
+	for name, body := range scripts {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+body+"\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", dir)
+	t.Setenv("WAYLAND_DISPLAY", "")
+	t.Setenv("DISPLAY", "")
+	return dir
+}
+
+func TestClipboardFallsBackToWSL(t *testing.T) {
+	// Shell builtins only: the fake PATH has no cat
+	dir := fakeBin(t, map[string]string{
+		"clip.exe":       `IFS= read -r line; printf '%s' "$line" > "${0%/*}/copied"`,
+		"powershell.exe": `printf 'from windows\r\n'`,
+	})
+
+	if err := Copy("hello"); err != nil {
+		t.Fatalf("Copy: %v", err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "copied")); string(data) != "hello" {
+		t.Errorf("clip.exe got %q", data)
+	}
+
+	got, err := Paste()
+	if err != nil {
+		t.Fatalf("Paste: %v", err)
+	}
+	if got != "from windows" {
+		t.Errorf("Paste() = %q", got)
+	}
+}
+
+func TestClipboardUnavailable(t *testing.T) {
+	fakeBin(t, nil)
+	if err := Copy("hello"); err == nil {
+		t.Error("Copy succeeded without any clipboard tool")
+	}
+}
+
+func TestDefaultEditorFallback(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+	fakeBin(t, map[string]string{"vi": "exit 0"})
+	if got := DefaultEditor(); got != "vi" {
+		t.Errorf("DefaultEditor() = %q, want vi", got)
+	}
+}
@@ -0,0 +1,25 @@
+package platform
+
+// copyCommands lists clipboard writers tried after the native clipboard.
+func copyCommands() []command {
+	return []command{{"clip.exe"}}
+}
+
+// pasteCommands lists clipboard readers tried after the native clipboard.
+func pasteCommands() []command {
+	return []command{{"powershell.exe", "-NoProfile", "-Command", "Get-Clipboard"}}
+}
+
+// fallbackEditors are tried in order when neither %VISUAL% nor %EDITOR% is set.
+var fallbackEditors = []string{"notepad.exe"}
+
+// keychainLookup has no command-line counterpart in the Windows
+// Credential Manager; callers fall back to an environment variable.
+func keychainLookup(service, account string) command {
+	return nil
+}
+
+// keychainStore is unsupported on Windows, like keychainLookup.
+func keychainStore(service, account, secret string) (command, string) {
+	return nil, ""
+}
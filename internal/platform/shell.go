@@ -0,0 +1,16 @@
+package platform
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+)
+
+// ShellCommand builds a command that runs command through the platform
+// shell: sh -c, or cmd /C on Windows.
+func ShellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
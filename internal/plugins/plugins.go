@@ -0,0 +1,197 @@
+// Package plugins runs board plugins: executables in the board's plugins
+// directory that receive the board and selected ticket as JSON on stdin
+// and answer with JSON actions for the board to carry out.
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// DirName is the directory in the kanban directory holding plugins.
+const DirName = "plugins"
+
+// Timeout bounds how long a plugin may run.
+const Timeout = time.Minute
+
+// Action types a plugin may return.
+const (
+	// ActionMessage shows Message in the status bar
+	ActionMessage = "message"
+	// ActionCopy copies Text to the clipboard
+	ActionCopy = "copy"
+	// ActionCreate creates a ticket from Title, Tags and Content in Column
+	// (default: the active column)
+	ActionCreate = "create"
+	// ActionUpdate replaces the Title, Tags or Content given for the ticket
+	// at Path (default: the selected ticket)
+	ActionUpdate = "update"
+	// ActionMove moves the ticket at Path (default: the selected ticket)
+	// to Column
+	ActionMove = "move"
+)
+
+// Plugin is an executable in the plugins directory.
+type Plugin struct {
+	// Name is the file name without extension
+	Name string
+	Path string
+}
+
+// Ticket is a ticket as plugins see it.
+type Ticket struct {
+	Path    string   `json:"path"`
+	Title   string   `json:"title"`
+	Tags    []string `json:"tags"`
+	Column  string   `json:"column"`
+	Content string   `json:"content"`
+}
+
+// NewTicket converts a board ticket for a plugin.
+func NewTicket(t *models.Ticket) Ticket {
+	tags := t.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return Ticket{Path: t.FilePath, Title: t.Title, Tags: tags, Column: t.Column, Content: t.Content}
+}
+
+// Input is the JSON a plugin reads on stdin.
+type Input struct {
+	KanbanDir string `json:"kanban_dir"`
+	// Columns are the column directories in board order
+	Columns []string `json:"columns"`
+	// Column is the active column's directory
+	Column string `json:"column"`
+	// Ticket is the selected ticket, if any
+	Ticket *Ticket `json:"ticket"`
+	// Selected are the tickets marked for a bulk action
+	Selected []Ticket `json:"selected"`
+}
+
+// Action is something a plugin asks the board to do. Which fields apply
+// depends on Type; Title, Tags and Content are pointers so an update can
+// leave them unchanged.
+type Action struct {
+	Type    string `json:"type"`
+	Message string `json:"message,omitempty"`
+	Text    string `json:"text,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Column  string `json:"column,omitempty"`
+	// Reason is why a move is made, required on the move_reasons transitions
+	Reason  string    `json:"reason,omitempty"`
+	Title   *string   `json:"title,omitempty"`
+	Tags    *[]string `json:"tags,omitempty"`
+	Content *string   `json:"content,omitempty"`
+}
+
+// output is the JSON a plugin writes to stdout.
+type output struct {
+	Actions []Action `json:"actions"`
+}
+
+// List returns the executables in kanbanDir's plugins directory, sorted by
+// name. A missing directory holds no plugins.
+func List(kanbanDir string) ([]Plugin, error) {
+	dir := filepath.Join(kanbanDir, DirName)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Plugin
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		// Windows has no executable bit; run whatever the directory holds
+		if runtime.GOOS != "windows" && info.Mode()&0111 == 0 {
+			continue
+		}
+		list = append(list, Plugin{
+			Name: strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())),
+			Path: filepath.Join(dir, entry.Name()),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// Run runs the plugin in the board's project directory with in as JSON on
+// stdin and returns the actions it printed. Empty output means no actions.
+// A failed plugin's error includes the last line of its stderr.
+func (p Plugin) Run(in Input) ([]Action, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Dir = filepath.Dir(in.KanbanDir)
+	cmd.Stdin = bytes.NewReader(data)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+		if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+			return nil, fmt.Errorf("plugin %s: %w: %s", p.Name, err, last)
+		}
+		return nil, fmt.Errorf("plugin %s: %w", p.Name, err)
+	}
+
+	if len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return nil, nil
+	}
+	var out output
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid output: %w", p.Name, err)
+	}
+	for _, a := range out.Actions {
+		if err := a.validate(); err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", p.Name, err)
+		}
+	}
+	return out.Actions, nil
+}
+
+// validate checks that an action has the fields its type needs.
+func (a Action) validate() error {
+	switch a.Type {
+	case ActionMessage, ActionCopy, ActionUpdate:
+		return nil
+	case ActionCreate:
+		if a.Title == nil || strings.TrimSpace(*a.Title) == "" {
+			return errors.New("create action needs a title")
+		}
+		return nil
+	case ActionMove:
+		if a.Column == "" {
+			return errors.New("move action needs a column")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown action type %q", a.Type)
+}
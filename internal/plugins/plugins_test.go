@@ -0,0 +1,81 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writePlugin writes an executable shell script plugin.
+func writePlugin(t *testing.T, kanbanDir, name, script string) {
+	t.Helper()
+	dir := filepath.Join(kanbanDir, DirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugins are shell scripts")
+	}
+	kanbanDir := filepath.Join(t.TempDir(), ".kanban")
+	writePlugin(t, kanbanDir, "tag-urgent.sh",
+		`title=$(sed -n 's/.*"title":"\([^"]*\)".*/\1/p')
+echo '{"actions":[{"type":"update","tags":["urgent"]},{"type":"message","message":"Tagged '"$title"'"}]}'`)
+	writePlugin(t, kanbanDir, "broken", `echo 'no API token' >&2; exit 1`)
+	writePlugin(t, kanbanDir, "quiet", `cat > /dev/null`)
+	if err := os.WriteFile(filepath.Join(kanbanDir, DirName, "README.md"), []byte("docs"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := List(kanbanDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, p := range list {
+		names = append(names, p.Name)
+	}
+	if got := strings.Join(names, ","); got != "broken,quiet,tag-urgent" {
+		t.Fatalf("plugins = %s", got)
+	}
+
+	in := Input{KanbanDir: kanbanDir, Ticket: &Ticket{Title: "Fix login", Tags: []string{}}}
+	actions, err := list[2].Run(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 || actions[0].Type != ActionUpdate || (*actions[0].Tags)[0] != "urgent" ||
+		actions[0].Title != nil || actions[1].Message != "Tagged Fix login" {
+		t.Errorf("actions = %+v", actions)
+	}
+
+	if _, err := list[0].Run(in); err == nil || !strings.HasSuffix(err.Error(), "no API token") {
+		t.Errorf("err = %v, want the plugin's stderr", err)
+	}
+	if actions, err := list[1].Run(in); err != nil || actions != nil {
+		t.Errorf("quiet plugin = %v, %v", actions, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	empty := ""
+	for _, a := range []Action{{Type: "explode"}, {Type: ActionCreate}, {Type: ActionCreate, Title: &empty}, {Type: ActionMove}} {
+		if err := a.validate(); err == nil {
+			t.Errorf("%+v accepted", a)
+		}
+	}
+}
+
+func TestListMissingDir(t *testing.T) {
+	list, err := List(t.TempDir())
+	if err != nil || list != nil {
+		t.Errorf("List = %v, %v; want no plugins", list, err)
+	}
+}
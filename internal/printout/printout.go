@@ -0,0 +1,282 @@
+// Package printout renders the board for printing: as paginated plain text,
+// or as an HTML page laid out for the browser's print-to-PDF, with the
+// columns as sections and every ticket's content.
+package printout
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Section is a column and its tickets, in board order.
+type Section struct {
+	Name    string
+	Tickets []*models.Ticket
+}
+
+// Options control the rendering.
+type Options struct {
+	// Title heads the printout, e.g. the board's name
+	Title string
+	// Date is when the board was printed
+	Date time.Time
+	// Width is the line width of the text rendering (default 80)
+	Width int
+	// PageLines splits the text rendering into pages of this many lines,
+	// separated by form feeds (0 for one long page)
+	PageLines int
+	// TitlesOnly leaves out the tickets' content
+	TitlesOnly bool
+}
+
+// DefaultWidth is the default line width of the text rendering.
+const DefaultWidth = 80
+
+// Read parses the tickets of cfg's columns, or only those whose directory
+// or name is in only. As on the board, sync conflict copies and tickets
+// snoozed at now are left out.
+func Read(cfg *config.Config, only []string, now time.Time) ([]Section, error) {
+	var sections []Section
+	for _, col := range cfg.Columns {
+		if len(only) > 0 && !selected(col, only) {
+			continue
+		}
+		tickets, err := models.ReadColumn(cfg.ColumnPath(col.Dir))
+		if err != nil {
+			return nil, err
+		}
+		s := Section{Name: col.Label()}
+		for _, ticket := range tickets {
+			if !ticket.SnoozedAt(now) {
+				s.Tickets = append(s.Tickets, ticket)
+			}
+		}
+		models.SortTickets(s.Tickets, cfg.SortBy, cfg.Locale)
+		sections = append(sections, s)
+	}
+	return sections, nil
+}
+
+// selected reports whether col is named in only, by directory or name.
+func selected(col config.Column, only []string) bool {
+	for _, name := range only {
+		if strings.EqualFold(name, col.Dir) || strings.EqualFold(name, col.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// details summarizes a ticket's tags, priority, due date and timestamps.
+func details(t *models.Ticket) string {
+	var parts []string
+	if len(t.Tags) > 0 {
+		parts = append(parts, "Tags: "+strings.Join(t.Tags, ", "))
+	}
+	if t.Priority > 0 {
+		parts = append(parts, fmt.Sprintf("Priority %d", t.Priority))
+	}
+	if t.Due != "" {
+		parts = append(parts, "Due "+t.Due)
+	}
+	if !t.Updated.IsZero() {
+		parts = append(parts, "Updated "+t.Updated.Format("Jan 2, 2006"))
+	}
+	return strings.Join(parts, "  ·  ")
+}
+
+// Text writes the sections as plain text. With PageLines set, pages end
+// with a footer and a form feed, and tickets are kept on one page when
+// they fit.
+func Text(w io.Writer, sections []Section, opts Options) error {
+	width := opts.Width
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	// Blocks are laid out whole when possible: the heading, then each
+	// ticket, with a section's heading kept with its first ticket
+	var blocks [][]string
+	title := opts.Title + " — " + opts.Date.Format("Mon Jan 2, 2006")
+	blocks = append(blocks, []string{title, strings.Repeat("=", min(len([]rune(title)), width)), ""})
+	for _, s := range sections {
+		heading := []string{fmt.Sprintf("%s (%d)", strings.ToUpper(s.Name), len(s.Tickets))}
+		heading = append(heading, strings.Repeat("-", min(len([]rune(heading[0])), width)), "")
+		if len(s.Tickets) == 0 {
+			blocks = append(blocks, append(heading, "  (no tickets)", ""))
+			continue
+		}
+		for i, t := range s.Tickets {
+			block := ticketLines(i+1, t, width, opts.TitlesOnly)
+			if i == 0 {
+				block = append(heading, block...)
+			}
+			blocks = append(blocks, block)
+		}
+	}
+
+	pages := paginate(blocks, opts.PageLines)
+	for i, page := range pages {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\f"); err != nil {
+				return err
+			}
+		}
+		if len(pages) > 1 {
+			for len(page) < opts.PageLines-2 {
+				page = append(page, "")
+			}
+			footer := fmt.Sprintf("%s — page %d of %d", opts.Title, i+1, len(pages))
+			page = append(page, "", strings.Repeat(" ", max((width-len([]rune(footer)))/2, 0))+footer)
+		}
+		if _, err := io.WriteString(w, strings.Join(page, "\n")+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ticketLines renders ticket number n as lines of at most width columns.
+func ticketLines(n int, t *models.Ticket, width int, titlesOnly bool) []string {
+	const indent = "    "
+	var lines []string
+	for i, line := range strings.Split(wordwrap.String(t.Title, width-len(indent)), "\n") {
+		if i == 0 {
+			line = fmt.Sprintf("%-4s", fmt.Sprintf("%d.", n)) + line
+		} else {
+			line = indent + line
+		}
+		lines = append(lines, line)
+	}
+	if d := details(t); d != "" {
+		lines = append(lines, indent+d)
+	}
+	if content := strings.TrimSpace(t.Content); content != "" && !titlesOnly {
+		lines = append(lines, "")
+		for _, line := range strings.Split(wordwrap.String(content, width-len(indent)), "\n") {
+			lines = append(lines, strings.TrimRight(indent+line, " "))
+		}
+	}
+	return append(lines, "")
+}
+
+// paginate lays blocks out on pages of pageLines lines, less two for the
+// footer, starting a new page rather than splitting a block that fits on
+// one. A pageLines of zero puts everything on one page.
+func paginate(blocks [][]string, pageLines int) [][]string {
+	body := pageLines - 2
+	if pageLines <= 0 || body < 1 {
+		var all []string
+		for _, b := range blocks {
+			all = append(all, b...)
+		}
+		return [][]string{all}
+	}
+
+	var pages [][]string
+	var page []string
+	for _, block := range blocks {
+		if len(page) > 0 && len(page)+len(block) > body && len(block) <= body {
+			pages = append(pages, page)
+			page = nil
+		}
+		for _, line := range block {
+			if len(page) == body {
+				pages = append(pages, page)
+				page = nil
+			}
+			// A page doesn't start with the blank line ending a block
+			if len(page) == 0 && line == "" {
+				continue
+			}
+			page = append(page, line)
+		}
+	}
+	if len(page) > 0 {
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// htmlTemplate lays the board out for printing: each column starts a new
+// page and tickets aren't split across pages.
+var htmlTemplate = template.Must(template.New("board").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+  @page { margin: 18mm; }
+  body { font: 11pt/1.45 system-ui, -apple-system, "Segoe UI", sans-serif; color: #222; max-width: 48em; margin: 2em auto; }
+  header { border-bottom: 2px solid #222; margin-bottom: 1.5em; }
+  h1 { font-size: 20pt; margin: 0; }
+  .date { color: #666; }
+  section + section { break-before: page; }
+  h2 { font-size: 15pt; border-bottom: 1px solid #999; }
+  article { break-inside: avoid; padding: .4em 0 .8em; border-bottom: 1px solid #ddd; }
+  h3 { font-size: 12pt; margin: .2em 0; }
+  .details { color: #555; font-size: 9.5pt; }
+  .empty { color: #777; font-style: italic; }
+  pre { white-space: pre-wrap; font: 10pt/1.4 ui-monospace, Menlo, Consolas, monospace; margin: .5em 0 0; }
+  @media print { body { margin: 0; max-width: none; } }
+</style>
+</head>
+<body>
+<header>
+  <h1>{{.Title}}</h1>
+  <p class="date">{{.Date}}</p>
+</header>
+{{- range .Sections}}
+<section>
+  <h2>{{.Name}} ({{len .Tickets}})</h2>
+  {{- range .Tickets}}
+  <article>
+    <h3>{{.Title}}</h3>
+    {{- if .Details}}
+    <div class="details">{{.Details}}</div>
+    {{- end}}
+    {{- if .Content}}
+    <pre>{{.Content}}</pre>
+    {{- end}}
+  </article>
+  {{- else}}
+  <p class="empty">No tickets</p>
+  {{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML writes the sections as a standalone HTML page meant to be printed,
+// or saved as PDF, from a browser.
+func HTML(w io.Writer, sections []Section, opts Options) error {
+	type ticket struct{ Title, Details, Content string }
+	type section struct {
+		Name    string
+		Tickets []ticket
+	}
+	data := struct {
+		Title, Date string
+		Sections    []section
+	}{Title: opts.Title, Date: opts.Date.Format("Monday, January 2, 2006")}
+	for _, s := range sections {
+		out := section{Name: s.Name}
+		for _, t := range s.Tickets {
+			tk := ticket{Title: t.Title, Details: details(t)}
+			if !opts.TitlesOnly {
+				tk.Content = strings.TrimSpace(t.Content)
+			}
+			out.Tickets = append(out.Tickets, tk)
+		}
+		data.Sections = append(data.Sections, out)
+	}
+	return htmlTemplate.Execute(w, data)
+}
@@ -0,0 +1,120 @@
+package printout
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func testSections() []Section {
+	return []Section{
+		{Name: "To Do", Tickets: []*models.Ticket{
+			{Title: "Write docs", Tags: []string{"docs"}, Content: "Cover the <install> steps and the configuration reference in detail."},
+			{Title: "Fix login", Due: "2025-03-14"},
+		}},
+		{Name: "Done"},
+	}
+}
+
+func TestRead(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	now := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	add := func(title, snoozedUntil string) *models.Ticket {
+		ticket := models.NewTicket(title, cfg.Columns[0].Dir)
+		ticket.SnoozedUntil = snoozedUntil
+		path, err := ticket.UniqueFilePath(cfg.ColumnPath(cfg.Columns[0].Dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.FilePath = path
+		if err := ticket.Save(); err != nil {
+			t.Fatal(err)
+		}
+		return ticket
+	}
+	kept := add("Write docs", "")
+	add("Later", "2025-04-01")
+	os.WriteFile(strings.TrimSuffix(kept.FilePath, ".md")+" (conflicted copy).md", nil, 0644)
+
+	sections, err := Read(cfg, []string{cfg.Columns[0].Dir}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 1 || len(sections[0].Tickets) != 1 || sections[0].Tickets[0].Title != "Write docs" {
+		t.Errorf("Read = %+v, want only Write docs", sections)
+	}
+}
+
+func TestText(t *testing.T) {
+	opts := Options{Title: "app", Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), Width: 40}
+	var buf bytes.Buffer
+	if err := Text(&buf, testSections(), opts); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"app — Fri Mar 14, 2025\n",
+		"TO DO (2)\n---------\n",
+		"1.  Write docs\n    Tags: docs\n\n    Cover the <install> steps and the\n    configuration reference in detail.\n",
+		"2.  Fix login\n    Due 2025-03-14\n",
+		"DONE (0)\n--------\n\n  (no tickets)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("text lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "\f") {
+		t.Error("unpaginated text has a form feed")
+	}
+
+	opts.TitlesOnly = true
+	buf.Reset()
+	Text(&buf, testSections(), opts)
+	if strings.Contains(buf.String(), "install") {
+		t.Error("titles-only text has content")
+	}
+}
+
+func TestTextPages(t *testing.T) {
+	opts := Options{Title: "app", Width: 40, PageLines: 12}
+	var buf bytes.Buffer
+	if err := Text(&buf, testSections(), opts); err != nil {
+		t.Fatal(err)
+	}
+	pages := strings.Split(buf.String(), "\f")
+	if len(pages) < 2 {
+		t.Fatalf("got %d pages, want several:\n%s", len(pages), buf.String())
+	}
+	for i, page := range pages {
+		lines := strings.Split(strings.TrimSuffix(page, "\n"), "\n")
+		if len(lines) != opts.PageLines {
+			t.Errorf("page %d has %d lines, want %d:\n%s", i+1, len(lines), opts.PageLines, page)
+		}
+		if footer := lines[len(lines)-1]; !strings.Contains(footer, "page "+string(rune('1'+i))+" of") {
+			t.Errorf("page %d footer = %q", i+1, footer)
+		}
+	}
+	// The first ticket fits on a page, so it isn't split
+	if !strings.Contains(pages[1], "Write docs") || !strings.Contains(pages[1], "configuration reference") {
+		t.Errorf("first ticket split across pages:\n%s", buf.String())
+	}
+}
+
+func TestHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := HTML(&buf, testSections(), Options{Title: "app"}); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"<h2>To Do (2)</h2>", "<h3>Fix login</h3>", "Cover the &lt;install&gt; steps", `<p class="empty">No tickets</p>`, "break-before: page"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML lacks %q", want)
+		}
+	}
+}
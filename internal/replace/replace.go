@@ -0,0 +1,126 @@
+// Package replace finds and replaces text in the titles and contents of a
+// board's tickets, previewing every change before it is written.
+package replace
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Options control how the search text is matched.
+type Options struct {
+	// Regexp treats the search text as a regular expression; the
+	// replacement may then refer to groups as $1 or ${name}
+	Regexp bool
+	// IgnoreCase matches regardless of letter case
+	IgnoreCase bool
+}
+
+// Line is a changed line of a ticket. Line 0 is the title; content lines
+// are numbered from 1.
+type Line struct {
+	Number int
+	Before string
+	After  string
+}
+
+// Change is a ticket the replacement rewrites.
+type Change struct {
+	Ticket  *models.Ticket
+	Title   string
+	Content string
+	Lines   []Line
+}
+
+// Replacer replaces one search text in tickets.
+type Replacer struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// New compiles a replacer for find and replacement.
+func New(find, replacement string, opts Options) (*Replacer, error) {
+	if find == "" {
+		return nil, errors.New("search text must not be empty")
+	}
+	pattern := find
+	if !opts.Regexp {
+		pattern = regexp.QuoteMeta(find)
+		// Keep "$" literal in plain replacements
+		replacement = strings.ReplaceAll(replacement, "$", "$$")
+	}
+	if opts.IgnoreCase {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return &Replacer{re: re, replacement: replacement}, nil
+}
+
+// Find returns the changes the replacement makes to the tickets in cfg's
+// columns, in column order. Every ticket is parsed before anything is
+// written, so an unreadable file stops the replacement up front.
+func (r *Replacer) Find(cfg *config.Config) ([]Change, error) {
+	var changes []Change
+	for _, col := range cfg.Columns {
+		names, err := models.TicketNames(cfg.ColumnPath(col.Dir))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			ticket, err := models.ParseTicket(filepath.Join(cfg.ColumnPath(col.Dir), name))
+			if err != nil {
+				return nil, err
+			}
+			if change, ok := r.Change(ticket); ok {
+				changes = append(changes, change)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// Change computes the replacement in one ticket, reporting false when the
+// search text doesn't occur in it.
+func (r *Replacer) Change(ticket *models.Ticket) (Change, bool) {
+	c := Change{
+		Ticket:  ticket,
+		Title:   r.re.ReplaceAllString(ticket.Title, r.replacement),
+		Content: r.re.ReplaceAllString(ticket.Content, r.replacement),
+	}
+	if c.Title != ticket.Title {
+		c.Lines = append(c.Lines, Line{Number: 0, Before: ticket.Title, After: c.Title})
+	}
+	if c.Content != ticket.Content {
+		before, after := strings.Split(ticket.Content, "\n"), strings.Split(c.Content, "\n")
+		if len(before) != len(after) {
+			// A replacement adding or removing lines is shown whole
+			c.Lines = append(c.Lines, Line{Number: 1, Before: ticket.Content, After: c.Content})
+		} else {
+			for i := range before {
+				if before[i] != after[i] {
+					c.Lines = append(c.Lines, Line{Number: i + 1, Before: before[i], After: after[i]})
+				}
+			}
+		}
+	}
+	return c, len(c.Lines) > 0
+}
+
+// Apply writes the new title and content to the ticket file.
+func (c *Change) Apply() error {
+	c.Ticket.Title = c.Title
+	c.Ticket.Content = c.Content
+	if err := c.Ticket.Save(); err != nil {
+		return fmt.Errorf("writing %s: %w", c.Ticket.FilePath, err)
+	}
+	return nil
+}
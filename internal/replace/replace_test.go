@@ -0,0 +1,95 @@
+package replace
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func TestChange(t *testing.T) {
+	ticket := &models.Ticket{
+		Title:   "Call v1/users",
+		Content: "Uses V1/users.\nUnrelated line\nSee v1/users and v1/users/:id",
+	}
+	tests := []struct {
+		name, find, repl string
+		opts             Options
+		want             []Line
+	}{
+		{"plain", "v1/users", "v2/accounts", Options{}, []Line{
+			{0, "Call v1/users", "Call v2/accounts"},
+			{3, "See v1/users and v1/users/:id", "See v2/accounts and v2/accounts/:id"},
+		}},
+		{"ignore case", "v1/users", "v2", Options{IgnoreCase: true}, []Line{
+			{0, "Call v1/users", "Call v2"},
+			{1, "Uses V1/users.", "Uses v2."},
+			{3, "See v1/users and v1/users/:id", "See v2 and v2/:id"},
+		}},
+		{"regexp groups", `v1/(\w+)/:id`, "v2/$1/{id}", Options{Regexp: true}, []Line{
+			{3, "See v1/users and v1/users/:id", "See v1/users and v2/users/{id}"},
+		}},
+		{"literal dollar", "Unrelated", "$1 line", Options{}, []Line{
+			{2, "Unrelated line", "$1 line line"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(tt.find, tt.repl, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, ok := r.Change(ticket)
+			if !ok || !reflect.DeepEqual(c.Lines, tt.want) {
+				t.Errorf("lines = %q, want %q", c.Lines, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindApply(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	if err := cfg.EnsureDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	for _, title := range []string{"Rename Foo", "Unrelated"} {
+		ticket := models.NewTicket(title, "todo")
+		ticket.Content = "Foo is used here"
+		path, err := ticket.UniqueFilePath(cfg.ColumnPath("todo"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.FilePath = path
+		if err := ticket.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, err := New("Foo", "Bar", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := r.Find(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("changes = %d, want 2", len(changes))
+	}
+	if err := changes[0].Apply(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := models.ParseTicket(changes[0].Ticket.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Content != "Bar is used here" {
+		t.Errorf("content = %q", got.Content)
+	}
+
+	if _, err := New("(", "", Options{Regexp: true}); err == nil {
+		t.Error("invalid regexp accepted")
+	}
+}
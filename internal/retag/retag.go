@@ -0,0 +1,93 @@
+// Package retag renames tags across every ticket of a board. Renaming a
+// tag to one a ticket already has merges the two.
+package retag
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Change is a ticket whose tags the rename rewrites.
+type Change struct {
+	Ticket *models.Ticket
+	Before []string
+	After  []string
+}
+
+// Plan lists the tickets a tag rename touches.
+type Plan struct {
+	From    string
+	To      string
+	Changes []Change
+}
+
+// NewPlan finds the tickets in cfg's columns tagged from. Every ticket is
+// parsed before anything is written, so a file that can't be read stops
+// the rename instead of leaving it half done.
+func NewPlan(cfg *config.Config, from, to string) (*Plan, error) {
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	switch {
+	case from == "" || to == "":
+		return nil, errors.New("tag names must not be empty")
+	case strings.Contains(to, ","):
+		return nil, fmt.Errorf("tag %q must not contain a comma", to)
+	case from == to:
+		return nil, fmt.Errorf("tag %q is unchanged", from)
+	}
+
+	plan := &Plan{From: from, To: to}
+	for _, col := range cfg.Columns {
+		names, err := models.TicketNames(cfg.ColumnPath(col.Dir))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			ticket, err := models.ParseTicket(filepath.Join(cfg.ColumnPath(col.Dir), name))
+			if err != nil {
+				return nil, err
+			}
+			if ticket.HasTag(from) {
+				plan.Changes = append(plan.Changes, Change{
+					Ticket: ticket,
+					Before: ticket.Tags,
+					After:  renameTag(ticket.Tags, from, to),
+				})
+			}
+		}
+	}
+	return plan, nil
+}
+
+// Apply writes the renamed tags, leaving each ticket's content and updated
+// time as they were.
+func (p *Plan) Apply() error {
+	for _, c := range p.Changes {
+		c.Ticket.Tags = c.After
+		if err := c.Ticket.WriteFile(); err != nil {
+			return fmt.Errorf("writing %s: %w", c.Ticket.FilePath, err)
+		}
+	}
+	return nil
+}
+
+// renameTag returns tags with from replaced by to in place, dropping
+// duplicates of to.
+func renameTag(tags []string, from, to string) []string {
+	renamed := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if tag == from {
+			tag = to
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			renamed = append(renamed, tag)
+		}
+	}
+	return renamed
+}
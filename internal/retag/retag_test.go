@@ -0,0 +1,78 @@
+package retag
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func TestPlanApply(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	if err := cfg.EnsureDirectories(); err != nil {
+		t.Fatal(err)
+	}
+
+	updated := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	add := func(title, dir string, tags ...string) *models.Ticket {
+		ticket := models.NewTicket(title, dir)
+		ticket.Tags = tags
+		ticket.Updated = updated
+		path, err := ticket.UniqueFilePath(cfg.ColumnPath(dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.FilePath = path
+		if err := ticket.WriteFile(); err != nil {
+			t.Fatal(err)
+		}
+		return ticket
+	}
+	renamed := add("Fix login", "todo", "bug", "auth")
+	merged := add("Crash on save", "done", "bugfix", "bug")
+	untouched := add("Write docs", "doing", "docs", "debug")
+
+	plan, err := NewPlan(cfg, "bug", "bugfix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Changes) != 2 {
+		t.Fatalf("changes = %d, want 2", len(plan.Changes))
+	}
+	if err := plan.Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		ticket *models.Ticket
+		want   []string
+	}{
+		{renamed, []string{"bugfix", "auth"}},
+		{merged, []string{"bugfix"}},
+		{untouched, []string{"docs", "debug"}},
+	} {
+		got, err := models.ParseTicket(tt.ticket.FilePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Tags, tt.want) {
+			t.Errorf("%s: tags = %q, want %q", tt.ticket.Title, got.Tags, tt.want)
+		}
+		if !got.Updated.Equal(updated) {
+			t.Errorf("%s: updated = %v, want it unchanged", tt.ticket.Title, got.Updated)
+		}
+	}
+}
+
+func TestNewPlanRejectsBadNames(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	for _, names := range [][2]string{{"", "x"}, {"x", " "}, {"x", "x"}, {"x", "a,b"}} {
+		if _, err := NewPlan(cfg, names[0], names[1]); err == nil {
+			t.Errorf("NewPlan(%q, %q) succeeded", names[0], names[1])
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Package scan finds TODO/FIXME/HACK comments in source trees.
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxFileSize skips files larger than this (generated code, data files).
+const maxFileSize = 1 << 20
+
+// skipDirs are directory names never descended into.
+var skipDirs = map[string]bool{
+	".git":         true,
+	".hg":          true,
+	".svn":         true,
+	".kanban":      true,
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// commentRe matches a marker following a common comment leader.
+var commentRe = regexp.MustCompile(`(?://|#|/\*|--|;|<!--|\*)\s*\b(TODO|FIXME|HACK)\b(?:\([^)]*\))?:?\s*(.*)`)
+
+// Comment is a marker comment found in a source file.
+type Comment struct {
+	// Kind is the marker: TODO, FIXME or HACK
+	Kind string
+	// Text is the comment text after the marker
+	Text string
+	// File is the path relative to the scanned root, with forward slashes
+	File string
+	// Line is the 1-based line number
+	Line int
+	// ID is a stable hash of the kind, file and text, unaffected by line moves
+	ID string
+}
+
+// Ref returns the comment's file:line reference.
+func (c Comment) Ref() string {
+	return c.File + ":" + strconv.Itoa(c.Line)
+}
+
+// Dir walks root and returns every marker comment in text files, skipping
+// VCS, dependency and kanban directories.
+func Dir(root string) ([]Comment, error) {
+	var comments []Comment
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // Unreadable entries are skipped
+		}
+		if d.IsDir() {
+			if path != root && skipDirs[d.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil || info.Size() > maxFileSize {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return nil
+		}
+		found, err := File(path, filepath.ToSlash(rel))
+		if err != nil {
+			return nil
+		}
+		comments = append(comments, found...)
+		return nil
+	})
+	return comments, err
+}
+
+// File returns the marker comments in one file, reported under name.
+// Binary files yield no comments.
+func File(path, name string) ([]Comment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.IndexByte(data[:min(len(data), 8000)], 0) >= 0 {
+		return nil, nil
+	}
+
+	var comments []Comment
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFileSize)
+	for line := 1; scanner.Scan(); line++ {
+		match := commentRe.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		text := cleanText(match[2])
+		comments = append(comments, Comment{
+			Kind: match[1],
+			Text: text,
+			File: name,
+			Line: line,
+			ID:   commentID(match[1], name, text),
+		})
+	}
+	return comments, scanner.Err()
+}
+
+// cleanText strips comment terminators and surrounding whitespace.
+func cleanText(text string) string {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSuffix(text, "-->")
+	text = strings.TrimSuffix(text, "*/")
+	return strings.TrimSpace(text)
+}
+
+// commentID hashes the parts of a comment that survive edits elsewhere in
+// the file.
+func commentID(kind, file, text string) string {
+	sum := sha1.Sum([]byte(kind + "\x00" + file + "\x00" + strings.Join(strings.Fields(text), " ")))
+	return hex.EncodeToString(sum[:])[:12]
+}
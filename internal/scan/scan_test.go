@@ -0,0 +1,56 @@
+package scan
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDir(t *testing.T) {
+	root := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(root, name)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("main.go", "package main\n\n// TODO: handle errors\nfunc main() {} // FIXME(bob) leaks\n")
+	write("build.sh", "#!/bin/sh\n# HACK work around flaky mirror\n")
+	write("style.css", "/* TODO: dark mode */\n")
+	write("node_modules/dep/index.js", "// TODO: ignored\n")
+	write(".kanban/todo/ticket.md", "# TODO ignored\n")
+	write("image.bin", "\x00\x01// TODO: binary\n")
+	write("notes.txt", "Mentions TODO without a comment leader\n")
+
+	comments, err := Dir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"build.sh:2":  "HACK work around flaky mirror",
+		"main.go:3":   "TODO handle errors",
+		"main.go:4":   "FIXME leaks",
+		"style.css:1": "TODO dark mode",
+	}
+	if len(comments) != len(want) {
+		t.Fatalf("got %d comments %+v, want %d", len(comments), comments, len(want))
+	}
+	for _, c := range comments {
+		if got := c.Kind + " " + c.Text; want[c.Ref()] != got {
+			t.Errorf("%s = %q, want %q", c.Ref(), got, want[c.Ref()])
+		}
+	}
+}
+
+func TestCommentIDStableAcrossLineMoves(t *testing.T) {
+	a := commentID("TODO", "main.go", "handle  errors")
+	b := commentID("TODO", "main.go", "handle errors")
+	if a != b {
+		t.Errorf("IDs differ for whitespace-only changes: %s vs %s", a, b)
+	}
+	if a == commentID("TODO", "other.go", "handle errors") {
+		t.Error("IDs should differ across files")
+	}
+}
@@ -0,0 +1,148 @@
+// Package search keeps an in-memory inverted index over ticket titles, tags
+// and content so searching large boards doesn't re-scan every ticket.
+package search
+
+import (
+	"hash/maphash"
+	"strings"
+	"unicode"
+
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// doc is an indexed ticket.
+type doc struct {
+	// sum hashes the indexed text, to skip unchanged tickets
+	sum    uint64
+	tokens []string
+}
+
+// Index maps words to the tickets (by file path) containing them.
+type Index struct {
+	docs     map[string]doc
+	postings map[string]map[string]bool
+	seed     maphash.Seed
+	// generation changes whenever the indexed tickets change, so callers
+	// can cache search results
+	generation int
+}
+
+// NewIndex returns an empty index.
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]doc),
+		postings: make(map[string]map[string]bool),
+		seed:     maphash.MakeSeed(),
+	}
+}
+
+// Generation returns a counter that changes whenever the index does.
+func (idx *Index) Generation() int {
+	return idx.generation
+}
+
+// Len returns the number of indexed tickets.
+func (idx *Index) Len() int {
+	return len(idx.docs)
+}
+
+// Sync makes the index match tickets: tickets that are new or changed since
+// they were indexed are (re)indexed and tickets no longer present are
+// dropped. Unchanged tickets cost a map lookup, so Sync can run after
+// every reload.
+func (idx *Index) Sync(tickets []*models.Ticket) {
+	seen := make(map[string]bool, len(tickets))
+	for _, t := range tickets {
+		seen[t.FilePath] = true
+		idx.Add(t)
+	}
+	for path := range idx.docs {
+		if !seen[path] {
+			idx.Remove(path)
+		}
+	}
+}
+
+// Add indexes a ticket, replacing any earlier version with the same path.
+// The ticket is skipped when its title, tags and content hash to the same
+// sum as the indexed version, whatever its timestamps say.
+func (idx *Index) Add(t *models.Ticket) {
+	text := t.Title + " " + strings.Join(t.Tags, " ") + " " + t.Content
+	sum := maphash.String(idx.seed, text)
+	if d, ok := idx.docs[t.FilePath]; ok {
+		if d.sum == sum {
+			return
+		}
+		idx.Remove(t.FilePath)
+	}
+
+	d := doc{sum: sum, tokens: Tokenize(text)}
+	for _, token := range d.tokens {
+		paths := idx.postings[token]
+		if paths == nil {
+			paths = make(map[string]bool)
+			idx.postings[token] = paths
+		}
+		paths[t.FilePath] = true
+	}
+	idx.docs[t.FilePath] = d
+	idx.generation++
+}
+
+// Remove drops the ticket stored at path.
+func (idx *Index) Remove(path string) {
+	d, ok := idx.docs[path]
+	if !ok {
+		return
+	}
+	for _, token := range d.tokens {
+		delete(idx.postings[token], path)
+		if len(idx.postings[token]) == 0 {
+			delete(idx.postings, token)
+		}
+	}
+	delete(idx.docs, path)
+	idx.generation++
+}
+
+// Search returns the paths of tickets matching every word of query. A query
+// word matches any indexed word containing it, ignoring case and
+// diacritics, so "auth" finds "OAuth" and "authentication". It returns nil
+// when query has no words to look for.
+func (idx *Index) Search(query string) map[string]bool {
+	var result map[string]bool
+	for _, term := range Tokenize(query) {
+		matches := make(map[string]bool)
+		for token, paths := range idx.postings {
+			if !strings.Contains(token, term) {
+				continue
+			}
+			for path := range paths {
+				if result == nil || result[path] {
+					matches[path] = true
+				}
+			}
+		}
+		result = matches
+		if len(result) == 0 {
+			break
+		}
+	}
+	return result
+}
+
+// Tokenize folds text for searching and splits it into unique words.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(models.FoldForSearch(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool, len(words))
+	tokens := words[:0]
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			tokens = append(tokens, w)
+		}
+	}
+	return tokens
+}
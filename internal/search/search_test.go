@@ -0,0 +1,108 @@
+package search
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/demo"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+func ticket(path, title, content string, tags ...string) *models.Ticket {
+	return &models.Ticket{FilePath: path, Title: title, Content: content, Tags: tags, Updated: time.Unix(1, 0)}
+}
+
+func paths(hits map[string]bool) []string {
+	var out []string
+	for p := range hits {
+		out = append(out, p)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestSearch(t *testing.T) {
+	idx := NewIndex()
+	login := ticket("a.md", "Fix login redirect", "OAuth callback loses the state")
+	cafe := ticket("b.md", "Café menu", "Update prices", "frontend")
+	idx.Sync([]*models.Ticket{login, cafe})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"login", []string{"a.md"}},
+		{"auth", []string{"a.md"}},
+		{"CAFE", []string{"b.md"}},
+		{"frontend", []string{"b.md"}},
+		{"fix state", []string{"a.md"}},
+		{"fix prices", nil},
+		{"e", []string{"a.md", "b.md"}},
+	}
+	for _, tt := range tests {
+		if got := paths(idx.Search(tt.query)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+	if idx.Search("  !! ") != nil {
+		t.Error("Search without words should return nil")
+	}
+
+	// Changed tickets are re-indexed, missing ones dropped
+	gen := idx.Generation()
+	idx.Sync([]*models.Ticket{login, cafe})
+	if idx.Generation() != gen {
+		t.Error("Sync re-indexed unchanged tickets")
+	}
+	edited := ticket("a.md", "Fix logout", "Session stays alive")
+	edited.Updated = time.Unix(2, 0)
+	idx.Sync([]*models.Ticket{edited})
+	if got := paths(idx.Search("login")); got != nil {
+		t.Errorf("stale match for login: %v", got)
+	}
+	if got := paths(idx.Search("logout")); !reflect.DeepEqual(got, []string{"a.md"}) {
+		t.Errorf("Search(logout) = %v", got)
+	}
+	if idx.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", idx.Len())
+	}
+}
+
+func TestSyncSameTimestamp(t *testing.T) {
+	idx := NewIndex()
+	idx.Sync([]*models.Ticket{ticket("a.md", "Fix login", "Steps", "bug")})
+
+	// Edits keeping the same updated time and content size still count
+	retagged := ticket("a.md", "Fix login", "Steps", "ops")
+	idx.Sync([]*models.Ticket{retagged})
+	if got := paths(idx.Search("ops")); !reflect.DeepEqual(got, []string{"a.md"}) {
+		t.Errorf("Search(ops) = %v", got)
+	}
+	reworded := ticket("a.md", "Fix login", "Stops", "ops")
+	idx.Sync([]*models.Ticket{reworded})
+	if got := paths(idx.Search("steps")); got != nil {
+		t.Errorf("stale match for steps: %v", got)
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	columns := demo.SyntheticColumns(5)
+	var tickets []*models.Ticket
+	for i := 0; i < 5000; i++ {
+		t := demo.SyntheticTicket(rng, i, columns, time.Now())
+		t.FilePath = t.GenerateFilename()
+		tickets = append(tickets, t)
+	}
+	idx := NewIndex()
+	idx.Sync(tickets)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.Search("render cache")
+	}
+}
@@ -0,0 +1,167 @@
+// Package secret encrypts the private section of tickets, so sensitive
+// notes can be committed to a shared repository while titles and the rest
+// of the ticket stay readable. Values are sealed with AES-256-GCM under a
+// board key kept in the system keychain or $KANBAN_KEY.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/platform"
+)
+
+// Prefix marks a sealed value; the version lets the format change later.
+const Prefix = "enc:v1:"
+
+// Service is the keychain service board keys are stored under.
+const Service = "kanban-tui"
+
+// KeyEnv names the environment variable that overrides the keychain.
+const KeyEnv = "KANBAN_KEY"
+
+// keySize is the AES-256 key length in bytes.
+const keySize = 32
+
+var (
+	// ErrNoKey is returned when no board key is configured.
+	ErrNoKey = errors.New("no encryption key")
+	// ErrWrongKey is returned when a value was sealed with another key or
+	// was tampered with.
+	ErrWrongKey = errors.New("wrong encryption key or corrupted value")
+)
+
+// GenerateKey returns a new random key, base64-encoded for sharing.
+func GenerateKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ParseKey decodes a base64-encoded key.
+func ParseKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(key) != keySize {
+		return nil, fmt.Errorf("invalid key: want %d base64-encoded bytes", keySize)
+	}
+	return key, nil
+}
+
+// Account returns the keychain account of a board: the absolute path of
+// its directory, so projects that share a directory name keep separate keys.
+func Account(kanbanDir string) string {
+	abs, err := filepath.Abs(kanbanDir)
+	if err != nil {
+		return filepath.Clean(kanbanDir)
+	}
+	return abs
+}
+
+// legacyAccount is the account keys were stored under before Account: the
+// project directory's name. Keys found there are still read.
+func legacyAccount(kanbanDir string) string {
+	return filepath.Base(filepath.Dir(Account(kanbanDir)))
+}
+
+// StoredKey returns the base64-encoded key kept in the keychain for the
+// board in kanbanDir, ignoring $KANBAN_KEY.
+func StoredKey(kanbanDir string) (string, error) {
+	s, err := platform.KeychainGet(Service, Account(kanbanDir))
+	if err != nil && !errors.Is(err, platform.ErrNoKeychain) {
+		if legacy, lerr := platform.KeychainGet(Service, legacyAccount(kanbanDir)); lerr == nil {
+			return legacy, nil
+		}
+	}
+	return s, err
+}
+
+// LoadKey returns the key of the board in kanbanDir: $KANBAN_KEY when set,
+// else the keychain entry for the board's account.
+func LoadKey(kanbanDir string) ([]byte, error) {
+	if s := os.Getenv(KeyEnv); s != "" {
+		return ParseKey(s)
+	}
+	s, err := StoredKey(kanbanDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: set $%s or run kanban key set (%v)", ErrNoKey, KeyEnv, err)
+	}
+	return ParseKey(s)
+}
+
+// StoreKey saves a base64-encoded key in the keychain for the board in
+// kanbanDir.
+func StoreKey(kanbanDir, key string) error {
+	if _, err := ParseKey(key); err != nil {
+		return err
+	}
+	return platform.KeychainSet(Service, Account(kanbanDir), strings.TrimSpace(key))
+}
+
+// IsSealed reports whether s is a sealed value.
+func IsSealed(s string) bool {
+	return strings.HasPrefix(s, Prefix)
+}
+
+// Seal encrypts plaintext with key. An empty plaintext stays empty, so an
+// emptied private section disappears from the frontmatter.
+func Seal(key []byte, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return Prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value sealed with key. A value that isn't sealed (e.g.
+// typed into the file by hand) is returned as-is, to be sealed on the next
+// save.
+func Open(key []byte, value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, Prefix))
+	if err != nil {
+		return "", ErrWrongKey
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", ErrWrongKey
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", ErrWrongKey
+	}
+	return string(plain), nil
+}
+
+// newGCM returns the AES-GCM cipher for key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("invalid key: want %d bytes", keySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,70 @@
+package secret
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	encoded, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ParseKey(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := Seal(key, "VPN password: hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealed(sealed) || strings.Contains(sealed, "hunter2") {
+		t.Fatalf("Seal = %q", sealed)
+	}
+	if again, _ := Seal(key, "VPN password: hunter2"); again == sealed {
+		t.Error("sealing twice gave the same value; nonces must differ")
+	}
+	if got, err := Open(key, sealed); err != nil || got != "VPN password: hunter2" {
+		t.Errorf("Open = %q, %v", got, err)
+	}
+
+	other, _ := GenerateKey()
+	otherKey, _ := ParseKey(other)
+	if _, err := Open(otherKey, sealed); !errors.Is(err, ErrWrongKey) {
+		t.Errorf("Open with another key: err = %v", err)
+	}
+	if got, err := Open(key, "typed by hand"); err != nil || got != "typed by hand" {
+		t.Errorf("Open(plain) = %q, %v", got, err)
+	}
+	if got, _ := Seal(key, ""); got != "" {
+		t.Errorf("Seal(\"\") = %q", got)
+	}
+}
+
+func TestLoadKeyFromEnv(t *testing.T) {
+	encoded, _ := GenerateKey()
+	t.Setenv(KeyEnv, encoded)
+	key, err := LoadKey(t.TempDir())
+	if err != nil || len(key) != keySize {
+		t.Fatalf("LoadKey = %d bytes, %v", len(key), err)
+	}
+
+	t.Setenv(KeyEnv, "c2hvcnQ=")
+	if _, err := LoadKey(t.TempDir()); err == nil {
+		t.Error("LoadKey accepted a short key")
+	}
+}
+
+func TestAccount(t *testing.T) {
+	a := Account(filepath.Join("/work", "api", ".kanban"))
+	b := Account(filepath.Join("/home", "ann", "api", ".kanban"))
+	if a == b {
+		t.Errorf("boards of two projects named api share the account %q", a)
+	}
+	if got := legacyAccount(filepath.Join("/work", "api", ".kanban")); got != "api" {
+		t.Errorf("legacyAccount = %q", got)
+	}
+}
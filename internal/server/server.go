@@ -0,0 +1,582 @@
+// Package server serves a board over HTTP: a JSON API for reading and
+// changing tickets, a server-sent event stream of board changes, and a web
+// board built on both.
+package server
+
+import (
+	"crypto/subtle"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/hooks"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/watcher"
+)
+
+// webFiles are the static assets of the web board.
+//
+//go:embed web
+var webFiles embed.FS
+
+// keepAlive is how often an idle event stream gets a comment, so proxies
+// don't close it.
+const keepAlive = 30 * time.Second
+
+// Event is a board change pushed to event stream clients.
+type Event struct {
+	// Op is "create", "write", "remove" or "rename": the last operation on
+	// the file within the watcher's debounce window. A move shows up as a
+	// change of the old and the new ID.
+	Op string `json:"op"`
+	// ID is the ticket's column directory and file name, e.g.
+	// "todo/2025-01-02-fix-login.md"
+	ID string `json:"id"`
+}
+
+// Auth holds the credentials clients must present. With neither a token
+// nor a password set, the server is open.
+type Auth struct {
+	// Token is accepted as a bearer token, a token query parameter (which
+	// also sets a cookie, so a browser opened on /?token=... stays signed
+	// in) or that cookie
+	Token string
+	// User and Password are accepted as HTTP basic auth
+	User     string
+	Password string
+}
+
+// enabled reports whether any credential is required.
+func (a Auth) enabled() bool {
+	return a.Token != "" || a.Password != ""
+}
+
+// Server serves one board.
+type Server struct {
+	// Auth is checked on every request
+	Auth Auth
+	// Hosts are the host names the server answers to besides localhost and
+	// IP addresses; requests for other hosts are rejected, so a page on a
+	// site that re-resolves its name to this machine can't read the board
+	Hosts []string
+
+	cfg *config.Config
+
+	mu      sync.Mutex
+	clients map[chan Event]bool
+}
+
+// New creates a server for the board of cfg.
+func New(cfg *config.Config) *Server {
+	return &Server{cfg: cfg, clients: make(map[chan Event]bool)}
+}
+
+// Ticket is a ticket in API responses and requests.
+type Ticket struct {
+	ID       string    `json:"id"`
+	Title    string    `json:"title"`
+	Tags     []string  `json:"tags"`
+	Column   string    `json:"column"`
+	Created  time.Time `json:"created"`
+	Updated  time.Time `json:"updated"`
+	Priority int       `json:"priority,omitempty"`
+	Due      string    `json:"due,omitempty"`
+	Content  string    `json:"content,omitempty"`
+}
+
+// Column is a column and its tickets in the board response.
+type Column struct {
+	Name    string   `json:"name"`
+	Dir     string   `json:"dir"`
+	Color   string   `json:"color,omitempty"`
+	Tickets []Ticket `json:"tickets"`
+}
+
+// Handler returns the HTTP handler serving the web board at / and the API:
+//
+//	GET    /api/board                 columns with their tickets (without content)
+//	GET    /api/tickets/{col}/{file}  one ticket with content
+//	POST   /api/tickets               create {title, tags, content, column}
+//	POST   /api/tickets/{col}/{file}/move  move {column}
+//	DELETE /api/tickets/{col}/{file}  move a ticket to the trash
+//	GET    /api/events                server-sent events of board changes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/board", s.handleBoard)
+	mux.HandleFunc("/api/tickets", s.handleCreate)
+	mux.HandleFunc("/api/tickets/", s.handleTicket)
+	mux.HandleFunc("/api/events", s.handleEvents)
+	web, _ := fs.Sub(webFiles, "web")
+	mux.Handle("/", http.FileServer(http.FS(web)))
+	if !s.Auth.enabled() {
+		return s.sameOrigin(mux)
+	}
+	return s.sameOrigin(s.requireAuth(mux))
+}
+
+// sameOrigin rejects requests other web pages can make to the server:
+// requests for an unknown host, and changes sent from another origin or
+// without a JSON body. Browsers send cross-site form and text/plain posts
+// without asking, but not JSON ones.
+func (s *Server) sameOrigin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.knownHost(r.Host) {
+			httpError(w, http.StatusForbidden, fmt.Errorf("unknown host %q", r.Host))
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			if origin := r.Header.Get("Origin"); origin != "" {
+				if u, err := url.Parse(origin); err != nil || u.Host != r.Host {
+					httpError(w, http.StatusForbidden, errors.New("cross-origin request"))
+					return
+				}
+			} else if r.Header.Get("Sec-Fetch-Site") == "cross-site" {
+				httpError(w, http.StatusForbidden, errors.New("cross-origin request"))
+				return
+			}
+			if r.Method == http.MethodPost {
+				if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+					httpError(w, http.StatusUnsupportedMediaType, errors.New("content type must be application/json"))
+					return
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// knownHost reports whether host, from a request's Host header, names
+// this server.
+func (s *Server) knownHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") || net.ParseIP(host) != nil {
+		return true
+	}
+	for _, h := range s.Hosts {
+		if strings.EqualFold(host, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenCookie is the cookie holding the token of a signed-in browser.
+const tokenCookie = "kanban_token"
+
+// requireAuth rejects requests without valid credentials.
+func (s *Server) requireAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("token"); token != "" && s.validToken(token) {
+			http.SetCookie(w, &http.Cookie{
+				Name:     tokenCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   r.TLS != nil,
+				SameSite: http.SameSiteStrictMode,
+			})
+			next.ServeHTTP(w, r)
+			return
+		}
+		if s.authorized(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if s.Auth.Password != "" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="kanban", charset="UTF-8"`)
+		}
+		httpError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+	})
+}
+
+// authorized reports whether r carries a valid bearer token, token cookie
+// or basic auth credentials.
+func (s *Server) authorized(r *http.Request) bool {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && s.validToken(token) {
+		return true
+	}
+	if c, err := r.Cookie(tokenCookie); err == nil && s.validToken(c.Value) {
+		return true
+	}
+	if user, password, ok := r.BasicAuth(); ok && s.Auth.Password != "" {
+		userOK := subtle.ConstantTimeCompare([]byte(user), []byte(s.Auth.User)) == 1
+		passwordOK := subtle.ConstantTimeCompare([]byte(password), []byte(s.Auth.Password)) == 1
+		return userOK && passwordOK
+	}
+	return false
+}
+
+// validToken compares token with the configured one in constant time.
+func (s *Server) validToken(token string) bool {
+	return s.Auth.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.Auth.Token)) == 1
+}
+
+// Watch pushes changes of the board's column directories to event stream
+// clients until stop is closed.
+func (s *Server) Watch(stop <-chan struct{}) error {
+	w, err := watcher.New(150 * time.Millisecond)
+	if err != nil {
+		return fmt.Errorf("creating watcher: %w", err)
+	}
+	defer w.Close()
+	for _, col := range s.cfg.Columns {
+		if err := w.Add(s.cfg.ColumnPath(col.Dir)); err != nil {
+			return fmt.Errorf("watching %s: %w", col.Dir, err)
+		}
+	}
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		case e := <-w.Events:
+			s.broadcast(Event{Op: opName(e.Op), ID: s.ticketID(e.Path)})
+		case err := <-w.Errors:
+			slog.Warn("server watcher error", "err", err)
+		}
+	}
+}
+
+// opName names a file operation for clients.
+func opName(op fsnotify.Op) string {
+	switch {
+	case op&fsnotify.Create != 0:
+		return "create"
+	case op&fsnotify.Remove != 0:
+		return "remove"
+	case op&fsnotify.Rename != 0:
+		return "rename"
+	}
+	return "write"
+}
+
+// broadcast sends e to every event stream client. Clients that fall
+// behind miss events rather than stall the others.
+func (s *Server) broadcast(e Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.clients {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// handleEvents streams board changes as server-sent events.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		httpError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httpError(w, http.StatusInternalServerError, errors.New("streaming unsupported"))
+		return
+	}
+
+	ch := make(chan Event, 16)
+	s.mu.Lock()
+	s.clients[ch] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, ch)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case e := <-ch:
+			data, _ := json.Marshal(e)
+			fmt.Fprintf(w, "event: change\ndata: %s\n\n", data)
+		}
+		flusher.Flush()
+	}
+}
+
+// handleBoard lists the columns and their tickets.
+func (s *Server) handleBoard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		httpError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	var columns []Column
+	for _, col := range s.cfg.Columns {
+		tickets, err := s.readColumn(col.Dir)
+		if err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		c := Column{Name: col.Name, Dir: col.Dir, Color: col.Color, Tickets: []Ticket{}}
+		for _, t := range tickets {
+			c.Tickets = append(c.Tickets, s.ticketJSON(t, false))
+		}
+		columns = append(columns, c)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"columns": columns})
+}
+
+// readColumn parses a column's tickets in the configured order.
+func (s *Server) readColumn(dir string) ([]*models.Ticket, error) {
+	tickets, err := models.ReadColumn(s.cfg.ColumnPath(dir))
+	if err != nil {
+		return nil, err
+	}
+	models.SortTickets(tickets, s.cfg.SortBy, s.cfg.Locale)
+	return tickets, nil
+}
+
+// relink points the board's references to a ticket renamed from oldName at
+// newName, as a move renames a ticket whose name is taken in the target
+// column.
+func (s *Server) relink(oldName, newName string) error {
+	for _, col := range s.cfg.Columns {
+		tickets, err := s.readColumn(col.Dir)
+		if err != nil {
+			return err
+		}
+		for _, t := range tickets {
+			if t.Relink(oldName, newName) {
+				if err := t.WriteFile(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// handleCreate creates a ticket.
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		httpError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	var req struct {
+		Title   string   `json:"title"`
+		Tags    []string `json:"tags"`
+		Content string   `json:"content"`
+		Column  string   `json:"column"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+	req.Title = strings.TrimSpace(req.Title)
+	if req.Title == "" {
+		httpError(w, http.StatusBadRequest, errors.New("title must not be empty"))
+		return
+	}
+	if req.Column == "" {
+		req.Column = s.cfg.Columns[0].Dir
+	}
+	col, ok := s.cfg.ColumnByDir(req.Column)
+	if !ok {
+		httpError(w, http.StatusBadRequest, fmt.Errorf("unknown column %q", req.Column))
+		return
+	}
+
+	ticket := models.NewTicket(req.Title, req.Column)
+	ticket.Tags, ticket.Content = col.WithDefaults(req.Tags, strings.TrimSpace(req.Content))
+	if err := os.MkdirAll(s.cfg.ColumnPath(req.Column), 0755); err != nil {
+		httpError(w, http.StatusInternalServerError, err)
+		return
+	}
+	path, err := ticket.UniqueFilePath(s.cfg.ColumnPath(req.Column))
+	if err == nil {
+		ticket.FilePath = path
+		err = ticket.Save()
+	}
+	if err != nil {
+		httpError(w, http.StatusInternalServerError, err)
+		return
+	}
+	s.runHooks(ticket, "", hooks.Create)
+	writeJSON(w, http.StatusCreated, s.ticketJSON(ticket, true))
+}
+
+// handleTicket reads, moves or trashes the ticket named by the path.
+func (s *Server) handleTicket(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/api/tickets/")
+	action := ""
+	if trimmed, ok := strings.CutSuffix(rest, "/move"); ok {
+		rest, action = trimmed, "move"
+	}
+	ticket, err := s.lookup(rest)
+	if err != nil {
+		httpError(w, http.StatusNotFound, err)
+		return
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.ticketJSON(ticket, true))
+
+	case action == "" && r.Method == http.MethodDelete:
+		if _, err := ticket.Trash(s.cfg.KanbanDir); err != nil {
+			httpError(w, http.StatusInternalServerError, err)
+			return
+		}
+		s.runHooks(ticket, "", hooks.Delete)
+		w.WriteHeader(http.StatusNoContent)
+
+	case action == "move" && r.Method == http.MethodPost:
+		var req struct {
+			Column string `json:"column"`
+			Reason string `json:"reason"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httpError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+			return
+		}
+		if !s.isColumn(req.Column) {
+			httpError(w, http.StatusBadRequest, fmt.Errorf("unknown column %q", req.Column))
+			return
+		}
+		if from := ticket.Column; from != req.Column {
+			reason := strings.TrimSpace(req.Reason)
+			if reason == "" && s.cfg.ReasonRequired(from, req.Column) {
+				httpError(w, http.StatusBadRequest, fmt.Errorf("moving from %s to %s requires a reason", from, req.Column))
+				return
+			}
+			oldName := ticket.Filename()
+			if err := ticket.Move(s.cfg.KanbanDir, req.Column); err != nil {
+				httpError(w, http.StatusInternalServerError, err)
+				return
+			}
+			if oldName != ticket.Filename() {
+				if err := s.relink(oldName, ticket.Filename()); err != nil {
+					httpError(w, http.StatusInternalServerError, err)
+					return
+				}
+			}
+			if reason != "" {
+				ticket.SetMoveReason(reason)
+				if err := ticket.WriteFile(); err != nil {
+					httpError(w, http.StatusInternalServerError, err)
+					return
+				}
+			}
+			events := []hooks.Event{hooks.Move}
+			if req.Column == s.cfg.DoneColumn() {
+				events = append(events, hooks.Done)
+			}
+			s.runHooks(ticket, from, events...)
+		}
+		writeJSON(w, http.StatusOK, s.ticketJSON(ticket, true))
+
+	default:
+		httpError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+}
+
+// lookup parses the ticket with ID "column/file.md". Only tickets in
+// configured columns can be reached.
+func (s *Server) lookup(id string) (*models.Ticket, error) {
+	dir, name, ok := strings.Cut(id, "/")
+	if !ok || !s.isColumn(dir) || name == "" || name != filepath.Base(name) ||
+		strings.HasPrefix(name, ".") || filepath.Ext(name) != ".md" {
+		return nil, fmt.Errorf("no ticket %q", id)
+	}
+	ticket, err := models.ParseTicket(filepath.Join(s.cfg.ColumnPath(dir), name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no ticket %q", id)
+	}
+	return ticket, err
+}
+
+// isColumn reports whether dir is a configured column directory.
+func (s *Server) isColumn(dir string) bool {
+	for _, col := range s.cfg.Columns {
+		if col.Dir == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// ticketID returns the ID of the ticket file at path.
+func (s *Server) ticketID(path string) string {
+	return filepath.Base(filepath.Dir(path)) + "/" + filepath.Base(path)
+}
+
+// ticketJSON converts a ticket for a response.
+func (s *Server) ticketJSON(t *models.Ticket, withContent bool) Ticket {
+	tags := t.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	out := Ticket{
+		ID:       s.ticketID(t.FilePath),
+		Title:    t.Title,
+		Tags:     tags,
+		Column:   t.Column,
+		Created:  t.Created,
+		Updated:  t.Updated,
+		Priority: t.Priority,
+		Due:      t.Due,
+	}
+	if withContent {
+		out.Content = t.Content
+	}
+	return out
+}
+
+// runHooks runs the configured hooks for a change made through the API in
+// the background, in order.
+func (s *Server) runHooks(ticket *models.Ticket, from string, events ...hooks.Event) {
+	var payloads []hooks.Payload
+	for _, event := range events {
+		payloads = append(payloads, hooks.NewPayload(event, ticket, from))
+	}
+	go func() {
+		for _, p := range payloads {
+			if command := hooks.Command(s.cfg.Hooks, p.Event); command != "" {
+				if err := hooks.Run(command, p); err != nil {
+					slog.Warn("hook failed", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// httpError writes err as a JSON error response.
+func httpError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
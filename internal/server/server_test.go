@@ -0,0 +1,239 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/user/kanban-tui/internal/config"
+)
+
+// newTestServer serves a fresh board.
+func newTestServer(t *testing.T) (*Server, *httptest.Server) {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	if err := cfg.EnsureDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	s := New(cfg)
+	ts := httptest.NewServer(s.Handler())
+	t.Cleanup(ts.Close)
+	return s, ts
+}
+
+// do sends a request and decodes the JSON response into v, if given.
+func do(t *testing.T, method, url, body string, wantStatus int, v any) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantStatus {
+		t.Fatalf("%s %s: status %d, want %d", method, url, resp.StatusCode, wantStatus)
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTicketAPI(t *testing.T) {
+	_, ts := newTestServer(t)
+
+	var created Ticket
+	do(t, "POST", ts.URL+"/api/tickets", `{"title":"Fix login","tags":["bug"],"content":"Steps"}`, http.StatusCreated, &created)
+	if created.Column != "todo" || !strings.HasPrefix(created.ID, "todo/") {
+		t.Fatalf("created = %+v", created)
+	}
+
+	var got Ticket
+	do(t, "GET", ts.URL+"/api/tickets/"+created.ID, "", http.StatusOK, &got)
+	if got.Title != "Fix login" || got.Content != "Steps" {
+		t.Errorf("got = %+v", got)
+	}
+
+	var moved Ticket
+	do(t, "POST", ts.URL+"/api/tickets/"+created.ID+"/move", `{"column":"doing"}`, http.StatusOK, &moved)
+	if moved.Column != "doing" || !strings.HasPrefix(moved.ID, "doing/") {
+		t.Errorf("moved = %+v", moved)
+	}
+
+	var board struct{ Columns []Column }
+	do(t, "GET", ts.URL+"/api/board", "", http.StatusOK, &board)
+	if len(board.Columns) != 3 || len(board.Columns[1].Tickets) != 1 || board.Columns[1].Tickets[0].Content != "" {
+		t.Errorf("board = %+v", board)
+	}
+
+	do(t, "DELETE", ts.URL+"/api/tickets/"+moved.ID, "", http.StatusNoContent, nil)
+	do(t, "GET", ts.URL+"/api/tickets/"+moved.ID, "", http.StatusNotFound, nil)
+}
+
+func TestMoveReason(t *testing.T) {
+	s, ts := newTestServer(t)
+	s.cfg.MoveReasons = []config.Transition{{From: "doing", To: "todo"}}
+
+	var ticket Ticket
+	do(t, "POST", ts.URL+"/api/tickets", `{"title":"Fix login","column":"doing"}`, http.StatusCreated, &ticket)
+	do(t, "POST", ts.URL+"/api/tickets/"+ticket.ID+"/move", `{"column":"todo"}`, http.StatusBadRequest, nil)
+	do(t, "POST", ts.URL+"/api/tickets/"+ticket.ID+"/move", `{"column":"todo","reason":"Blocked on API"}`, http.StatusOK, &ticket)
+
+	parsed, err := s.lookup(ticket.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := parsed.MoveReason(); got != "Blocked on API" {
+		t.Errorf("MoveReason = %q", got)
+	}
+}
+
+func TestTicketAPIRejects(t *testing.T) {
+	_, ts := newTestServer(t)
+	do(t, "POST", ts.URL+"/api/tickets", `{"title":"  "}`, http.StatusBadRequest, nil)
+	do(t, "POST", ts.URL+"/api/tickets", `{"title":"x","column":"nope"}`, http.StatusBadRequest, nil)
+	do(t, "GET", ts.URL+"/api/tickets/todo/../config.yaml", "", http.StatusNotFound, nil)
+	do(t, "GET", ts.URL+"/api/tickets/.trash/x.md", "", http.StatusNotFound, nil)
+	do(t, "PUT", ts.URL+"/api/board", "", http.StatusMethodNotAllowed, nil)
+}
+
+func TestCrossSite(t *testing.T) {
+	_, ts := newTestServer(t)
+	send := func(method, path, contentType string, set func(*http.Request)) int {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(`{"title":"Pwned"}`))
+		req.Header.Set("Content-Type", contentType)
+		if set != nil {
+			set(req)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if status := send("POST", "/api/tickets", "text/plain", nil); status != http.StatusUnsupportedMediaType {
+		t.Errorf("text/plain post: status %d", status)
+	}
+	if status := send("POST", "/api/tickets", "application/json", func(r *http.Request) { r.Header.Set("Origin", "https://evil.example") }); status != http.StatusForbidden {
+		t.Errorf("cross-origin post: status %d", status)
+	}
+	if status := send("DELETE", "/api/tickets/todo/x.md", "", func(r *http.Request) { r.Header.Set("Sec-Fetch-Site", "cross-site") }); status != http.StatusForbidden {
+		t.Errorf("cross-site delete: status %d", status)
+	}
+	if status := send("GET", "/api/board", "", func(r *http.Request) { r.Host = "evil.example" }); status != http.StatusForbidden {
+		t.Errorf("unknown host: status %d", status)
+	}
+	if status := send("POST", "/api/tickets", "application/json; charset=utf-8", func(r *http.Request) { r.Header.Set("Origin", ts.URL) }); status != http.StatusCreated {
+		t.Errorf("same-origin post: status %d", status)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	s, ts := newTestServer(t)
+	resp, err := http.Get(ts.URL + "/api/events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("content type = %q", ct)
+	}
+
+	r := bufio.NewReader(resp.Body)
+	if line, _ := r.ReadString('\n'); line != ": connected\n" {
+		t.Fatalf("first line = %q", line)
+	}
+	s.broadcast(Event{Op: "create", ID: "todo/x.md"})
+	var lines []string
+	for len(lines) < 3 {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line != "\n" || len(lines) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	want := []string{"event: change\n", `data: {"op":"create","id":"todo/x.md"}` + "\n", "\n"}
+	if strings.Join(lines, "") != strings.Join(want, "") {
+		t.Errorf("event = %q, want %q", lines, want)
+	}
+}
+
+func TestWebBoard(t *testing.T) {
+	_, ts := newTestServer(t)
+	for path, want := range map[string]string{"/": "<title>Kanban</title>", "/app.js": "/api/events", "/style.css": ".card"} {
+		resp, err := http.Get(ts.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), want) {
+			t.Errorf("GET %s: status %d, body without %q", path, resp.StatusCode, want)
+		}
+	}
+}
+
+func TestAuth(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = t.TempDir()
+	s := New(cfg)
+	s.Auth = Auth{Token: "s3cret", User: "kanban", Password: "hunter2"}
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+
+	get := func(path string, set func(*http.Request)) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest("GET", ts.URL+path, nil)
+		if set != nil {
+			set(req)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	if resp := get("/api/board", nil); resp.StatusCode != http.StatusUnauthorized || resp.Header.Get("WWW-Authenticate") == "" {
+		t.Errorf("no credentials: status %d", resp.StatusCode)
+	}
+	if resp := get("/api/board", func(r *http.Request) { r.Header.Set("Authorization", "Bearer wrong") }); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("wrong token: status %d", resp.StatusCode)
+	}
+	if resp := get("/api/board", func(r *http.Request) { r.Header.Set("Authorization", "Bearer s3cret") }); resp.StatusCode != http.StatusOK {
+		t.Errorf("bearer token: status %d", resp.StatusCode)
+	}
+	if resp := get("/api/board", func(r *http.Request) { r.SetBasicAuth("kanban", "hunter2") }); resp.StatusCode != http.StatusOK {
+		t.Errorf("basic auth: status %d", resp.StatusCode)
+	}
+	if resp := get("/api/board", func(r *http.Request) { r.SetBasicAuth("admin", "hunter2") }); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("wrong user: status %d", resp.StatusCode)
+	}
+
+	resp := get("/?token=s3cret", nil)
+	cookies := resp.Cookies()
+	if resp.StatusCode != http.StatusOK || len(cookies) != 1 || !cookies[0].HttpOnly {
+		t.Fatalf("token query: status %d, cookies %v", resp.StatusCode, cookies)
+	}
+	if resp := get("/api/board", func(r *http.Request) { r.AddCookie(cookies[0]) }); resp.StatusCode != http.StatusOK {
+		t.Errorf("token cookie: status %d", resp.StatusCode)
+	}
+}
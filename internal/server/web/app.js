@@ -0,0 +1,158 @@
+// Web board for `kanban serve`: renders /api/board, moves tickets by drag
+// and drop or from the ticket dialog, and refetches on server-sent events.
+"use strict";
+
+const boardEl = document.getElementById("board");
+const statusEl = document.getElementById("status");
+const dialog = document.getElementById("ticket");
+let columns = [];
+let openID = null;
+
+function setStatus(text, isError) {
+  statusEl.textContent = text;
+  statusEl.className = isError ? "error" : "";
+}
+
+async function api(method, path, body) {
+  const resp = await fetch(path, {
+    method,
+    headers: body ? { "Content-Type": "application/json" } : {},
+    body: body ? JSON.stringify(body) : undefined,
+  });
+  if (!resp.ok) {
+    const err = await resp.json().catch(() => ({ error: resp.statusText }));
+    throw new Error(err.error);
+  }
+  return resp.status === 204 ? null : resp.json();
+}
+
+function el(tag, className, text) {
+  const e = document.createElement(tag);
+  if (className) e.className = className;
+  if (text !== undefined) e.textContent = text;
+  return e;
+}
+
+function render() {
+  boardEl.replaceChildren();
+  for (const col of columns) {
+    const colEl = el("section", "column");
+    if (col.color) colEl.style.setProperty("--column-color", col.color);
+    const h = el("h2", "", col.name + " ");
+    h.append(el("span", "count", "(" + col.tickets.length + ")"));
+    colEl.append(h);
+
+    colEl.addEventListener("dragover", (e) => {
+      e.preventDefault();
+      colEl.classList.add("drop");
+    });
+    colEl.addEventListener("dragleave", () => colEl.classList.remove("drop"));
+    colEl.addEventListener("drop", (e) => {
+      e.preventDefault();
+      colEl.classList.remove("drop");
+      move(e.dataTransfer.getData("text/plain"), col.dir);
+    });
+
+    for (const t of col.tickets) {
+      const card = el("article", "card");
+      card.draggable = true;
+      card.append(el("div", "title", t.title));
+      if (t.tags.length) card.append(el("div", "tags", t.tags.join(", ")));
+      card.addEventListener("dragstart", (e) => e.dataTransfer.setData("text/plain", t.id));
+      card.addEventListener("click", () => openTicket(t.id));
+      colEl.append(card);
+    }
+    boardEl.append(colEl);
+  }
+}
+
+async function load() {
+  try {
+    columns = (await api("GET", "/api/board")).columns;
+    render();
+  } catch (err) {
+    setStatus("Error: " + err.message, true);
+  }
+}
+
+async function move(id, column, reason) {
+  if (!id || id.startsWith(column + "/")) return;
+  try {
+    const t = await api("POST", "/api/tickets/" + id + "/move", { column, reason });
+    setStatus("Moved " + t.title);
+    await load();
+  } catch (err) {
+    // Transitions listed in move_reasons are refused without a reason
+    if (!reason && err.message.includes("requires a reason")) {
+      const given = prompt("Why is this ticket moving?");
+      if (given && given.trim()) return move(id, column, given.trim());
+      setStatus("Move cancelled");
+      return;
+    }
+    setStatus("Error: " + err.message, true);
+  }
+}
+
+async function openTicket(id) {
+  try {
+    const t = await api("GET", "/api/tickets/" + id);
+    openID = t.id;
+    document.getElementById("ticket-title").textContent = t.title;
+    const tags = t.tags.length ? " · " + t.tags.join(", ") : "";
+    document.getElementById("ticket-meta").textContent =
+      "Updated " + new Date(t.updated).toLocaleString() + tags;
+    document.getElementById("ticket-content").textContent = t.content;
+    const select = document.getElementById("ticket-column");
+    select.replaceChildren(...columns.map((c) => {
+      const o = el("option", "", c.name);
+      o.value = c.dir;
+      o.selected = c.dir === t.column;
+      return o;
+    }));
+    dialog.showModal();
+  } catch (err) {
+    setStatus("Error: " + err.message, true);
+  }
+}
+
+dialog.addEventListener("close", async () => {
+  const id = openID;
+  openID = null;
+  if (dialog.returnValue === "move") {
+    await move(id, document.getElementById("ticket-column").value);
+  } else if (dialog.returnValue === "delete" && confirm("Move this ticket to the trash?")) {
+    try {
+      await api("DELETE", "/api/tickets/" + id);
+      setStatus("Moved to trash");
+      await load();
+    } catch (err) {
+      setStatus("Error: " + err.message, true);
+    }
+  }
+});
+
+document.getElementById("new-ticket").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const form = e.target;
+  const tags = form.tags.value.split(",").map((s) => s.trim()).filter(Boolean);
+  try {
+    const t = await api("POST", "/api/tickets", { title: form.title.value, tags });
+    form.reset();
+    setStatus("Created " + t.title);
+    await load();
+  } catch (err) {
+    setStatus("Error: " + err.message, true);
+  }
+});
+
+// Refetch on board changes, coalescing bursts (a move is two events)
+let reload = null;
+const events = new EventSource("/api/events");
+events.addEventListener("change", () => {
+  clearTimeout(reload);
+  reload = setTimeout(load, 100);
+});
+events.addEventListener("error", () => setStatus("Live updates disconnected, retrying…", true));
+events.addEventListener("open", () => setStatus(""));
+
+load();
@@ -0,0 +1,183 @@
+// Package spell provides dictionary-based spell checking with suggestions.
+package spell
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultDictionary is the system word list used when none is configured.
+const DefaultDictionary = "/usr/share/dict/words"
+
+// maxSuggestions caps the suggestions returned for a misspelled word.
+const maxSuggestions = 3
+
+var (
+	fencedCodeRe = regexp.MustCompile("(?s)```.*?(```|$)")
+	inlineCodeRe = regexp.MustCompile("`[^`\n]*`")
+	urlRe        = regexp.MustCompile(`\b\w+://\S+|\[\[[^\]]*\]\]|@\S+`)
+)
+
+// Checker looks up words in a dictionary.
+type Checker struct {
+	words map[string]struct{}
+	// suggestions caches Suggest results per word
+	suggestions map[string][]string
+}
+
+// Load reads a word list with one word per line.
+func Load(path string) (*Checker, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c := &Checker{
+		words:       make(map[string]struct{}),
+		suggestions: make(map[string][]string),
+	}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			c.words[strings.ToLower(word)] = struct{}{}
+		}
+	}
+	return c, scanner.Err()
+}
+
+// NewChecker creates a checker from an in-memory word list.
+func NewChecker(words []string) *Checker {
+	c := &Checker{
+		words:       make(map[string]struct{}, len(words)),
+		suggestions: make(map[string][]string),
+	}
+	for _, word := range words {
+		c.words[strings.ToLower(word)] = struct{}{}
+	}
+	return c
+}
+
+// Known reports whether word is in the dictionary (case-insensitive).
+func (c *Checker) Known(word string) bool {
+	lower := strings.ToLower(word)
+	if _, ok := c.words[lower]; ok {
+		return true
+	}
+	// Accept simple possessives ("ticket's")
+	if base, ok := strings.CutSuffix(lower, "'s"); ok {
+		_, ok = c.words[base]
+		return ok
+	}
+	return false
+}
+
+// Misspelled returns the unknown words in markdown text, in order of first
+// appearance. Code, URLs, wiki links and @mentions are skipped, as are words
+// with digits or single letters.
+func (c *Checker) Misspelled(text string) []string {
+	text = fencedCodeRe.ReplaceAllString(text, " ")
+	text = inlineCodeRe.ReplaceAllString(text, " ")
+	text = urlRe.ReplaceAllString(text, " ")
+
+	seen := make(map[string]bool)
+	var misspelled []string
+	for _, word := range splitWords(text) {
+		if len([]rune(word)) < 2 || seen[word] {
+			continue
+		}
+		seen[word] = true
+		if !c.Known(word) {
+			misspelled = append(misspelled, word)
+		}
+	}
+	return misspelled
+}
+
+// splitWords splits text into runs of letters and inner apostrophes, dropping
+// any word that touches a digit or underscore (identifiers, versions).
+func splitWords(text string) []string {
+	var words []string
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\'' && r != '_'
+	}) {
+		field = strings.Trim(field, "'")
+		if field == "" || strings.IndexFunc(field, func(r rune) bool {
+			return unicode.IsDigit(r) || r == '_'
+		}) >= 0 {
+			continue
+		}
+		words = append(words, field)
+	}
+	return words
+}
+
+// Suggest returns up to three dictionary words one or two edits away from word.
+func (c *Checker) Suggest(word string) []string {
+	lower := strings.ToLower(word)
+	if cached, ok := c.suggestions[lower]; ok {
+		return cached
+	}
+
+	candidates := c.known(edits(lower))
+	if len(candidates) == 0 {
+		seen := make(map[string]bool)
+		for _, e := range edits(lower) {
+			for _, e2 := range c.known(edits(e)) {
+				if !seen[e2] {
+					seen[e2] = true
+					candidates = append(candidates, e2)
+				}
+			}
+		}
+		sort.Strings(candidates)
+	}
+	if len(candidates) > maxSuggestions {
+		candidates = candidates[:maxSuggestions]
+	}
+
+	c.suggestions[lower] = candidates
+	return candidates
+}
+
+// known filters candidates down to dictionary words, sorted and deduplicated.
+func (c *Checker) known(candidates []string) []string {
+	seen := make(map[string]bool)
+	var found []string
+	for _, w := range candidates {
+		if _, ok := c.words[w]; ok && !seen[w] {
+			seen[w] = true
+			found = append(found, w)
+		}
+	}
+	sort.Strings(found)
+	return found
+}
+
+// edits returns all strings one deletion, transposition, substitution or
+// insertion away from word.
+func edits(word string) []string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	r := []rune(word)
+	var out []string
+	for i := 0; i <= len(r); i++ {
+		left, right := string(r[:i]), string(r[i:])
+		if i < len(r) {
+			out = append(out, left+string(r[i+1:]))
+			if i+1 < len(r) {
+				out = append(out, left+string(r[i+1])+string(r[i])+string(r[i+2:]))
+			}
+			for _, l := range letters {
+				out = append(out, left+string(l)+string(r[i+1:]))
+			}
+		}
+		for _, l := range letters {
+			out = append(out, left+string(l)+right)
+		}
+	}
+	return out
+}
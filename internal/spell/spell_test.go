@@ -0,0 +1,35 @@
+package spell
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMisspelled(t *testing.T) {
+	c := NewChecker([]string{"fix", "the", "login", "bug", "in", "and", "ticket"})
+
+	got := c.Misspelled("Fix teh login bug in `handlr` and the ticket's v2 https://exampel.com\n\n```go\nfunc foo()\n```")
+	want := []string{"teh"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Misspelled() = %v, want %v", got, want)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	c := NewChecker([]string{"the", "then", "receive", "ticket"})
+
+	tests := []struct {
+		word string
+		want []string
+	}{
+		{"teh", []string{"the"}},
+		{"recieve", []string{"receive"}},
+		{"tickte", []string{"ticket"}},
+		{"zzzzzz", nil},
+	}
+	for _, tt := range tests {
+		if got := c.Suggest(tt.word); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Suggest(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+}
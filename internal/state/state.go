@@ -0,0 +1,150 @@
+// Package state persists per-user application state (as opposed to board
+// configuration) such as dismissed onboarding tips.
+package state
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// State holds user state shared across all boards.
+type State struct {
+	// TipsDismissed is set once the onboarding tips have been dismissed
+	TipsDismissed bool `yaml:"tips_dismissed,omitempty"`
+	// RecentBoards lists kanban directories, most recently opened first
+	RecentBoards []string `yaml:"recent_boards,omitempty"`
+	// EditorWidth is the ticket editor/viewer width in cells (0 uses the default)
+	EditorWidth int `yaml:"editor_width,omitempty"`
+	// Starred lists the starred ticket filenames of each kanban directory
+	Starred map[string][]string `yaml:"starred,omitempty"`
+
+	// path is where the state was loaded from
+	path string `yaml:"-"`
+}
+
+// maxRecentBoards caps the length of the recent boards list.
+const maxRecentBoards = 20
+
+// DefaultPath returns the state file location: $XDG_STATE_HOME/kanban-tui/state.yaml,
+// falling back to ~/.local/state/kanban-tui/state.yaml.
+func DefaultPath() string {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			home = "."
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "kanban-tui", "state.yaml")
+}
+
+// Load reads the state from the default path. A missing file yields empty state.
+func Load() (*State, error) {
+	return LoadFrom(DefaultPath())
+}
+
+// LoadFrom reads the state from path. A missing file yields empty state.
+func LoadFrom(path string) (*State, error) {
+	s := &State{path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return s, err
+	}
+
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+// Save writes the state back to the file it was loaded from.
+func (s *State) Save() error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0644)
+}
+
+// AddRecentBoard moves kanbanDir to the front of the recent boards list.
+func (s *State) AddRecentBoard(kanbanDir string) {
+	boards := []string{kanbanDir}
+	for _, dir := range s.RecentBoards {
+		if dir != kanbanDir {
+			boards = append(boards, dir)
+		}
+	}
+	if len(boards) > maxRecentBoards {
+		boards = boards[:maxRecentBoards]
+	}
+	s.RecentBoards = boards
+}
+
+// ExistingRecentBoards returns the recent boards that still exist on disk.
+func (s *State) ExistingRecentBoards() []string {
+	var boards []string
+	for _, dir := range s.RecentBoards {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			boards = append(boards, dir)
+		}
+	}
+	return boards
+}
+
+// IsStarred reports whether the ticket file name of board kanbanDir is starred.
+func (s *State) IsStarred(kanbanDir, name string) bool {
+	for _, starred := range s.Starred[kanbanDir] {
+		if starred == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RenameStar moves the star of ticket file name from of board kanbanDir to
+// the file name to, reporting whether from was starred.
+func (s *State) RenameStar(kanbanDir, from, to string) bool {
+	if !s.IsStarred(kanbanDir, from) {
+		return false
+	}
+	s.ToggleStar(kanbanDir, from)
+	if !s.IsStarred(kanbanDir, to) {
+		s.ToggleStar(kanbanDir, to)
+	}
+	return true
+}
+
+// ToggleStar stars or unstars the ticket file name of board kanbanDir,
+// returning whether it is now starred.
+func (s *State) ToggleStar(kanbanDir, name string) bool {
+	if s.IsStarred(kanbanDir, name) {
+		var names []string
+		for _, starred := range s.Starred[kanbanDir] {
+			if starred != name {
+				names = append(names, starred)
+			}
+		}
+		if len(names) == 0 {
+			delete(s.Starred, kanbanDir)
+		} else {
+			s.Starred[kanbanDir] = names
+		}
+		return false
+	}
+	if s.Starred == nil {
+		s.Starred = make(map[string][]string)
+	}
+	s.Starred[kanbanDir] = append(s.Starred[kanbanDir], name)
+	return true
+}
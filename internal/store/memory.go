@@ -0,0 +1,111 @@
+package store
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/user/kanban-tui/internal/watcher"
+)
+
+// Memory keeps ticket files in memory, for tests and throwaway boards.
+// Changes are reported to watchers like file system events.
+type Memory struct {
+	mu       sync.Mutex
+	files    map[string][]byte
+	watchers map[string][]*watcher.Watcher
+}
+
+// NewMemory returns an empty memory store.
+func NewMemory() *Memory {
+	return &Memory{files: make(map[string][]byte), watchers: make(map[string][]*watcher.Watcher)}
+}
+
+// List returns the names of the files in dir.
+func (s *Memory) List(dir string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dir = filepath.Clean(dir)
+	var names []string
+	for path := range s.files {
+		if filepath.Dir(path) == dir {
+			names = append(names, filepath.Base(path))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Read returns the file at path.
+func (s *Memory) Read(path string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[filepath.Clean(path)]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: path, Err: fs.ErrNotExist}
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Write replaces the file at path.
+func (s *Memory) Write(path string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path = filepath.Clean(path)
+	op := fsnotify.Write
+	if _, ok := s.files[path]; !ok {
+		op = fsnotify.Create
+	}
+	s.files[path] = bytes.Clone(data)
+	s.notify(path, op)
+	return nil
+}
+
+// Move renames the file at from to to.
+func (s *Memory) Move(from, to string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	from, to = filepath.Clean(from), filepath.Clean(to)
+	data, ok := s.files[from]
+	if !ok {
+		return &fs.PathError{Op: "rename", Path: from, Err: fs.ErrNotExist}
+	}
+	delete(s.files, from)
+	s.files[to] = data
+	s.notify(from, fsnotify.Rename)
+	s.notify(to, fsnotify.Create)
+	return nil
+}
+
+// Delete removes the file at path.
+func (s *Memory) Delete(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path = filepath.Clean(path)
+	if _, ok := s.files[path]; !ok {
+		return &fs.PathError{Op: "remove", Path: path, Err: fs.ErrNotExist}
+	}
+	delete(s.files, path)
+	s.notify(path, fsnotify.Remove)
+	return nil
+}
+
+// Watch reports later changes of the files in dir on w.
+func (s *Memory) Watch(w *watcher.Watcher, dir string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dir = filepath.Clean(dir)
+	s.watchers[dir] = append(s.watchers[dir], w)
+	return nil
+}
+
+// notify reports a change of path to the watchers of its directory.
+func (s *Memory) notify(path string, op fsnotify.Op) {
+	for _, w := range s.watchers[filepath.Dir(path)] {
+		w.Notify(path, op)
+	}
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/user/kanban-tui/internal/watcher"
+)
+
+// OS stores tickets on the local file system.
+type OS struct{}
+
+// List returns the names of the regular files in dir.
+func (OS) List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// Read opens the file at path.
+func (OS) Read(path string) (io.ReadCloser, error) {
+	return os.Open(path)
+}
+
+// Write replaces the file at path.
+func (OS) Write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Move renames the file at from to to.
+func (OS) Move(from, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return err
+	}
+	return os.Rename(from, to)
+}
+
+// Delete removes the file at path.
+func (OS) Delete(path string) error {
+	return os.Remove(path)
+}
+
+// Watch watches dir on the file system.
+func (OS) Watch(w *watcher.Watcher, dir string) error {
+	return w.Add(dir)
+}
@@ -0,0 +1,32 @@
+// Package store abstracts where a board's ticket files live, so backends
+// other than the local file system (memory for tests, remote stores, a
+// cache) can be plugged in without touching the models or the UI.
+//
+// Paths are file system style paths (the kanban directory joined with a
+// column directory and a file name) in every backend, so tickets keep
+// their identity whatever the store.
+package store
+
+import (
+	"io"
+
+	"github.com/user/kanban-tui/internal/watcher"
+)
+
+// BoardStore reads and writes ticket files.
+type BoardStore interface {
+	// List returns the names of the files in dir, sorted. A missing
+	// directory holds no files.
+	List(dir string) ([]string, error)
+	// Read opens the file at path. Errors for missing files satisfy
+	// errors.Is(err, fs.ErrNotExist).
+	Read(path string) (io.ReadCloser, error)
+	// Write replaces the file at path, creating its directory.
+	Write(path string, data []byte) error
+	// Move renames the file at from to to, creating to's directory.
+	Move(from, to string) error
+	// Delete removes the file at path.
+	Delete(path string) error
+	// Watch reports changes of the files in dir on w.
+	Watch(w *watcher.Watcher, dir string) error
+}
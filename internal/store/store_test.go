@@ -0,0 +1,123 @@
+package store_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/store"
+	"github.com/user/kanban-tui/internal/watcher"
+)
+
+// backends returns each store with a fresh board directory.
+func backends(t *testing.T) map[string]struct {
+	s   store.BoardStore
+	dir string
+} {
+	return map[string]struct {
+		s   store.BoardStore
+		dir string
+	}{
+		"os":     {store.OS{}, t.TempDir()},
+		"memory": {store.NewMemory(), "/board/.kanban"},
+	}
+}
+
+func TestStores(t *testing.T) {
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			s, todo, done := b.s, filepath.Join(b.dir, "todo"), filepath.Join(b.dir, "done")
+			if names, err := s.List(todo); err != nil || len(names) != 0 {
+				t.Fatalf("List(missing) = %v, %v", names, err)
+			}
+			for _, n := range []string{"b.md", "a.md"} {
+				if err := s.Write(filepath.Join(todo, n), []byte("ticket "+n)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if names, _ := s.List(todo); !reflect.DeepEqual(names, []string{"a.md", "b.md"}) {
+				t.Errorf("List = %q", names)
+			}
+
+			if err := s.Move(filepath.Join(todo, "a.md"), filepath.Join(done, "a.md")); err != nil {
+				t.Fatal(err)
+			}
+			f, err := s.Read(filepath.Join(done, "a.md"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, _ := io.ReadAll(f)
+			f.Close()
+			if string(data) != "ticket a.md" {
+				t.Errorf("Read = %q", data)
+			}
+
+			if err := s.Delete(filepath.Join(todo, "b.md")); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.Read(filepath.Join(todo, "b.md")); !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("Read(deleted) err = %v", err)
+			}
+			if names, _ := s.List(todo); len(names) != 0 {
+				t.Errorf("List after move and delete = %q", names)
+			}
+		})
+	}
+}
+
+func TestTicketsOnMemoryStore(t *testing.T) {
+	prev := models.Files
+	models.Files = store.NewMemory()
+	defer func() { models.Files = prev }()
+
+	w, err := watcher.New(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	kanbanDir := "/board/.kanban"
+	if err := models.Files.Watch(w, filepath.Join(kanbanDir, "doing")); err != nil {
+		t.Fatal(err)
+	}
+
+	ticket := models.NewTicket("Fix login", "todo")
+	path, err := ticket.UniqueFilePath(filepath.Join(kanbanDir, "todo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket.FilePath = path
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	again, err := models.NewTicket("Fix login", "todo").UniqueFilePath(filepath.Join(kanbanDir, "todo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == ticket.FilePath {
+		t.Errorf("UniqueFilePath reused %s", again)
+	}
+	if err := ticket.Move(kanbanDir, "doing"); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case e := <-w.Events:
+		if e.Path != ticket.FilePath {
+			t.Errorf("event for %s, want %s", e.Path, ticket.FilePath)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no watcher event for the move")
+	}
+	got, err := models.ParseTicket(ticket.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "Fix login" || got.Column != "doing" {
+		t.Errorf("parsed %q in %s", got.Title, got.Column)
+	}
+}
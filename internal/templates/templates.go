@@ -0,0 +1,94 @@
+// Package templates loads ticket templates from a board's templates
+// directory and fills in their {{variable}} placeholders.
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// DirName is the directory in the kanban directory holding templates.
+const DirName = "templates"
+
+// variablePattern matches a {{name}} placeholder. Names start with a letter
+// or underscore, so Go template actions like {{.Title}} are left alone.
+var variablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_-]*)\s*\}\}`)
+
+// Template is a ticket template: a ticket file whose title, tags and
+// content may contain {{variable}} placeholders.
+type Template struct {
+	// Name is the file name without the .md extension
+	Name    string
+	Title   string
+	Tags    []string
+	Content string
+}
+
+// Load reads the templates in kanbanDir's templates directory, sorted by
+// name. A missing directory holds no templates.
+func Load(kanbanDir string) ([]Template, error) {
+	dir := filepath.Join(kanbanDir, DirName)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []Template
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		ticket, err := models.ParseTicket(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, Template{
+			Name:    strings.TrimSuffix(entry.Name(), ".md"),
+			Title:   ticket.Title,
+			Tags:    ticket.Tags,
+			Content: ticket.Content,
+		})
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+// Variables returns the names of the template's placeholders in the order
+// they first appear in the title, tags and content.
+func (t *Template) Variables() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, text := range append(append([]string{t.Title}, t.Tags...), t.Content) {
+		for _, match := range variablePattern.FindAllStringSubmatch(text, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				names = append(names, match[1])
+			}
+		}
+	}
+	return names
+}
+
+// Fill substitutes values for the template's placeholders; placeholders
+// without a value become empty. Tags left empty are dropped.
+func (t *Template) Fill(values map[string]string) (title string, tags []string, content string) {
+	fill := func(s string) string {
+		return variablePattern.ReplaceAllStringFunc(s, func(match string) string {
+			return values[variablePattern.FindStringSubmatch(match)[1]]
+		})
+	}
+	for _, tag := range t.Tags {
+		if tag = strings.TrimSpace(fill(tag)); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return strings.TrimSpace(fill(t.Title)), tags, fill(t.Content)
+}
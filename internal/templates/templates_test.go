@@ -0,0 +1,54 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadFill(t *testing.T) {
+	kanbanDir := t.TempDir()
+	dir := filepath.Join(kanbanDir, DirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	bug := "---\ntitle: Fix {{component}} crash in {{ version }}\ntags: [bug, \"{{component}}\", \"{{area}}\"]\n---\n\n" +
+		"Crashes in {{component}} since {{version}}.\nLeave {{.Title}} as is.\n"
+	if err := os.WriteFile(filepath.Join(dir, "bug.md"), []byte(bug), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "chore.md"), []byte("---\ntitle: Chore\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := Load(kanbanDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates) != 2 || templates[0].Name != "bug" || templates[1].Name != "chore" {
+		t.Fatalf("templates = %+v", templates)
+	}
+
+	tmpl := templates[0]
+	if got, want := tmpl.Variables(), []string{"component", "version", "area"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("variables = %q, want %q", got, want)
+	}
+	title, tags, content := tmpl.Fill(map[string]string{"component": "parser", "version": "1.2"})
+	if title != "Fix parser crash in 1.2" {
+		t.Errorf("title = %q", title)
+	}
+	if want := []string{"bug", "parser"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("tags = %q, want %q", tags, want)
+	}
+	if want := "Crashes in parser since 1.2.\nLeave {{.Title}} as is."; content != want {
+		t.Errorf("content = %q, want %q", content, want)
+	}
+}
+
+func TestLoadMissingDir(t *testing.T) {
+	templates, err := Load(t.TempDir())
+	if err != nil || templates != nil {
+		t.Errorf("Load = %v, %v; want no templates", templates, err)
+	}
+}
@@ -0,0 +1,172 @@
+// Package testreport extracts failing tests from `go test -json` output and
+// JUnit XML reports.
+package testreport
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+)
+
+// maxOutputLines caps the output kept per failure (the tail is kept).
+const maxOutputLines = 200
+
+// Failure is a failing test, or a failing package with no failing tests
+// (e.g. a build error), in which case Test is empty.
+type Failure struct {
+	Package string
+	Test    string
+	Output  string
+}
+
+// Name returns "package.Test", or just the package for package failures.
+func (f Failure) Name() string {
+	if f.Test == "" {
+		return f.Package
+	}
+	return f.Package + "." + f.Test
+}
+
+// testEvent is one line of `go test -json` output.
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Output  string
+}
+
+// ParseGoTestJSON reads `go test -json` output. When subtests fail, only the
+// innermost failing subtests are reported, not their parents.
+func ParseGoTestJSON(r io.Reader) ([]Failure, error) {
+	type key struct{ pkg, test string }
+	output := make(map[key][]string)
+	var failed []key
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var ev testEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			continue // Non-JSON lines (e.g. build output) are ignored
+		}
+		k := key{ev.Package, ev.Test}
+		switch ev.Action {
+		case "output":
+			output[k] = append(output[k], ev.Output)
+		case "fail":
+			failed = append(failed, k)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Drop parents of failing subtests and packages that have failing tests
+	var failures []Failure
+	for _, k := range failed {
+		shadowed := false
+		for _, other := range failed {
+			if other.pkg != k.pkg || other == k {
+				continue
+			}
+			if k.test == "" || strings.HasPrefix(other.test, k.test+"/") {
+				shadowed = true
+				break
+			}
+		}
+		if !shadowed {
+			failures = append(failures, Failure{
+				Package: k.pkg,
+				Test:    k.test,
+				Output:  tail(strings.Join(output[k], "")),
+			})
+		}
+	}
+	sortFailures(failures)
+	return failures, nil
+}
+
+// junitSuite is a <testsuite>, or the <testsuites> root wrapping them.
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Cases  []junitCase  `xml:"testcase"`
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failures  []junitResult `xml:"failure"`
+	Errors    []junitResult `xml:"error"`
+	SystemOut string        `xml:"system-out"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// ParseJUnit reads a JUnit XML report.
+func ParseJUnit(r io.Reader) ([]Failure, error) {
+	var root junitSuite
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+
+	var failures []Failure
+	var walk func(s junitSuite)
+	walk = func(s junitSuite) {
+		for _, c := range s.Cases {
+			results := append(append([]junitResult{}, c.Failures...), c.Errors...)
+			if len(results) == 0 {
+				continue
+			}
+			pkg := c.Classname
+			if pkg == "" {
+				pkg = s.Name
+			}
+			var out []string
+			for _, res := range results {
+				if res.Message != "" {
+					out = append(out, res.Message)
+				}
+				if body := strings.TrimSpace(res.Body); body != "" {
+					out = append(out, body)
+				}
+			}
+			if sysOut := strings.TrimSpace(c.SystemOut); sysOut != "" {
+				out = append(out, sysOut)
+			}
+			failures = append(failures, Failure{
+				Package: pkg,
+				Test:    c.Name,
+				Output:  tail(strings.Join(out, "\n")),
+			})
+		}
+		for _, child := range s.Suites {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	sortFailures(failures)
+	return failures, nil
+}
+
+// tail keeps the last maxOutputLines lines of output.
+func tail(output string) string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(lines) > maxOutputLines {
+		lines = append([]string{"..."}, lines[len(lines)-maxOutputLines:]...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func sortFailures(failures []Failure) {
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].Name() < failures[j].Name()
+	})
+}
@@ -0,0 +1,62 @@
+package testreport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGoTestJSON(t *testing.T) {
+	input := `{"Action":"run","Package":"ex/a","Test":"TestOK"}
+{"Action":"pass","Package":"ex/a","Test":"TestOK"}
+{"Action":"output","Package":"ex/a","Test":"TestParent/sub","Output":"    a_test.go:9: want 1, got 2\n"}
+{"Action":"fail","Package":"ex/a","Test":"TestParent/sub"}
+{"Action":"fail","Package":"ex/a","Test":"TestParent"}
+{"Action":"fail","Package":"ex/a"}
+{"Action":"output","Package":"ex/b","Output":"b.go:3:1: syntax error\n"}
+{"Action":"fail","Package":"ex/b"}
+not json
+`
+	failures, err := ParseGoTestJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range failures {
+		names = append(names, f.Name())
+	}
+	if got, want := strings.Join(names, ","), "ex/a.TestParent/sub,ex/b"; got != want {
+		t.Fatalf("failures = %s, want %s", got, want)
+	}
+	if !strings.Contains(failures[0].Output, "want 1, got 2") {
+		t.Errorf("output = %q", failures[0].Output)
+	}
+	if !strings.Contains(failures[1].Output, "syntax error") {
+		t.Errorf("package output = %q", failures[1].Output)
+	}
+}
+
+func TestParseJUnit(t *testing.T) {
+	input := `<?xml version="1.0"?>
+<testsuites>
+  <testsuite name="suite">
+    <testcase classname="app.Login" name="rejects bad password">
+      <failure message="expected 401">stack trace</failure>
+    </testcase>
+    <testcase classname="app.Login" name="accepts good password"/>
+  </testsuite>
+</testsuites>`
+	failures, err := ParseJUnit(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failures) != 1 {
+		t.Fatalf("got %d failures, want 1", len(failures))
+	}
+	if got := failures[0].Name(); got != "app.Login.rejects bad password" {
+		t.Errorf("name = %q", got)
+	}
+	if got := failures[0].Output; got != "expected 401\nstack trace" {
+		t.Errorf("output = %q", got)
+	}
+}
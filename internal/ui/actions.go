@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Board actions bound to keys whose handling doesn't live with a feature
+// of its own. The key handler, the command palette and the leader chords
+// all call them, so a key that means something else in one column (a and x
+// in the review column) can't change what a palette command does.
+
+// do adapts an action without a command to a palette or chord action.
+func do(action func(m *Model)) func(m *Model) tea.Cmd {
+	return func(m *Model) tea.Cmd {
+		action(m)
+		return nil
+	}
+}
+
+// quit cancels running agents, closes the watcher and ends the program.
+func (m *Model) quit() tea.Cmd {
+	m.cancelRuns()
+	m.watcher.Close()
+	return tea.Quit
+}
+
+// newTicket opens the editor on a new ticket in the active column, starting
+// from the column's default tags and content skeleton.
+func (m *Model) newTicket() tea.Cmd {
+	tags, content := m.columns[m.activeColumn].Config.WithDefaults(nil, "")
+	m.viewMode = ViewNewTicket
+	m.editorMode = EditorModeCreate
+	m.editingTicket = nil
+	m.titleInput.SetValue("")
+	m.tagsInput.SetValue(strings.Join(tags, ", "))
+	m.contentInput.SetValue(content)
+	m.editorFocus = 0
+	m.titleInput.Focus()
+	m.tagsInput.Blur()
+	m.contentInput.Blur()
+	return textinput.Blink
+}
+
+// viewSelected opens the selected ticket in the read-only view.
+func (m *Model) viewSelected() tea.Cmd {
+	if !m.hasSelectedTicket() {
+		return nil
+	}
+	return m.openTicketEditor(EditorModeView)
+}
+
+// editSelected opens the selected ticket in the editor.
+func (m *Model) editSelected() tea.Cmd {
+	if !m.hasSelectedTicket() {
+		return nil
+	}
+	return m.openTicketEditor(EditorModeEdit)
+}
+
+// confirmDeleteSelected asks how to delete the selected ticket.
+func (m *Model) confirmDeleteSelected() {
+	if m.hasSelectedTicket() {
+		m.viewMode = ViewConfirmDelete
+	}
+}
+
+// openMove shows the column picker for the selected ticket.
+func (m *Model) openMove() {
+	if m.hasSelectedTicket() {
+		m.viewMode = ViewMoveTicket
+		m.moveTarget = m.activeColumn
+	}
+}
+
+// moveSelectedNext moves the selected ticket one column right.
+func (m *Model) moveSelectedNext() tea.Cmd {
+	if m.hasSelectedTicket() && m.activeColumn == len(m.columns)-1 {
+		m.setStatus("Already in the last column")
+		return nil
+	}
+	return m.moveSelectedTo(m.activeColumn + 1)
+}
+
+// moveSelectedPrev moves the selected ticket one column left.
+func (m *Model) moveSelectedPrev() tea.Cmd {
+	if m.hasSelectedTicket() && m.activeColumn == 0 {
+		m.setStatus("Already in the first column")
+		return nil
+	}
+	return m.moveSelectedTo(m.activeColumn - 1)
+}
+
+// openSearch starts a new search.
+func (m *Model) openSearch() tea.Cmd {
+	m.viewMode = ViewSearch
+	m.searchInput.SetValue("")
+	m.searchInput.Focus()
+	return textinput.Blink
+}
+
+// refresh reloads the board from disk.
+func (m *Model) refresh() tea.Cmd {
+	m.loadAllTickets()
+	m.setStatus("Refreshed")
+	return m.linkCommitsCmd()
+}
+
+// copyTicketsPrompt copies the prompt for the selected tickets, or for all
+// todo tickets when none are selected.
+func (m *Model) copyTicketsPrompt() tea.Cmd {
+	if len(m.marked) > 0 && len(m.pendingChunks) == 0 {
+		return m.copyMarkedTicketsPrompt()
+	}
+	return m.copyTodoTicketsPrompt()
+}
+
+// reopenSelected asks for a comment to reopen the selected done ticket.
+func (m *Model) reopenSelected() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return nil
+	}
+	if m.activeColumn != m.config.DoneColumnIndex() {
+		m.setStatus("Only tickets in the done column can be reopened")
+		return nil
+	}
+	return m.startReopen(ticket)
+}
+
+// starSelected stars or unstars the selected ticket.
+func (m *Model) starSelected() {
+	m.toggleStar(m.getSelectedTicket())
+}
+
+// openHelp shows the key reference.
+func (m *Model) openHelp() {
+	m.viewMode = ViewHelp
+}
+
+// openEpics shows the epic tree.
+func (m *Model) openEpics() {
+	m.viewMode = ViewEpics
+}
@@ -0,0 +1,21 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// regenerateAgentMd rewrites AGENT.md from the current config, e.g. after
+// columns or prompts changed.
+func (m *Model) regenerateAgentMd() {
+	if m.config.DisableAgentMd {
+		m.setStatus("AGENT.md is disabled by disable_agent_md in the config")
+		return
+	}
+	path, err := m.config.WriteAgentInstructions()
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	m.setSuccess("Regenerated " + filepath.Base(path) + " from the config")
+}
@@ -2,18 +2,32 @@ package ui
 
 import (
 	"fmt"
-	"os"
+	"log/slog"
 	"path/filepath"
-	"sort"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/agent"
 	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/conflicts"
+	"github.com/user/kanban-tui/internal/github"
+	"github.com/user/kanban-tui/internal/hooks"
 	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/plugins"
+	"github.com/user/kanban-tui/internal/replace"
+	"github.com/user/kanban-tui/internal/search"
+	"github.com/user/kanban-tui/internal/spell"
+	"github.com/user/kanban-tui/internal/state"
+	"github.com/user/kanban-tui/internal/templates"
 	"github.com/user/kanban-tui/internal/watcher"
 )
 
@@ -30,6 +44,37 @@ const (
 	ViewHelp
 	ViewSearch
 	ViewAgentFeedback // Fullscreen agent feedback view
+	ViewStats
+	ViewReview
+	ViewReopenComment
+	ViewSplit
+	ViewEpics
+	ViewConfirm // Generic yes/no confirmation for confirmAction
+	ViewDuplicateWarning
+	ViewTips // First-launch onboarding tips overlay
+	ViewBoardPicker
+	ViewColorPicker
+	ViewQuickEdit      // Inline title/tags edit on the board
+	ViewSessions       // Agent session list for the viewed ticket
+	ViewPager          // Scrollable text: agent transcripts and diffs
+	ViewQueue          // Agent dispatch queue
+	ViewChecklist      // Entry checklist of the column a ticket moves into
+	ViewPalette        // Command palette
+	ViewToday          // Daily worklist across columns
+	ViewSnooze         // Date prompt for snoozing a ticket
+	ViewStarred        // Starred tickets across columns
+	ViewTagRename      // Old and new name of a tag renamed across the board
+	ViewSwitcher       // Fuzzy finder over every ticket
+	ViewReplace        // Search and replacement text of a board-wide replace
+	ViewReplacePreview // Per-ticket confirmation of a board-wide replace
+	ViewTemplates      // Ticket templates to create a ticket from
+	ViewTemplateVars   // Values for the chosen template's variables
+	ViewPlugins        // Plugins to run on the selected ticket
+	ViewConflicts      // Sync conflict copies and their resolution
+	ViewPrivate        // Encrypted private notes of the viewed ticket
+	ViewMoveReason     // Reason for a move on a configured transition
+	ViewEmail          // Recipients of an emailed ticket or board summary
+	ViewCodeBlocks     // Code blocks of the viewed ticket to copy
 )
 
 // Editor modes for the ticket editor
@@ -44,12 +89,12 @@ type (
 	tickMsg         time.Time
 	fileChangeMsg   watcher.Event
 	watcherErrorMsg error
-	statusClearMsg  struct{}
 )
 
 // Model represents the application state.
 type Model struct {
 	config  *config.Config
+	state   *state.State
 	styles  Styles
 	watcher *watcher.Watcher
 
@@ -69,45 +114,256 @@ type Model struct {
 	tagsInput    textinput.Model
 	contentInput textarea.Model
 	searchInput  textinput.Model
+	commentInput textinput.Model
 	searchQuery  string
 	editorFocus  int // 0 = title, 1 = tags, 2 = content
 	editorMode   int // 0 = create, 1 = edit, 2 = view
 
+	// searchIndex covers every loaded ticket; searchHits caches the paths
+	// matching searchQuery for the index generation it was computed at
+	searchIndex   *search.Index
+	searchHits    map[string]bool
+	searchHitsFor string
+	searchHitsGen int
+
 	// Editing state
 	editingTicket *models.Ticket // The ticket being edited (nil for create)
-
-	// Status/feedback
-	statusMessage string
-	statusTimeout time.Time
+	duplicateOf   *models.Ticket // Existing ticket similar to the one being created
+
+	// Status/feedback: stacked toasts, oldest first, and whether a redraw
+	// is scheduled for the next one to expire
+	toasts       []toast
+	toastTicking bool
+
+	// Review queue selection and the ticket awaiting a reopen comment;
+	// rejecting requires the comment and labels it as a rejection
+	reviewIndex  int
+	reopenTarget *models.Ticket
+	rejecting    bool
+
+	// Today and starred view selections
+	todayIndex   int
+	starredIndex int
+
+	// Snooze date prompt, the ticket it is for, and whether snoozed
+	// tickets are shown (dimmed) on the board
+	snoozeInput  textinput.Model
+	snoozeTarget *models.Ticket
+	showSnoozed  bool
+
+	// Move reason prompt and the checklist items skipped for that move
+	moveReasonInput textinput.Model
+	moveSkipped     []string
+
+	// Email recipients prompt, the mail server, and whether the board
+	// summary is sent instead of the selected ticket
+	emailInput  textinput.Model
+	emailServer config.SMTP
+	emailBoard  bool
+
+	// Tag rename prompt; tagRenameFrom is set once the old tag is entered
+	tagRenameInput textinput.Model
+	tagRenameFrom  string
+
+	// Board-wide replace: the prompt and the search text once entered,
+	// matching options, and the changes being confirmed
+	replaceInput   textinput.Model
+	replaceFind    string
+	replaceStarted bool
+	replaceOpts    replace.Options
+	replaceChanges []replace.Change
+	replaceIndex   int
+	replaceApplied int
+
+	// Ticket templates, the chosen one, its variables and the values
+	// entered so far
+	ticketTemplates  []templates.Template
+	templateIndex    int
+	templateVars     []string
+	templateValues   map[string]string
+	templateVarInput textinput.Model
+
+	// Lifecycle hooks queued by ticket operations, run after the update
+	pendingHooks []pendingHook
+
+	// Plugin menu entries and selection
+	pluginList  []plugins.Plugin
+	pluginIndex int
+
+	// Sync conflict copies found in the columns and the selected one
+	syncConflicts []conflicts.Conflict
+	conflictIndex int
+
+	// Private notes editor and the board key, loaded on first use
+	privateInput     textarea.Model
+	privateKey       []byte
+	privateKeyErr    error
+	privateKeyLoaded bool
+
+	// Ticket quick-switcher filter and selection
+	switcherInput textinput.Model
+	switcherIndex int
+
+	// Split view state
+	splitTicket  *models.Ticket
+	splitItems   []models.ChecklistItem
+	splitChecked []bool
+	splitIndex   int
+	splitFocus   int // 0 = checklist, 1 = sub-titles
+	splitInput   textarea.Model
+
+	// Epic hierarchy: children by parent filename, the epic the board is
+	// filtered to, and the epic view selection
+	children   map[string][]*models.Ticket
+	epicFilter string
+	epicIndex  int
+
+	// Multi-selection of ticket file paths, in the order they were marked
+	marked []string
+
+	// Batch prompt chunks waiting to be copied, and the total for the batch
+	pendingChunks []string
+	chunkTotal    int
 
 	// Modal state
 	confirmAction func() tea.Cmd
+	confirmPrompt string
 	moveTarget    int
+	// Set while a review approval goes through the move checklist and
+	// reason prompts
+	approving bool
 
 	// Error state
 	lastError error
+
+	// Other boards aggregated into this one in workspace mode
+	workspace []string
+
+	// Focus mode collapses the backlog and done columns
+	focusMode bool
+
+	// Board picker choices and selection
+	boardChoices []string
+	boardIndex   int
+
+	// Color picker selection
+	colorIndex int
+
+	// Scroll positions of the ticket view's content and the agent feedback
+	ticketViewer   viewport.Model
+	feedbackViewer viewport.Model
+
+	// Outline sidebar of the ticket view, and the first key of a pending
+	// ]] or [[ section jump
+	showOutline    bool
+	pendingBracket string
+
+	// Long lines in the ticket and agent feedback views are cut at the
+	// pane edge instead of soft-wrapped
+	noWrap bool
+
+	// Set while the editor's right border is being dragged
+	resizingEditor bool
+
+	// The viewed ticket's highlighted content and the hash of what it was
+	// highlighted from
+	highlighted  string
+	highlightKey uint64
+
+	// Spell checker for the editor (nil when spell checking is off), the
+	// editor text it last checked and the misspelled words found in it
+	speller      *spell.Checker
+	spellText    string
+	misspellings []misspelling
+
+	// Draft autosave: a recovered draft awaiting restore/discard, the last
+	// saved editor snapshot, and whether the autosave timer is running
+	pendingDraft *models.Ticket
+	draftSaved   string
+	draftTicking bool
+
+	// Fetched pull request statuses keyed by ticket pr value, the GitHub
+	// repository detected from the git remote (nil until looked up), and the
+	// tickets whose issue is being created, by filename
+	prStatuses     map[string]github.Status
+	gitRemoteRepo  *string
+	creatingIssues map[string]bool
+
+	// Agent sessions of the viewed ticket and the selected one
+	sessions     []agent.Session
+	sessionUsage []agent.Usage
+	sessionTotal agent.Usage
+	sessionIndex int
+
+	// Code blocks of the viewed ticket and the selected one
+	codeBlocks     []codeBlock
+	codeBlockIndex int
+
+	// Pager: title, text, first visible line, the view Esc returns to, and
+	// whether the text is a diff to color
+	pagerTitle  string
+	pagerText   string
+	pagerScroll int
+	pagerReturn ViewMode
+	pagerDiff   bool
+
+	// Keys typed after the leader key (nil when no sequence is active)
+	chordKeys []string
+
+	// Command palette filter and highlighted command
+	paletteInput textinput.Model
+	paletteIndex int
+
+	// Confirmed items of the target column's entry checklist
+	checklistChecks []bool
+	checklistIndex  int
+
+	// Agent dispatch queue, the highlighted item, and the next item id;
+	// runs counts the agent processes still running
+	queue      []*queueItem
+	queueIndex int
+	queueSeq   int
+	runs       sync.WaitGroup
+
+	// Agent usage per ticket slug, gathered from transcripts when the stats
+	// view opens
+	agentUsage map[string]agent.Usage
+
+	// clipboard receives copied prompts and supplies pasted tickets
+	clipboard Clipboard
+
+	// Rendered cover image previews keyed by path, mod time and width
+	previewCache map[string]string
+
+	// Rendered board cards and columns keyed by a hash of their inputs:
+	// those drawn in the last frame and so far in the current one
+	renders     map[uint64]string
+	nextRenders map[uint64]string
+
+	// Onboarding tips page
+	tipIndex int
+
+	// lastChangedPath is the file most recently created or written on disk,
+	// as reported by the watcher
+	lastChangedPath string
 }
 
 // ColumnData holds column information and tickets.
 type ColumnData struct {
 	Config  config.Column
 	Tickets []*models.Ticket
+	// Offset is the index of the first visible ticket
+	Offset int
 }
 
 // New creates a new Model with the given configuration.
 func New(cfg *config.Config) (*Model, error) {
-	// Create file watcher
-	w, err := watcher.New(150 * time.Millisecond)
-	if err != nil {
-		return nil, fmt.Errorf("creating watcher: %w", err)
+	if !ApplyTheme(cfg.Theme) {
+		return nil, fmt.Errorf("unknown theme %q (available: %s)", cfg.Theme, strings.Join(ThemeNames, ", "))
 	}
-
-	// Watch all column directories
-	for _, col := range cfg.Columns {
-		colPath := cfg.ColumnPath(col.Dir)
-		if err := w.Add(colPath); err != nil {
-			return nil, fmt.Errorf("watching %s: %w", colPath, err)
-		}
+	w, err := newBoardWatcher(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	// Initialize text inputs
@@ -135,38 +391,103 @@ func New(cfg *config.Config) (*Model, error) {
 	si.CharLimit = 50
 	si.Width = 30
 
+	ci := textinput.New()
+	ci.Placeholder = "Why is this being reopened?"
+	ci.CharLimit = 500
+	ci.Width = 60
+
 	m := &Model{
-		config:       cfg,
-		styles:       DefaultStyles(),
-		watcher:      w,
-		columns:      make([]ColumnData, len(cfg.Columns)),
-		titleInput:   ti,
-		tagsInput:    tg,
-		contentInput: ta,
-		searchInput:  si,
-		activeColumn: 0,
-		activeTicket: 0,
-		viewMode:     ViewBoard,
-		editorFocus:  0,
-		editorMode:   EditorModeCreate,
+		config:           cfg,
+		styles:           DefaultStyles(),
+		watcher:          w,
+		titleInput:       ti,
+		tagsInput:        tg,
+		contentInput:     ta,
+		searchInput:      si,
+		commentInput:     ci,
+		splitInput:       newSplitInput(),
+		privateInput:     newPrivateInput(),
+		paletteInput:     newPaletteInput(),
+		snoozeInput:      newSnoozeInput(),
+		tagRenameInput:   newTagRenameInput(),
+		moveReasonInput:  newMoveReasonInput(),
+		emailInput:       newEmailInput(),
+		switcherInput:    newSwitcherInput(),
+		replaceInput:     newReplaceInput(),
+		templateVarInput: newTemplateVarInput(),
+		activeColumn:     0,
+		activeTicket:     0,
+		viewMode:         ViewBoard,
+		editorFocus:      0,
+		editorMode:       EditorModeCreate,
+		clipboard:        systemClipboard{},
+		searchIndex:      search.NewIndex(),
 	}
 
 	// Initialize column data
-	for i, col := range cfg.Columns {
-		m.columns[i] = ColumnData{
-			Config:  col,
-			Tickets: []*models.Ticket{},
-		}
-	}
+	m.initColumns()
 
 	// Load initial tickets
 	if err := m.loadAllTickets(); err != nil {
 		return nil, fmt.Errorf("loading tickets: %w", err)
 	}
 
+	// Show onboarding tips until dismissed. State errors are non-fatal, but
+	// state that can't be read is left unset so it is never overwritten.
+	if st, err := state.Load(); err != nil {
+		m.setError(fmt.Sprintf("Error loading state: %v", err))
+	} else {
+		m.state = st
+		if !m.state.TipsDismissed {
+			m.viewMode = ViewTips
+		}
+	}
+	m.rememberBoard()
+	m.loadSpellChecker()
+
 	return m, nil
 }
 
+// newBoardWatcher creates a file watcher for all column directories of cfg.
+func newBoardWatcher(cfg *config.Config) (*watcher.Watcher, error) {
+	w, err := watcher.New(150 * time.Millisecond)
+	if err != nil {
+		return nil, fmt.Errorf("creating watcher: %w", err)
+	}
+
+	for _, col := range cfg.Columns {
+		colPath := cfg.ColumnPath(col.Dir)
+		if err := models.Files.Watch(w, colPath); err != nil {
+			w.Close()
+			return nil, fmt.Errorf("watching %s: %w", colPath, err)
+		}
+	}
+
+	// Watch the config file for live reloads
+	if cfg.Path != "" {
+		if err := w.AddFile(cfg.Path); err != nil {
+			slog.Warn("not watching config", "path", cfg.Path, "err", err)
+		}
+	}
+	return w, nil
+}
+
+// initColumns resets the column data from the configured columns.
+func (m *Model) initColumns() {
+	m.columns = make([]ColumnData, len(m.config.Columns))
+	for i, col := range m.config.Columns {
+		m.columns[i] = ColumnData{
+			Config:  col,
+			Tickets: []*models.Ticket{},
+		}
+	}
+}
+
+// KanbanDir returns the directory of the board currently shown.
+func (m *Model) KanbanDir() string {
+	return m.config.KanbanDir
+}
+
 // loadAllTickets loads tickets from all columns.
 func (m *Model) loadAllTickets() error {
 	for i, col := range m.config.Columns {
@@ -175,41 +496,28 @@ func (m *Model) loadAllTickets() error {
 			return err
 		}
 		m.columns[i].Tickets = tickets
+		slog.Debug("loaded column", "column", col.Dir, "tickets", len(tickets))
 	}
+	m.indexChildren()
+	m.searchIndex.Sync(m.allTickets())
+	m.findConflicts()
 	return nil
 }
 
 // loadColumnTickets loads tickets from a specific column.
+// In workspace mode the column is read from every workspace board.
 func (m *Model) loadColumnTickets(colDir string) ([]*models.Ticket, error) {
-	colPath := m.config.ColumnPath(colDir)
-
-	entries, err := os.ReadDir(colPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return []*models.Ticket{}, nil
-		}
-		return nil, err
-	}
-
 	var tickets []*models.Ticket
-	for _, entry := range entries {
-		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
-			continue
-		}
-
-		ticketPath := filepath.Join(colPath, entry.Name())
-		ticket, err := models.ParseTicket(ticketPath)
+	for _, boardDir := range m.boardDirs() {
+		boardTickets, err := models.ReadColumn(filepath.Join(boardDir, colDir))
 		if err != nil {
-			// Skip invalid tickets but log the error
-			continue
+			return nil, err
 		}
-		tickets = append(tickets, ticket)
+		tickets = append(tickets, boardTickets...)
 	}
 
-	// Sort by updated date (newest first)
-	sort.Slice(tickets, func(i, j int) bool {
-		return tickets[i].Updated.After(tickets[j].Updated)
-	})
+	// Sort by the configured order (updated date, newest first, by default)
+	models.SortTickets(tickets, m.config.SortBy, m.config.Locale)
 
 	return tickets, nil
 }
@@ -219,17 +527,24 @@ func (m *Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.watcherCmd(),
 		textinput.Blink,
+		m.linkCommitsCmd(),
 	)
 }
 
 // watcherCmd listens for file system events.
 func (m *Model) watcherCmd() tea.Cmd {
+	// The command runs on another goroutine: it must not read m, whose
+	// watcher is replaced when switching boards
+	w := m.watcher
 	return func() tea.Msg {
 		select {
-		case event := <-m.watcher.Events:
+		case event := <-w.Events:
 			return fileChangeMsg(event)
-		case err := <-m.watcher.Errors:
+		case err := <-w.Errors:
 			return watcherErrorMsg(err)
+		case <-w.Done():
+			// Replaced by a new watcher with its own command
+			return nil
 		}
 	}
 }
@@ -248,12 +563,22 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
+	case tea.MouseMsg:
+		m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 
 	case fileChangeMsg:
-		// Reload tickets when files change
+		if m.isConfigFile(msg.Path) {
+			cmds = append(cmds, m.reloadConfig(msg.Op))
+			break
+		}
+		// Remember the most recently touched ticket, then reload
+		if msg.Op&(fsnotify.Create|fsnotify.Write) != 0 {
+			m.lastChangedPath = msg.Path
+		}
 		m.loadAllTickets()
 		cmds = append(cmds, m.watcherCmd())
 
@@ -261,12 +586,49 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.lastError = msg
 		cmds = append(cmds, m.watcherCmd())
 
-	case statusClearMsg:
-		m.statusMessage = ""
+	case toastExpireMsg:
+		m.toastTicking = false
+		m.pruneToasts()
+
+	case draftTickMsg:
+		cmds = append(cmds, m.handleDraftTick())
+
+	case prStatusMsg:
+		m.handlePRStatus(msg)
+
+	case issueCreatedMsg:
+		m.handleIssueCreated(msg)
+
+	case emailSentMsg:
+		m.handleEmailSent(msg)
+
+	case commitsMsg:
+		m.handleCommits(msg)
+
+	case externalEditMsg:
+		m.handleExternalEdit(msg)
+
+	case queueDoneMsg:
+		cmds = append(cmds, m.handleQueueDone(msg))
+
+	case queueTickMsg:
+		cmds = append(cmds, m.handleQueueTick())
+
+	case hookDoneMsg:
+		m.handleHookDone(msg)
+
+	case pluginDoneMsg:
+		m.handlePluginDone(msg)
+	}
+
+	// Offer draft recovery and start autosaving whenever the editor opens
+	if isEditing(m.viewMode) && !isEditing(prevViewMode) && prevViewMode != ViewDuplicateWarning {
+		cmds = append(cmds, m.startDraftSession())
 	}
 
 	// Update text inputs only if we were already in input mode (not just switched to it)
-	if prevViewMode == ViewNewTicket || prevViewMode == ViewEditTicket {
+	if prevViewMode == ViewNewTicket || prevViewMode == ViewEditTicket ||
+		(prevViewMode == ViewQuickEdit && m.viewMode == ViewQuickEdit) {
 		var cmd tea.Cmd
 		switch m.editorFocus {
 		case 0:
@@ -285,6 +647,96 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 	}
 
+	if prevViewMode == ViewPalette && m.viewMode == ViewPalette {
+		var cmd tea.Cmd
+		query := m.paletteInput.Value()
+		m.paletteInput, cmd = m.paletteInput.Update(msg)
+		if m.paletteInput.Value() != query {
+			m.paletteIndex = 0
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewSplit && m.viewMode == ViewSplit && m.splitFocus == 1 {
+		var cmd tea.Cmd
+		m.splitInput, cmd = m.splitInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewPrivate && m.viewMode == ViewPrivate {
+		var cmd tea.Cmd
+		m.privateInput, cmd = m.privateInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewReopenComment && m.viewMode == ViewReopenComment {
+		var cmd tea.Cmd
+		m.commentInput, cmd = m.commentInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewSnooze && m.viewMode == ViewSnooze {
+		var cmd tea.Cmd
+		m.snoozeInput, cmd = m.snoozeInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewMoveReason && m.viewMode == ViewMoveReason {
+		var cmd tea.Cmd
+		m.moveReasonInput, cmd = m.moveReasonInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewEmail && m.viewMode == ViewEmail {
+		var cmd tea.Cmd
+		m.emailInput, cmd = m.emailInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewTagRename && m.viewMode == ViewTagRename {
+		var cmd tea.Cmd
+		m.tagRenameInput, cmd = m.tagRenameInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewReplace && m.viewMode == ViewReplace {
+		var cmd tea.Cmd
+		m.replaceInput, cmd = m.replaceInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewTemplateVars && m.viewMode == ViewTemplateVars {
+		var cmd tea.Cmd
+		m.templateVarInput, cmd = m.templateVarInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if prevViewMode == ViewSwitcher && m.viewMode == ViewSwitcher {
+		var cmd tea.Cmd
+		query := m.switcherInput.Value()
+		m.switcherInput, cmd = m.switcherInput.Update(msg)
+		if m.switcherInput.Value() != query {
+			m.switcherIndex = 0
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	if m.viewMode != prevViewMode {
+		slog.Debug("view mode changed", "from", prevViewMode, "to", m.viewMode)
+	}
+
+	m.checkSpelling()
+
+	// Run hooks queued by ticket operations
+	if cmd := m.hookCmd(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
+	// Redraw when the oldest toast expires
+	if cmd := m.toastCmd(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -293,8 +745,7 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 	// Global keys
 	switch msg.String() {
 	case "ctrl+c":
-		m.watcher.Close()
-		return tea.Quit
+		return m.quit()
 	}
 
 	// Mode-specific handling
@@ -305,6 +756,14 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return m.handleTicketEditorKeys(msg)
 	case ViewMoveTicket:
 		return m.handleMoveTicketKeys(msg)
+	case ViewChecklist:
+		return m.handleEntryChecklistKeys(msg)
+	case ViewMoveReason:
+		return m.handleMoveReasonKeys(msg)
+	case ViewEmail:
+		return m.handleEmailKeys(msg)
+	case ViewPalette:
+		return m.handlePaletteKeys(msg)
 	case ViewConfirmDelete:
 		return m.handleConfirmDeleteKeys(msg)
 	case ViewHelp:
@@ -313,6 +772,60 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return m.handleSearchKeys(msg)
 	case ViewAgentFeedback:
 		return m.handleAgentFeedbackKeys(msg)
+	case ViewStats:
+		return m.handleStatsKeys(msg)
+	case ViewReview:
+		return m.handleReviewKeys(msg)
+	case ViewReopenComment:
+		return m.handleReopenCommentKeys(msg)
+	case ViewSplit:
+		return m.handleSplitKeys(msg)
+	case ViewEpics:
+		return m.handleEpicKeys(msg)
+	case ViewConfirm:
+		return m.handleConfirmKeys(msg)
+	case ViewDuplicateWarning:
+		return m.handleDuplicateKeys(msg)
+	case ViewTips:
+		return m.handleTipsKeys(msg)
+	case ViewBoardPicker:
+		return m.handleBoardPickerKeys(msg)
+	case ViewColorPicker:
+		return m.handleColorPickerKeys(msg)
+	case ViewQuickEdit:
+		return m.handleQuickEditKeys(msg)
+	case ViewSessions:
+		return m.handleSessionsKeys(msg)
+	case ViewCodeBlocks:
+		return m.handleCodeBlocksKeys(msg)
+	case ViewPager:
+		return m.handlePagerKeys(msg)
+	case ViewQueue:
+		return m.handleQueueKeys(msg)
+	case ViewToday:
+		return m.handleTodayKeys(msg)
+	case ViewSnooze:
+		return m.handleSnoozeKeys(msg)
+	case ViewStarred:
+		return m.handleStarredKeys(msg)
+	case ViewTagRename:
+		return m.handleTagRenameKeys(msg)
+	case ViewSwitcher:
+		return m.handleSwitcherKeys(msg)
+	case ViewReplace:
+		return m.handleReplaceKeys(msg)
+	case ViewReplacePreview:
+		return m.handleReplacePreviewKeys(msg)
+	case ViewTemplates:
+		return m.handleTemplatesKeys(msg)
+	case ViewTemplateVars:
+		return m.handleTemplateVarKeys(msg)
+	case ViewPlugins:
+		return m.handlePluginsKeys(msg)
+	case ViewConflicts:
+		return m.handleConflictsKeys(msg)
+	case ViewPrivate:
+		return m.handlePrivateKeys(msg)
 	}
 
 	return nil
@@ -320,20 +833,38 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 
 // handleBoardKeys handles keys in board view.
 func (m *Model) handleBoardKeys(msg tea.KeyMsg) tea.Cmd {
+	// Leader key sequences
+	if m.chordKeys != nil {
+		return m.handleChordKey(msg)
+	}
+	if msg.String() == m.leaderKey() {
+		m.startChord()
+		return nil
+	}
+
+	// Approve and reject tickets in the review column
+	if m.activeColumn == m.reviewColumnIndex() {
+		switch msg.String() {
+		case "a":
+			return m.approveSelectedTicket()
+		case "x":
+			return m.startReject(m.getSelectedTicket())
+		}
+	}
+
 	switch msg.String() {
 	case "q":
-		m.watcher.Close()
-		return tea.Quit
+		return m.quit()
 
 	case "h", "left":
-		if m.activeColumn > 0 {
-			m.activeColumn--
+		if prev := m.nextFocusedColumn(m.activeColumn, -1); prev != m.activeColumn {
+			m.activeColumn = prev
 			m.activeTicket = 0
 		}
 
 	case "l", "right":
-		if m.activeColumn < len(m.columns)-1 {
-			m.activeColumn++
+		if next := m.nextFocusedColumn(m.activeColumn, 1); next != m.activeColumn {
+			m.activeColumn = next
 			m.activeTicket = 0
 		}
 
@@ -349,57 +880,170 @@ func (m *Model) handleBoardKeys(msg tea.KeyMsg) tea.Cmd {
 		}
 
 	case "n":
-		m.viewMode = ViewNewTicket
-		m.editorMode = EditorModeCreate
-		m.editingTicket = nil
-		m.titleInput.SetValue("")
-		m.tagsInput.SetValue("")
-		m.contentInput.SetValue("")
-		m.editorFocus = 0
-		m.titleInput.Focus()
-		m.tagsInput.Blur()
-		m.contentInput.Blur()
-		return textinput.Blink
+		return m.newTicket()
+
+	case "N":
+		m.openTemplates()
 
 	case "enter":
-		if m.hasSelectedTicket() {
-			return m.openTicketEditor(EditorModeView)
-		}
+		return m.viewSelected()
 
 	case "d":
-		if m.hasSelectedTicket() {
-			m.viewMode = ViewConfirmDelete
-		}
+		m.confirmDeleteSelected()
 
 	case "m":
-		if m.hasSelectedTicket() {
-			m.viewMode = ViewMoveTicket
-			m.moveTarget = m.activeColumn
-		}
+		m.openMove()
+
+	case ">":
+		return m.moveSelectedNext()
+
+	case "<":
+		return m.moveSelectedPrev()
 
 	case "e":
-		if m.hasSelectedTicket() {
-			return m.openTicketEditor(EditorModeEdit)
-		}
+		return m.editSelected()
+
+	case "ctrl+e":
+		return m.openExternalEditor()
 
 	case "/":
-		m.viewMode = ViewSearch
-		m.searchInput.SetValue("")
-		m.searchInput.Focus()
-		return textinput.Blink
+		return m.openSearch()
 
 	case "?":
-		m.viewMode = ViewHelp
+		m.openHelp()
+
+	case "ctrl+p", ":":
+		return m.openPalette()
+
+	case "ctrl+o":
+		return m.openSwitcher()
 
 	case "r":
-		m.loadAllTickets()
-		m.setStatus("Refreshed")
+		return m.refresh()
 
 	case "p":
 		return m.copySelectedTicketPrompt()
 
+	case "V":
+		return m.copyVerifyPrompt()
+
+	case "y":
+		return m.copyTicketMarkdown()
+
+	case "Y":
+		return m.copyTicketJSON()
+
+	case "U":
+		return m.createIssue()
+
+	case "@":
+		return m.openEmail()
+
 	case "P":
-		return m.copyTodoTicketsPrompt()
+		return m.copyTicketsPrompt()
+
+	case " ":
+		m.toggleMark()
+
+	case "esc":
+		if len(m.marked) > 0 {
+			m.clearMarks()
+			m.setStatus("Selection cleared")
+		} else if m.epicFilter != "" {
+			m.epicFilter = ""
+			m.activeTicket = 0
+			m.setStatus("Epic filter cleared")
+		}
+
+	case "E":
+		m.openEpics()
+
+	case "M":
+		return m.startMerge()
+
+	case "B":
+		m.openBoardPicker()
+
+	case "W":
+		return m.toggleWorkspace()
+
+	case "F":
+		m.toggleFocusMode()
+
+	case "c":
+		m.openColorPicker()
+
+	case "i":
+		return m.startQuickEdit()
+
+	case "v":
+		return m.pasteNewTicket()
+
+	case "G":
+		return m.refreshPRStatuses()
+
+	case "b":
+		m.startBranch()
+
+	case "w":
+		m.toggleWorktree()
+
+	case "O":
+		m.openSelectedPR()
+
+	case "a":
+		return m.dispatchSelected()
+
+	case "A":
+		return m.dispatchTodo()
+
+	case "Q":
+		m.openQueue()
+
+	case ".":
+		m.jumpToLastChanged()
+
+	case "s":
+		m.openStats()
+
+	case "R":
+		m.openReviewQueue()
+
+	case "T":
+		m.openToday()
+
+	case "z":
+		return m.startSnooze()
+
+	case "Z":
+		m.toggleShowSnoozed()
+
+	case "*":
+		m.starSelected()
+
+	case "'":
+		m.openStarred()
+
+	case "#":
+		return m.startTagRename()
+
+	case "%":
+		return m.startReplace()
+
+	case "X":
+		m.openPlugins()
+
+	case "!":
+		m.openConflicts()
+
+	case "I":
+		m.regenerateAgentMd()
+
+	case "o":
+		return m.reopenSelected()
+
+	case "S":
+		return m.startSplit()
 	}
 
 	return nil
@@ -409,6 +1053,9 @@ func (m *Model) handleBoardKeys(msg tea.KeyMsg) tea.Cmd {
 func (m *Model) handleTicketEditorKeys(msg tea.KeyMsg) tea.Cmd {
 	// View mode specific handling
 	if m.editorMode == EditorModeView {
+		if m.handleSectionKeys(msg.String()) {
+			return nil
+		}
 		switch msg.String() {
 		case "esc", "q":
 			m.viewMode = ViewBoard
@@ -424,20 +1071,58 @@ func (m *Model) handleTicketEditorKeys(msg tea.KeyMsg) tea.Cmd {
 		case "f":
 			// Open fullscreen agent feedback view
 			if m.editingTicket != nil && m.editingTicket.AgentFeedback != "" {
+				m.feedbackViewer.GotoTop()
 				m.viewMode = ViewAgentFeedback
 			}
 			return nil
+		case "L":
+			m.openSessions()
+			return nil
+		case "D":
+			m.openTicketDiff()
+			return nil
+		case "p":
+			return m.openPrivate()
+		case "w":
+			m.toggleWrap()
+			return nil
+		case "o":
+			m.toggleOutline()
+			return nil
+		case "c":
+			m.openCodeBlocks()
+			return nil
+		case "<":
+			m.resizeEditor(-editorResizeStep)
+			return nil
+		case ">":
+			m.resizeEditor(editorResizeStep)
+			return nil
 		}
+		scrollViewer(&m.ticketViewer, msg.String())
 		return nil
 	}
 
 	// Create and Edit mode handling
 	switch msg.String() {
 	case "esc":
+		// Keep the latest content as a draft in case Esc was accidental
+		m.saveDraft()
 		m.viewMode = ViewBoard
 		m.resetEditorInputs()
 		return nil
 
+	case "ctrl+r":
+		m.restoreDraft()
+		return nil
+
+	case "ctrl+x":
+		if m.pendingDraft != nil {
+			m.removeDraft()
+			m.setStatus("Draft discarded")
+		}
+		return nil
+
 	case "tab":
 		// Cycle focus: title → tags → content → title
 		m.editorFocus = (m.editorFocus + 1) % 3
@@ -450,6 +1135,14 @@ func (m *Model) handleTicketEditorKeys(msg tea.KeyMsg) tea.Cmd {
 		m.updateEditorFocus()
 		return nil
 
+	case "alt+<":
+		m.resizeEditor(-editorResizeStep)
+		return nil
+
+	case "alt+>":
+		m.resizeEditor(editorResizeStep)
+		return nil
+
 	case "ctrl+s":
 		// Save the ticket
 		if m.editorMode == EditorModeEdit {
@@ -483,18 +1176,24 @@ func (m *Model) handleMoveTicketKeys(msg tea.KeyMsg) tea.Cmd {
 	case "esc":
 		m.viewMode = ViewBoard
 
-	case "h", "left":
+	case "h", "left", "k", "up":
 		if m.moveTarget > 0 {
 			m.moveTarget--
 		}
 
-	case "l", "right":
+	case "l", "right", "j", "down":
 		if m.moveTarget < len(m.columns)-1 {
 			m.moveTarget++
 		}
 
 	case "enter":
-		return m.moveSelectedTicket()
+		return m.confirmMove()
+
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		if n := int(msg.String()[0] - '1'); n < len(m.columns) {
+			m.moveTarget = n
+			return m.confirmMove()
+		}
 	}
 
 	return nil
@@ -506,8 +1205,37 @@ func (m *Model) handleConfirmDeleteKeys(msg tea.KeyMsg) tea.Cmd {
 	case "esc", "n":
 		m.viewMode = ViewBoard
 
+	case "y", "t", "enter":
+		return m.deleteSelectedTicket(false)
+
+	case "D":
+		return m.deleteSelectedTicket(true)
+	}
+
+	return nil
+}
+
+// confirm shows a yes/no prompt and runs action when confirmed.
+func (m *Model) confirm(prompt string, action func() tea.Cmd) {
+	m.confirmPrompt = prompt
+	m.confirmAction = action
+	m.viewMode = ViewConfirm
+}
+
+// handleConfirmKeys handles keys in the generic confirmation view.
+func (m *Model) handleConfirmKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "n":
+		m.viewMode = ViewBoard
+		m.confirmAction = nil
+
 	case "y", "enter":
-		return m.deleteSelectedTicket()
+		action := m.confirmAction
+		m.viewMode = ViewBoard
+		m.confirmAction = nil
+		if action != nil {
+			return action()
+		}
 	}
 
 	return nil
@@ -547,20 +1275,21 @@ func (m *Model) handleAgentFeedbackKeys(msg tea.KeyMsg) tea.Cmd {
 	switch msg.String() {
 	case "esc", "q", "f":
 		m.viewMode = ViewTicket
+	case "w":
+		m.toggleWrap()
+	default:
+		scrollViewer(&m.feedbackViewer, msg.String())
 	}
 	return nil
 }
 
-// getFilteredTickets returns tickets for a column, filtered by search query if active.
+// getFilteredTickets returns tickets for a column, filtered by search query
+// and epic if active.
 func (m *Model) getFilteredTickets(colIndex int) []*models.Ticket {
 	if colIndex >= len(m.columns) {
 		return nil
 	}
-	tickets := m.columns[colIndex].Tickets
-	if m.searchQuery != "" {
-		tickets = m.filterTickets(tickets)
-	}
-	return tickets
+	return m.filterTickets(m.columns[colIndex].Tickets)
 }
 
 // hasSelectedTicket returns true if there's a valid ticket selected.
@@ -581,6 +1310,59 @@ func (m *Model) getSelectedTicket() *models.Ticket {
 	return tickets[m.activeTicket]
 }
 
+// clampSelection keeps the selection within the active column after a
+// ticket left it.
+func (m *Model) clampSelection() {
+	col := m.columns[m.activeColumn]
+	if m.activeTicket >= len(col.Tickets) && m.activeTicket > 0 {
+		m.activeTicket--
+	}
+}
+
+// allTickets returns every ticket on the board, in column order.
+func (m *Model) allTickets() []*models.Ticket {
+	var tickets []*models.Ticket
+	for _, col := range m.columns {
+		tickets = append(tickets, col.Tickets...)
+	}
+	return tickets
+}
+
+// selectTicketByPath moves the selection to the ticket stored at path.
+// The search and epic filters are cleared if they hide the ticket.
+func (m *Model) selectTicketByPath(path string) bool {
+	for pass := 0; pass < 2; pass++ {
+		for colIdx := range m.columns {
+			for i, t := range m.getFilteredTickets(colIdx) {
+				if t.FilePath == path {
+					m.activeColumn = colIdx
+					m.activeTicket = i
+					return true
+				}
+			}
+		}
+		if m.searchQuery == "" && m.epicFilter == "" {
+			break
+		}
+		m.searchQuery = ""
+		m.epicFilter = ""
+	}
+	return false
+}
+
+// jumpToLastChanged selects the ticket most recently modified on disk.
+func (m *Model) jumpToLastChanged() {
+	if m.lastChangedPath == "" {
+		m.setStatus("No recent changes")
+		return
+	}
+	if !m.selectTicketByPath(m.lastChangedPath) {
+		m.setStatus("Last changed ticket no longer exists")
+		return
+	}
+	m.setStatus(fmt.Sprintf("Jumped to: %s", m.getSelectedTicket().ShortTitle(30)))
+}
+
 // parseTagsInput parses the comma-separated tags input into a slice.
 func (m *Model) parseTagsInput() []string {
 	input := strings.TrimSpace(m.tagsInput.Value())
@@ -619,6 +1401,7 @@ func (m *Model) openTicketEditor(mode int) tea.Cmd {
 
 	m.editorMode = mode
 	m.editingTicket = ticket
+	m.ticketViewer.GotoTop()
 
 	// Populate fields from ticket
 	m.titleInput.SetValue(ticket.Title)
@@ -640,27 +1423,43 @@ func (m *Model) openTicketEditor(mode int) tea.Cmd {
 	return textinput.Blink
 }
 
-// createTicket creates a new ticket with title, tags, and content.
+// createTicket creates a new ticket with title, tags, and content, warning
+// first if a ticket with a very similar title already exists.
 func (m *Model) createTicket() tea.Cmd {
+	return m.createTicketChecked(true)
+}
+
+// createTicketChecked creates a new ticket, optionally checking for duplicates.
+func (m *Model) createTicketChecked(checkDuplicates bool) tea.Cmd {
 	title := strings.TrimSpace(m.titleInput.Value())
 	if title == "" {
-		m.setStatus("Error: Title cannot be empty")
+		m.setError("Error: Title cannot be empty")
 		return nil
 	}
 
+	if checkDuplicates {
+		if dup := models.FindSimilar(title, m.allTickets()); dup != nil {
+			m.duplicateOf = dup
+			m.viewMode = ViewDuplicateWarning
+			return nil
+		}
+	}
+
 	col := m.columns[m.activeColumn]
 	ticket := models.NewTicket(title, col.Config.Dir)
 	ticket.Tags = m.parseTagsInput()
 	ticket.Content = strings.TrimSpace(m.contentInput.Value())
-	ticket.FilePath = filepath.Join(
-		m.config.ColumnPath(col.Config.Dir),
-		ticket.GenerateFilename(),
-	)
-
-	if err := ticket.Save(); err != nil {
-		m.setStatus(fmt.Sprintf("Error: %v", err))
+	path, err := ticket.UniqueFilePath(m.config.ColumnPath(col.Config.Dir))
+	if err == nil {
+		ticket.FilePath = path
+		err = ticket.Save()
+	}
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
 	} else {
-		m.setStatus(fmt.Sprintf("Created: %s", title))
+		m.removeDraft()
+		m.setSuccess(fmt.Sprintf("Created: %s", title))
+		m.fireHook(hooks.Create, ticket, "")
 	}
 
 	m.viewMode = ViewBoard
@@ -678,7 +1477,7 @@ func (m *Model) saveTicket() tea.Cmd {
 
 	title := strings.TrimSpace(m.titleInput.Value())
 	if title == "" {
-		m.setStatus("Error: Title cannot be empty")
+		m.setError("Error: Title cannot be empty")
 		return nil
 	}
 
@@ -687,9 +1486,10 @@ func (m *Model) saveTicket() tea.Cmd {
 	m.editingTicket.Content = strings.TrimSpace(m.contentInput.Value())
 
 	if err := m.editingTicket.Save(); err != nil {
-		m.setStatus(fmt.Sprintf("Error: %v", err))
+		m.setError(fmt.Sprintf("Error: %v", err))
 	} else {
-		m.setStatus(fmt.Sprintf("Updated: %s", title))
+		m.removeDraft()
+		m.setSuccess(fmt.Sprintf("Updated: %s", title))
 	}
 
 	m.viewMode = ViewBoard
@@ -699,33 +1499,51 @@ func (m *Model) saveTicket() tea.Cmd {
 	return nil
 }
 
-// deleteSelectedTicket deletes the selected ticket.
-func (m *Model) deleteSelectedTicket() tea.Cmd {
+// deleteSelectedTicket moves the selected ticket to the board's trash, or
+// removes it for good when permanent is set.
+func (m *Model) deleteSelectedTicket(permanent bool) tea.Cmd {
 	ticket := m.getSelectedTicket()
 	if ticket == nil {
 		return nil
 	}
 
-	if err := ticket.Delete(); err != nil {
-		m.setStatus(fmt.Sprintf("Error: %v", err))
+	if permanent {
+		if err := ticket.Delete(); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+		} else {
+			m.setSuccess(fmt.Sprintf("Deleted: %s", ticket.Title))
+			m.fireHook(hooks.Delete, ticket, "")
+		}
+	} else if _, err := ticket.Trash(ticket.KanbanDir()); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
 	} else {
-		m.setStatus(fmt.Sprintf("Deleted: %s", ticket.Title))
+		m.setSuccess(fmt.Sprintf("Moved to %s/: %s", models.TrashDir, ticket.Title))
+		m.fireHook(hooks.Delete, ticket, "")
 	}
 
 	m.viewMode = ViewBoard
 	m.loadAllTickets()
 
-	// Adjust selection if needed
-	col := m.columns[m.activeColumn]
-	if m.activeTicket >= len(col.Tickets) && m.activeTicket > 0 {
-		m.activeTicket--
-	}
-
+	m.clampSelection()
 	return nil
 }
 
-// moveSelectedTicket moves the selected ticket to a new column.
-func (m *Model) moveSelectedTicket() tea.Cmd {
+// confirmMove moves the selected ticket to moveTarget, showing the target
+// column's entry checklist first if it has one, and then asking for a
+// reason if the transition requires one.
+func (m *Model) confirmMove() tea.Cmd {
+	if m.moveTarget != m.activeColumn && len(m.columns[m.moveTarget].Config.Checklist) > 0 {
+		m.openEntryChecklist()
+		return nil
+	}
+	return m.moveWithReason(nil)
+}
+
+// moveSelectedTicket moves the selected ticket to a new column, recording
+// the skipped checklist items and the reason for the move, if any.
+func (m *Model) moveSelectedTicket(skipped []string, reason string) tea.Cmd {
+	approving := m.approving
+	m.approving = false
 	ticket := m.getSelectedTicket()
 	if ticket == nil {
 		return nil
@@ -737,31 +1555,44 @@ func (m *Model) moveSelectedTicket() tea.Cmd {
 	}
 
 	targetCol := m.columns[m.moveTarget].Config.Dir
-
-	if err := ticket.Move(m.config.KanbanDir, targetCol); err != nil {
-		m.setStatus(fmt.Sprintf("Error: %v", err))
+	wip := ""
+	if warning := m.wipWarning(m.columns[m.moveTarget], 1); warning != "" {
+		wip = fmt.Sprintf(", was %s of %d", warning, m.columns[m.moveTarget].Config.WIPLimit)
+	}
+
+	if err := m.moveTicketTo(ticket, targetCol, reason, skipped); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+	} else if reason != "" && len(skipped) == 0 {
+		m.setSuccess(fmt.Sprintf("Moved to %s: %s", m.columns[m.moveTarget].Config.Name, reason))
+	} else if len(skipped) > 0 {
+		m.setSuccess(fmt.Sprintf("Moved to %s (%d checklist item(s) skipped%s)", m.columns[m.moveTarget].Config.Name, len(skipped), wip))
+	} else if wip != "" {
+		m.setSuccess(fmt.Sprintf("Moved to %s (%s)", m.columns[m.moveTarget].Config.Name, strings.TrimPrefix(wip, ", ")))
 	} else {
-		m.setStatus(fmt.Sprintf("Moved to %s", m.columns[m.moveTarget].Config.Name))
+		m.setSuccess(fmt.Sprintf("Moved to %s", m.columns[m.moveTarget].Config.Name))
+	}
+	if approving && ticket.Column == targetCol {
+		if err := m.stampApproval(ticket); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+		} else if len(skipped) > 0 {
+			m.setSuccess(fmt.Sprintf("Approved: %s (%d checklist item(s) skipped)", ticket.ShortTitle(30), len(skipped)))
+		} else {
+			m.setSuccess(fmt.Sprintf("Approved: %s", ticket.ShortTitle(30)))
+		}
 	}
 
 	m.viewMode = ViewBoard
 	m.loadAllTickets()
 
-	// Adjust selection if needed
-	col := m.columns[m.activeColumn]
-	if m.activeTicket >= len(col.Tickets) && m.activeTicket > 0 {
-		m.activeTicket--
+	// Offer to clean up the ticket's worktree once it is done
+	if ticket.Worktree != "" && m.moveTarget == m.config.DoneColumnIndex() {
+		m.confirmRemoveWorktree(ticket)
 	}
 
+	m.clampSelection()
 	return nil
 }
 
-// setStatus sets a temporary status message.
-func (m *Model) setStatus(msg string) {
-	m.statusMessage = msg
-	m.statusTimeout = time.Now().Add(3 * time.Second)
-}
-
 // copySelectedTicketPrompt copies the prompt for the selected ticket to clipboard.
 func (m *Model) copySelectedTicketPrompt() tea.Cmd {
 	ticket := m.getSelectedTicket()
@@ -772,21 +1603,54 @@ func (m *Model) copySelectedTicketPrompt() tea.Cmd {
 
 	prompt, err := m.renderSingleTicketPrompt(ticket)
 	if err != nil {
-		m.setStatus(fmt.Sprintf("Error: %v", err))
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+
+	if err := m.copyToClipboard(prompt); err != nil {
+		m.setError(fmt.Sprintf("Clipboard error: %v", err))
+		return nil
+	}
+
+	m.setSuccess(fmt.Sprintf("Copied prompt for: %s (%s)", ticket.ShortTitle(30), m.promptSizeNote(prompt)))
+	return nil
+}
+
+// copyVerifyPrompt copies the acceptance criteria verification prompt for
+// the selected ticket to clipboard.
+func (m *Model) copyVerifyPrompt() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		m.setStatus("No ticket selected")
+		return nil
+	}
+	if len(ticket.AcceptanceCriteria()) == 0 {
+		m.setStatus("Ticket has no acceptance criteria")
+		return nil
+	}
+
+	prompt, err := m.renderVerifyPrompt(ticket)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
 		return nil
 	}
 
-	if err := copyToClipboard(prompt); err != nil {
-		m.setStatus(fmt.Sprintf("Clipboard error: %v", err))
+	if err := m.copyToClipboard(prompt); err != nil {
+		m.setError(fmt.Sprintf("Clipboard error: %v", err))
 		return nil
 	}
 
-	m.setStatus(fmt.Sprintf("Copied prompt for: %s", ticket.ShortTitle(30)))
+	m.setSuccess(fmt.Sprintf("Copied verify prompt for: %s (%s)", ticket.ShortTitle(30), m.promptSizeNote(prompt)))
 	return nil
 }
 
 // copyTodoTicketsPrompt copies prompts for all tickets in the first column.
+// When a chunked batch is in progress, it copies the next chunk instead.
 func (m *Model) copyTodoTicketsPrompt() tea.Cmd {
+	if len(m.pendingChunks) > 0 {
+		return m.copyNextChunk()
+	}
+
 	if len(m.columns) == 0 {
 		m.setStatus("No columns configured")
 		return nil
@@ -798,18 +1662,61 @@ func (m *Model) copyTodoTicketsPrompt() tea.Cmd {
 		return nil
 	}
 
-	prompt, err := m.renderBatchTicketPrompt(todoColumn.Tickets)
+	return m.copyBatchPrompt(todoColumn.Tickets, "todo ticket(s)")
+}
+
+// copyBatchPrompt renders a batch prompt for tickets, splitting it into chunks
+// when batch_chunk_size is set. Chunks are either written to numbered files or
+// queued for sequential copying. A new batch drops the chunks left of the
+// previous one.
+func (m *Model) copyBatchPrompt(tickets []*models.Ticket, label string) tea.Cmd {
+	m.pendingChunks = nil
+	prompts, err := m.renderBatchChunks(tickets)
 	if err != nil {
-		m.setStatus(fmt.Sprintf("Error: %v", err))
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+
+	if len(prompts) == 1 {
+		if err := m.copyToClipboard(prompts[0]); err != nil {
+			m.setError(fmt.Sprintf("Clipboard error: %v", err))
+			return nil
+		}
+		m.setSuccess(fmt.Sprintf("Copied %d %s to clipboard (%s)", len(tickets), label, m.promptSizeNote(prompts[0])))
 		return nil
 	}
 
-	if err := copyToClipboard(prompt); err != nil {
-		m.setStatus(fmt.Sprintf("Clipboard error: %v", err))
+	if m.config.BatchChunkFiles {
+		dir, err := m.writeBatchChunks(prompts)
+		if err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		m.setSuccess(fmt.Sprintf("Wrote %d prompt chunks for %d %s to %s", len(prompts), len(tickets), label, dir))
+		return nil
+	}
+
+	m.pendingChunks = prompts
+	m.chunkTotal = len(prompts)
+	return m.copyNextChunk()
+}
+
+// copyNextChunk copies the next queued batch prompt chunk to the clipboard.
+func (m *Model) copyNextChunk() tea.Cmd {
+	prompt := m.pendingChunks[0]
+	part := m.chunkTotal - len(m.pendingChunks) + 1
+
+	if err := m.copyToClipboard(prompt); err != nil {
+		m.setError(fmt.Sprintf("Clipboard error: %v", err))
 		return nil
 	}
+	m.pendingChunks = m.pendingChunks[1:]
 
-	m.setStatus(fmt.Sprintf("Copied %d todo ticket(s) to clipboard", len(todoColumn.Tickets)))
+	if len(m.pendingChunks) > 0 {
+		m.setSuccess(fmt.Sprintf("Copied chunk %d/%d (%s) - press P for the next", part, m.chunkTotal, m.promptSizeNote(prompt)))
+	} else {
+		m.setSuccess(fmt.Sprintf("Copied final chunk %d/%d (%s)", part, m.chunkTotal, m.promptSizeNote(prompt)))
+	}
 	return nil
 }
 
@@ -828,10 +1735,70 @@ func (m *Model) View() string {
 		return m.renderDeleteConfirmScreen()
 	case ViewMoveTicket:
 		return m.renderMoveScreen()
+	case ViewChecklist:
+		return m.renderEntryChecklistScreen()
+	case ViewMoveReason:
+		return m.renderMoveReasonScreen()
+	case ViewEmail:
+		return m.renderEmailScreen()
+	case ViewPalette:
+		return m.renderPaletteScreen()
 	case ViewSearch:
 		return m.renderSearchScreen()
 	case ViewAgentFeedback:
 		return m.renderAgentFeedbackScreen()
+	case ViewStats:
+		return m.renderStats()
+	case ViewReview:
+		return m.renderReviewQueue()
+	case ViewReopenComment:
+		return m.renderReopenScreen()
+	case ViewSplit:
+		return m.renderSplitScreen()
+	case ViewEpics:
+		return m.renderEpicTree()
+	case ViewConfirm:
+		return m.renderConfirmScreen()
+	case ViewDuplicateWarning:
+		return m.renderDuplicateScreen()
+	case ViewTips:
+		return m.renderTips()
+	case ViewBoardPicker:
+		return m.renderBoardPicker()
+	case ViewColorPicker:
+		return m.renderColorPicker()
+	case ViewSessions:
+		return m.renderSessions()
+	case ViewCodeBlocks:
+		return m.renderCodeBlocks()
+	case ViewPager:
+		return m.renderPager()
+	case ViewQueue:
+		return m.renderQueue()
+	case ViewToday:
+		return m.renderToday()
+	case ViewSnooze:
+		return m.renderSnoozeScreen()
+	case ViewStarred:
+		return m.renderStarred()
+	case ViewTagRename:
+		return m.renderTagRenameScreen()
+	case ViewSwitcher:
+		return m.renderSwitcherScreen()
+	case ViewReplace:
+		return m.renderReplaceScreen()
+	case ViewReplacePreview:
+		return m.renderReplacePreview()
+	case ViewTemplates:
+		return m.renderTemplates()
+	case ViewTemplateVars:
+		return m.renderTemplateVarScreen()
+	case ViewPlugins:
+		return m.renderPlugins()
+	case ViewConflicts:
+		return m.renderConflicts()
+	case ViewPrivate:
+		return m.renderPrivateScreen()
 	default:
 		return m.renderBoard()
 	}
@@ -842,36 +1809,54 @@ func (m *Model) renderBoard() string {
 	var b strings.Builder
 
 	// Header
-	header := m.styles.Header.Width(m.width - 4).Render("  Kanban Board")
+	headerText := "  Kanban Board"
+	if len(m.workspace) > 0 {
+		headerText = fmt.Sprintf("  Workspace (%d boards)", len(m.workspace)+1)
+	}
+	if epic := m.findTicketByFilename(m.epicFilter); epic != nil {
+		headerText += "  ·  Epic: " + epic.Title + " (Esc to clear)"
+	}
+	if n := len(m.reviewQueue()); n > 0 {
+		headerText += fmt.Sprintf("  ·  %d to review (R)", n)
+	}
+	if n := len(m.syncConflicts); n > 0 {
+		headerText += fmt.Sprintf("  ·  %d sync conflicts (!)", n)
+	}
+	header := m.styles.Header.Width(m.width - 4).Render(headerText)
 	b.WriteString(header)
 	b.WriteString("\n\n")
 
-	// Calculate column width
-	colWidth := (m.width - 4 - len(m.columns)*2) / len(m.columns)
-	if colWidth < 20 {
-		colWidth = 20
-	}
+	colWidth := m.columnWidth()
 
 	// Render columns
 	var columnViews []string
 	for i, col := range m.columns {
+		if m.collapsed(i) {
+			columnViews = append(columnViews, m.renderSlimColumn(col, i))
+			continue
+		}
 		isActive := i == m.activeColumn
 		columnViews = append(columnViews, m.renderColumn(col, i, colWidth, isActive))
 	}
+	m.finishRenderFrame()
 
 	// Join columns horizontally
 	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, columnViews...))
 	b.WriteString("\n")
 
 	// Status message
-	if m.statusMessage != "" && time.Now().Before(m.statusTimeout) {
+	if toasts := m.renderToasts(); toasts != "" {
 		b.WriteString("\n")
-		b.WriteString(m.styles.StatusMessage.Render(m.statusMessage))
+		b.WriteString(toasts)
 	}
 
-	// Help bar at bottom
+	// Help bar at bottom, or the next keys of a leader sequence
 	b.WriteString("\n\n")
-	b.WriteString(m.renderHelpBar())
+	if m.chordKeys != nil {
+		b.WriteString(m.renderChordHints())
+	} else {
+		b.WriteString(m.renderHelpBar())
+	}
 
 	return m.styles.App.Render(b.String())
 }
@@ -880,35 +1865,60 @@ func (m *Model) renderBoard() string {
 func (m *Model) renderColumn(col ColumnData, colIndex, width int, isActive bool) string {
 	var b strings.Builder
 
-	// Filter tickets if searching
-	tickets := col.Tickets
-	if m.searchQuery != "" {
-		tickets = m.filterTickets(tickets)
-	}
+	// Filter tickets if searching or focused on an epic
+	tickets := m.getFilteredTickets(colIndex)
 
 	// Column header with color (show filtered count when searching)
 	headerColor := GetColumnColor(col.Config.Dir)
 	headerStyle := m.styles.ColumnHeader.Copy().Background(headerColor)
-	count := m.styles.ColumnCount.Render(fmt.Sprintf("(%d)", len(tickets)))
-	header := headerStyle.Render(col.Config.Name) + count
+	count := fmt.Sprintf("(%d)", len(tickets))
+	if col.Config.WIPLimit > 0 {
+		count = "(" + m.columnCount(col) + ")"
+	}
+	countStyle := m.styles.ColumnCount
+	if m.wipWarning(col, 0) != "" {
+		countStyle = countStyle.Copy().Foreground(GruvboxRed)
+	}
+	header := headerStyle.Render(col.Config.Label()) + countStyle.Render(count)
+	if age := m.oldestAge(colIndex); age != "" {
+		// Dropped when the column is too narrow to fit it
+		withAge := headerStyle.Render(col.Config.Label()) + countStyle.Render(count+" · oldest "+age)
+		if lipgloss.Width(withAge) <= width-2 {
+			header = withAge
+		}
+	}
 	b.WriteString(header)
 	b.WriteString("\n")
 
-	// Render tickets
-	maxTickets := (m.height - 12) / 4
-	if maxTickets < 3 {
-		maxTickets = 3
+	// Render the visible window of tickets, following the selection
+	maxTickets := m.visibleTicketCount()
+	offset := &m.columns[colIndex].Offset
+	if isActive {
+		if m.activeTicket < *offset {
+			*offset = m.activeTicket
+		} else if m.activeTicket >= *offset+maxTickets {
+			*offset = m.activeTicket - maxTickets + 1
+		}
 	}
+	*offset = min(*offset, max(len(tickets)-maxTickets, 0))
 
-	for i, ticket := range tickets {
-		if i >= maxTickets {
-			remaining := len(tickets) - maxTickets
+	if *offset > 0 {
+		b.WriteString(m.styles.TicketDate.Render(fmt.Sprintf("  ↑ %d more", *offset)))
+		b.WriteString("\n")
+	}
+	for i := *offset; i < len(tickets); i++ {
+		if i >= *offset+maxTickets {
+			remaining := len(tickets) - i
 			b.WriteString(m.styles.TicketDate.Render(fmt.Sprintf("  +%d more...", remaining)))
 			break
 		}
 
 		isSelected := isActive && i == m.activeTicket
-		b.WriteString(m.renderTicket(ticket, width-4, isSelected))
+		if isSelected && m.viewMode == ViewQuickEdit {
+			b.WriteString(m.renderQuickEditCard(width - 4))
+			continue
+		}
+		b.WriteString(m.renderCard(tickets[i], width-4, isSelected))
 	}
 
 	if len(tickets) == 0 {
@@ -916,51 +1926,93 @@ func (m *Model) renderColumn(col ColumnData, colIndex, width int, isActive bool)
 		b.WriteString(empty)
 	}
 
-	// Apply column style
-	style := m.styles.Column
-	if isActive {
-		style = m.styles.ColumnActive
-	}
-
-	return style.Width(width).Height(m.height - 10).Render(b.String())
+	return m.styleColumn(b.String(), width, m.height-10-m.extraToastLines(), isActive)
 }
 
 // renderTicket renders a single ticket card.
 func (m *Model) renderTicket(ticket *models.Ticket, width int, isSelected bool) string {
 	var b strings.Builder
 
-	title := m.styles.TicketTitle.Render(ticket.ShortTitle(width - 4))
+	titleWidth := width - 4
+	if idx := m.markIndex(ticket); idx >= 0 {
+		badge := fmt.Sprintf("[%d] ", idx+1)
+		b.WriteString(m.styles.TicketMark.Render(badge))
+		titleWidth -= runewidth.StringWidth(badge)
+	}
+
+	titleStyle := m.styles.TicketTitle
+	snoozed := ticket.SnoozedAt(time.Now())
+	if snoozed {
+		titleStyle = titleStyle.Copy().Foreground(GruvboxGray)
+	}
+	if m.isStarred(ticket) {
+		b.WriteString(m.styles.HelpKey.Render("★ "))
+		titleWidth -= 2
+	}
+	title := titleStyle.Render(ticket.ShortTitle(titleWidth))
 	b.WriteString(title)
 	b.WriteString("\n")
 
 	if len(ticket.Tags) > 0 {
-		tags := m.styles.TicketTags.Render(strings.Join(ticket.Tags, ", "))
+		tags := m.renderTags(ticket.Tags, width-4)
 		b.WriteString(tags)
 		b.WriteString("\n")
 	}
 
-	date := m.styles.TicketDate.Render(ticket.Updated.Format("Jan 02"))
+	date := m.styles.TicketDate.Render(m.formatDate(ticket.Updated))
 	b.WriteString(date)
+	if snoozed {
+		b.WriteString(m.styles.TicketDate.Render("  ·  snoozed until " + ticket.SnoozedUntil))
+	}
+
+	if len(m.workspace) > 0 {
+		b.WriteString(m.styles.TicketDate.Render("  ·  "))
+		b.WriteString(m.styles.TicketMark.Render(boardName(ticket.KanbanDir())))
+	}
+
+	if ticket.PR != "" {
+		b.WriteString("\n")
+		b.WriteString(m.renderPRBadge(ticket))
+	}
+
+	if done, total := m.epicProgress(ticket); total > 0 {
+		b.WriteString("\n")
+		b.WriteString(m.styles.TicketTags.Render(fmt.Sprintf("▸ %d/%d children done", done, total)))
+	}
 
 	style := m.styles.Ticket
 	if isSelected {
 		style = m.styles.TicketSelected
 	}
+	if ticket.Color != "" && !isSelected {
+		// Tint the border; the selection highlight still wins
+		style = style.Copy().BorderForeground(lipgloss.Color(ticket.Color))
+	}
 
 	return style.Width(width).Render(b.String())
 }
 
-// filterTickets filters tickets by search query.
+// filterTickets filters tickets by search query and epic, and hides
+// snoozed tickets unless they are shown.
 func (m *Model) filterTickets(tickets []*models.Ticket) []*models.Ticket {
-	if m.searchQuery == "" {
+	now := time.Now()
+	hideSnoozed := !m.showSnoozed && slices.ContainsFunc(tickets, func(t *models.Ticket) bool {
+		return t.SnoozedAt(now)
+	})
+	if m.searchQuery == "" && m.epicFilter == "" && !hideSnoozed {
 		return tickets
 	}
 
-	query := strings.ToLower(m.searchQuery)
 	var filtered []*models.Ticket
 
 	for _, t := range tickets {
-		if strings.Contains(strings.ToLower(t.Title), query) {
+		if m.epicFilter != "" && t.Parent != m.epicFilter && t.Filename() != m.epicFilter {
+			continue
+		}
+		if hideSnoozed && t.SnoozedAt(now) {
+			continue
+		}
+		if m.searchQuery == "" || m.matchesSearch(t) {
 			filtered = append(filtered, t)
 		}
 	}
@@ -968,6 +2020,26 @@ func (m *Model) filterTickets(tickets []*models.Ticket) []*models.Ticket {
 	return filtered
 }
 
+// matchesSearch reports whether the ticket matches the search query, using
+// the search index so each frame doesn't re-scan every ticket.
+func (m *Model) matchesSearch(t *models.Ticket) bool {
+	if m.searchHitsFor != m.searchQuery || m.searchHitsGen != m.searchIndex.Generation() || m.searchHits == nil {
+		m.searchHits = m.searchIndex.Search(m.searchQuery)
+		m.searchHitsFor = m.searchQuery
+		m.searchHitsGen = m.searchIndex.Generation()
+		if m.searchHits == nil {
+			// No words to look up (e.g. only punctuation): match titles
+			m.searchHits = make(map[string]bool)
+			for _, ticket := range m.allTickets() {
+				if models.MatchesQuery(ticket.Title, m.searchQuery) {
+					m.searchHits[ticket.FilePath] = true
+				}
+			}
+		}
+	}
+	return m.searchHits[t.FilePath]
+}
+
 // renderTicketEditor renders the unified ticket editor (create/edit/view modes).
 func (m *Model) renderTicketEditor() string {
 	var b strings.Builder
@@ -975,13 +2047,7 @@ func (m *Model) renderTicketEditor() string {
 	isViewMode := m.editorMode == EditorModeView
 
 	// Calculate content width (leave margins)
-	contentWidth := m.width - 8
-	if contentWidth > 80 {
-		contentWidth = 80
-	}
-	if contentWidth < 40 {
-		contentWidth = 40
-	}
+	contentWidth := m.editorWidth()
 
 	// Update input widths to match
 	m.titleInput.Width = contentWidth - 4
@@ -1043,11 +2109,51 @@ func (m *Model) renderTicketEditor() string {
 	b.WriteString(header)
 	b.WriteString("\n\n")
 
+	if banner := m.renderDraftBanner(); banner != "" {
+		b.WriteString(banner)
+		b.WriteString("\n\n")
+	}
+
 	// Column indicator
 	b.WriteString(m.styles.HelpDesc.Render(columnText))
 	b.WriteString(columnBadge)
+	if m.editingTicket != nil {
+		b.WriteString(m.styles.TicketDate.Render(fmt.Sprintf("  %s in column  ·  created %s  ·  updated %s",
+			ticketAge(m.editingTicket),
+			m.formatDate(m.editingTicket.Created),
+			m.formatDate(m.editingTicket.Updated))))
+		if m.editingTicket.Attempts > 0 {
+			b.WriteString(m.styles.TicketDate.Render(fmt.Sprintf("  ·  %d agent attempt(s)", m.editingTicket.Attempts)))
+		}
+	}
 	b.WriteString("\n\n")
 
+	// Git links (view mode only)
+	if isViewMode && m.editingTicket != nil {
+		if links := m.renderGitLinks(m.editingTicket); links != "" {
+			b.WriteString(links)
+			b.WriteString("\n\n")
+		}
+	}
+
+	// Acceptance criteria checklist (view mode only)
+	if isViewMode && m.editingTicket != nil {
+		if criteria := m.renderCriteria(m.editingTicket); criteria != "" {
+			b.WriteString(criteria)
+			b.WriteString("\n\n")
+		}
+		if skipped := m.editingTicket.SkippedChecks(); len(skipped) > 0 {
+			b.WriteString(m.styles.HelpDesc.Render("Skipped on entry: "))
+			b.WriteString(m.styles.StatusMessage.Render(strings.Join(skipped, ", ")))
+			b.WriteString("\n\n")
+		}
+		if reason := m.editingTicket.MoveReason(); reason != "" {
+			b.WriteString(m.styles.HelpDesc.Render("Moved here because: "))
+			b.WriteString(m.styles.StatusMessage.Render(reason))
+			b.WriteString("\n\n")
+		}
+	}
+
 	// Title field
 	titleLabel := m.styles.ModalTitle.Render("Title")
 	if !isViewMode && m.editorFocus == 0 {
@@ -1084,12 +2190,11 @@ func (m *Model) renderTicketEditor() string {
 
 	if isViewMode {
 		// View mode: show styled text
-		tagsContent := m.tagsInput.Value()
-		if tagsContent == "" {
-			tagsContent = "(no tags)"
+		tagsContent := m.styles.TicketTags.Render("(no tags)")
+		if tags := m.parseTagsInput(); len(tags) > 0 {
+			tagsContent = m.renderTags(tags, contentWidth-4)
 		}
-		b.WriteString(m.styles.Input.Width(contentWidth).Render(
-			m.styles.TicketTags.Render(tagsContent)))
+		b.WriteString(m.styles.Input.Width(contentWidth).Render(tagsContent))
 	} else {
 		// Edit mode: show input
 		tagsStyle := m.styles.Input
@@ -1114,7 +2219,14 @@ func (m *Model) renderTicketEditor() string {
 		if contentText == "" {
 			contentText = "(no content)"
 		}
-		b.WriteString(m.styles.Input.Width(contentWidth).Height(taHeight + 2).Render(contentText))
+		headings := parseHeadings(contentText)
+		if m.showOutline && len(headings) > 0 {
+			box := m.renderViewer(&m.ticketViewer, m.highlightedContent(contentText), contentWidth-outlineWidth-3, taHeight+2)
+			outline := m.renderOutline(contentText, headings, taHeight+2)
+			b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, box, " ", outline))
+		} else {
+			b.WriteString(m.renderViewer(&m.ticketViewer, m.highlightedContent(contentText), contentWidth, taHeight+2))
+		}
 	} else {
 		// Edit mode: show textarea
 		contentStyle := m.styles.Input
@@ -1123,7 +2235,36 @@ func (m *Model) renderTicketEditor() string {
 		}
 		b.WriteString(contentStyle.Width(contentWidth).Height(taHeight + 2).Render(m.contentInput.View()))
 	}
-	b.WriteString("\n\n")
+	b.WriteString("\n")
+	if indicator := m.scrollIndicator(&m.ticketViewer); isViewMode && indicator != "" {
+		b.WriteString(indicator)
+		b.WriteString("\n")
+	}
+	b.WriteString(m.renderContentStats())
+	b.WriteString("\n")
+	if spelling := m.renderSpelling(contentWidth); spelling != "" {
+		b.WriteString(spelling)
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	// Cover image preview (view mode only, when enabled)
+	if isViewMode && m.editingTicket != nil {
+		if preview := m.renderImagePreview(m.editingTicket, contentWidth-4); preview != "" {
+			b.WriteString(m.styles.ModalTitle.Render("Cover"))
+			b.WriteString("\n")
+			b.WriteString(preview)
+			b.WriteString("\n\n")
+		}
+	}
+
+	// Private notes (view mode only, when the ticket has any)
+	if isViewMode && m.editingTicket != nil {
+		if private := m.renderPrivateSection(m.editingTicket, contentWidth); private != "" {
+			b.WriteString(private)
+			b.WriteString("\n\n")
+		}
+	}
 
 	// Agent feedback preview (view mode only, when feedback exists)
 	if isViewMode && m.editingTicket != nil && m.editingTicket.AgentFeedback != "" {
@@ -1135,8 +2276,8 @@ func (m *Model) renderTicketEditor() string {
 		feedback := m.editingTicket.AgentFeedback
 		previewLines := strings.SplitN(feedback, "\n", 3)
 		preview := strings.Join(previewLines[:min(len(previewLines), 2)], "\n")
-		if len(preview) > 100 {
-			preview = preview[:100] + "..."
+		if runewidth.StringWidth(preview) > 100 {
+			preview = runewidth.Truncate(preview, 100, "...")
 		} else if len(previewLines) > 2 {
 			preview += "..."
 		}
@@ -1149,8 +2290,8 @@ func (m *Model) renderTicketEditor() string {
 	}
 
 	// Status message if any
-	if m.statusMessage != "" && time.Now().Before(m.statusTimeout) {
-		b.WriteString(m.styles.StatusMessage.Render(m.statusMessage))
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
 		b.WriteString("\n\n")
 	}
 
@@ -1159,16 +2300,27 @@ func (m *Model) renderTicketEditor() string {
 	if isViewMode {
 		helpKeys = []struct{ key, desc string }{
 			{"e", "edit"},
-			{"Esc", "back"},
 		}
-		// Show feedback shortcut only if agent feedback exists
+		// Show feedback and session shortcuts only if there is something to show
 		if m.editingTicket != nil && m.editingTicket.AgentFeedback != "" {
-			helpKeys = []struct{ key, desc string }{
-				{"e", "edit"},
-				{"f", "feedback"},
-				{"Esc", "back"},
-			}
+			helpKeys = append(helpKeys, struct{ key, desc string }{"f", "feedback"})
+		}
+		if m.editingTicket != nil && sessionCount(m.editingTicket) > 0 {
+			helpKeys = append(helpKeys, struct{ key, desc string }{"L", "agent sessions"})
+		}
+		if t := m.editingTicket; t != nil && (t.Branch != "" || t.Worktree != "" || len(t.Commits) > 0) {
+			helpKeys = append(helpKeys, struct{ key, desc string }{"D", "diff"})
 		}
+		helpKeys = append(helpKeys, struct{ key, desc string }{"p", "private notes"})
+		helpKeys = append(helpKeys, struct{ key, desc string }{"w", m.wrapHint()})
+		if len(parseHeadings(m.contentInput.Value())) > 0 {
+			helpKeys = append(helpKeys, struct{ key, desc string }{"]]/[[", "sections"})
+			helpKeys = append(helpKeys, struct{ key, desc string }{"o", "outline"})
+		}
+		if m.editingTicket != nil && len(parseCodeBlocks(m.editingTicket.Content)) > 0 {
+			helpKeys = append(helpKeys, struct{ key, desc string }{"c", "copy code"})
+		}
+		helpKeys = append(helpKeys, struct{ key, desc string }{"Esc", "back"})
 	} else {
 		helpKeys = []struct{ key, desc string }{
 			{"Tab", "next field"},
@@ -1198,35 +2350,108 @@ func (m *Model) renderMoveModal() string {
 	b.WriteString("\n\n")
 
 	for i, col := range m.columns {
-		style := m.styles.Button
+		cursor := "  "
 		if i == m.moveTarget {
-			style = m.styles.ButtonActive
+			cursor = m.styles.HelpKey.Render("▶ ")
 		}
-		b.WriteString(style.Render(col.Config.Name))
+		key := " "
+		if i < 9 {
+			key = fmt.Sprint(i + 1)
+		}
+		label := runewidth.FillRight(runewidth.Truncate(col.Config.Label(), 24, "…"), 24)
+		if i == m.moveTarget {
+			label = lipgloss.NewStyle().Bold(true).Foreground(GruvboxYellow).Render(label)
+		}
+		line := fmt.Sprintf("%s%s  %s %s", cursor, m.styles.HelpKey.Render(key), label, m.styles.ColumnCount.Render(m.columnCount(col)))
+		if i == m.activeColumn {
+			line += m.styles.HelpDesc.Render("  (current)")
+		} else if warning := m.wipWarning(col, 1); warning != "" {
+			line += lipgloss.NewStyle().Foreground(GruvboxOrange).Render("  " + warning)
+		}
+		b.WriteString(line + "\n")
 	}
 
-	b.WriteString("\n\n")
-	b.WriteString(m.styles.HelpDesc.Render("h/l to select, Enter to confirm, Esc to cancel"))
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("j/k select, 1-9 move, Enter confirm, Esc cancel"))
 
 	return m.styles.Modal.Width(60).Render(b.String())
 }
 
-// renderDeleteConfirm renders the delete confirmation modal.
-func (m *Model) renderDeleteConfirm() string {
-	ticket := m.getSelectedTicket()
-	title := ""
-	if ticket != nil {
-		title = ticket.Title
+// columnCount renders a column's ticket count, with its WIP limit if set.
+func (m *Model) columnCount(col ColumnData) string {
+	if limit := col.Config.WIPLimit; limit > 0 {
+		return fmt.Sprintf("%d/%d", len(col.Tickets), limit)
+	}
+	return fmt.Sprint(len(col.Tickets))
+}
+
+// wipWarning describes a column that is over its WIP limit, or that added
+// more tickets would push over it. It returns "" if the limit holds.
+func (m *Model) wipWarning(col ColumnData, added int) string {
+	limit := col.Config.WIPLimit
+	switch {
+	case limit <= 0 || len(col.Tickets)+added <= limit:
+		return ""
+	case len(col.Tickets) > limit:
+		return "over WIP limit"
+	default:
+		return "at WIP limit"
 	}
+}
 
+// renderDeleteConfirm renders the delete confirmation modal with the
+// ticket's path, tags and the start of its content.
+func (m *Model) renderDeleteConfirm() string {
+	const width = 60
 	var b strings.Builder
 	b.WriteString(m.styles.ModalTitle.Render("Delete Ticket?"))
 	b.WriteString("\n\n")
-	b.WriteString(fmt.Sprintf("Are you sure you want to delete:\n%s", title))
-	b.WriteString("\n\n")
-	b.WriteString(m.styles.HelpDesc.Render("y to confirm, n/Esc to cancel"))
 
-	return m.styles.Modal.Width(50).Render(b.String())
+	if ticket := m.getSelectedTicket(); ticket != nil {
+		b.WriteString(lipgloss.NewStyle().Bold(true).Render(runewidth.Truncate(ticket.Title, width-6, "…")))
+		b.WriteString("\n")
+		path := ticket.FilePath
+		if rel, err := filepath.Rel(filepath.Dir(ticket.KanbanDir()), path); err == nil {
+			path = rel
+		}
+		b.WriteString(m.styles.HelpDesc.Render(runewidth.Truncate(path, width-6, "…")))
+		b.WriteString("\n")
+		if len(ticket.Tags) > 0 {
+			b.WriteString(m.renderTags(ticket.Tags, width-6))
+			b.WriteString("\n")
+		}
+		if preview := contentPreview(ticket.Content, deletePreviewLines, width-6); preview != "" {
+			b.WriteString("\n")
+			b.WriteString(m.styles.HelpDesc.Render(preview))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%s move to %s/   %s delete permanently   %s cancel",
+		m.styles.HelpKey.Render("y"), models.TrashDir, m.styles.HelpKey.Render("D"), m.styles.HelpKey.Render("n")))
+
+	return m.styles.Modal.Width(width).Render(b.String())
+}
+
+// deletePreviewLines is the number of content lines shown before deleting.
+const deletePreviewLines = 6
+
+// contentPreview returns up to n non-blank lines of content, each truncated
+// to width, with "…" when lines were left out.
+func contentPreview(content string, n, width int) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(lines) == n {
+			lines = append(lines, "…")
+			break
+		}
+		lines = append(lines, runewidth.Truncate(strings.ReplaceAll(line, "\t", "    "), width, "…"))
+	}
+	return strings.Join(lines, "\n")
 }
 
 // renderSearchModal renders the search modal.
@@ -1242,6 +2467,19 @@ func (m *Model) renderSearchModal() string {
 	return m.styles.Modal.Width(50).Render(b.String())
 }
 
+// renderConfirmScreen renders the generic confirmation as a centered full-screen view.
+func (m *Model) renderConfirmScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Confirm"))
+	b.WriteString("\n\n")
+	b.WriteString(m.confirmPrompt)
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("y to confirm, n/Esc to cancel"))
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
 // renderDeleteConfirmScreen renders the delete confirmation as a centered full-screen view.
 func (m *Model) renderDeleteConfirmScreen() string {
 	modal := m.renderDeleteConfirm()
@@ -1295,14 +2533,18 @@ func (m *Model) renderAgentFeedbackScreen() string {
 	}
 
 	// Calculate available height for feedback content
-	feedbackHeight := max(m.height-14, 5)
+	feedbackHeight := max(m.height-15, 5)
 
-	feedbackStyle := m.styles.Input.Width(contentWidth).Height(feedbackHeight)
-	b.WriteString(feedbackStyle.Render(feedback))
-	b.WriteString("\n\n")
+	b.WriteString(m.renderViewer(&m.feedbackViewer, feedback, contentWidth, feedbackHeight))
+	b.WriteString("\n")
+	if indicator := m.scrollIndicator(&m.feedbackViewer); indicator != "" {
+		b.WriteString(indicator)
+	}
+	b.WriteString("\n")
 
 	// Help bar
 	helpKeys := []struct{ key, desc string }{
+		{"w", m.wrapHint()},
 		{"Esc/f", "back"},
 	}
 
@@ -1325,16 +2567,43 @@ func (m *Model) renderHelpBar() string {
 		{"h/l", "columns"},
 		{"j/k", "tickets"},
 		{"n", "new"},
+		{"v", "paste new"},
 		{"e", "edit"},
+		{"i", "quick edit"},
 		{"d", "delete"},
 		{"m", "move"},
+		{"c", "color"},
 		{"p", "copy ticket prompt"},
+		{"V", "copy verify prompt"},
+		{"y/Y", "copy markdown/JSON"},
+		{"a", "dispatch to agent"},
+		{"Q", "agent queue"},
 		{"P", "copy all todo prompts"},
+		{"Space", "select"},
+		{".", "last changed"},
+		{"s", "stats"},
+		{"R", "review"},
+		{"T", "today"},
+		{"z", "snooze"},
+		{"*", "star"},
+		{"'", "starred"},
+		{"o", "reopen"},
+		{"S", "split"},
+		{"E", "epics"},
+		{"M", "merge"},
+		{"B", "boards"},
+		{"W", "workspace"},
+		{"F", "focus"},
 		{"Enter", "view"},
 		{"/", "search"},
+		{"ctrl+p", "commands"},
+		{"ctrl+o", "go to ticket"},
 		{"?", "help"},
 		{"q", "quit"},
 	}
+	if m.activeColumn == m.reviewColumnIndex() {
+		keys = append([]struct{ key, desc string }{{"a", "approve"}, {"x", "reject"}}, keys...)
+	}
 
 	var parts []string
 	for _, k := range keys {
@@ -1360,17 +2629,58 @@ Navigation
 
 Actions
   n          Create new ticket
-  e          Edit selected ticket (opens $EDITOR)
-  d          Delete selected ticket
+  v          New ticket from the clipboard (first line is the title)
+  e          Edit selected ticket
+  Ctrl+E     Open the ticket file in the external editor (editor, $EDITOR)
+  i          Quick-edit title and tags in place
+  d          Delete selected ticket (y: to .trash/, D: permanently)
   m          Move ticket to another column
+  > / <      Move ticket one column right/left (, m 1-9: to column N)
+  c          Set the ticket's card color
+  b          Create/check out a git branch for the ticket
+  w          Create the ticket's git worktree (or remove it)
+  G          Fetch status of linked pull requests
+  O          Open the ticket's pull request in the browser
+  U          Create a GitHub issue from the ticket (stored in issue)
+  @          Email the ticket or a board summary (smtp in the user config)
+  y / Y      Copy the ticket's markdown / JSON to the clipboard
   Enter      View ticket details
+  .          Jump to the most recently changed ticket
 
 Agent Integration
   p          Copy AI agent prompt for selected ticket to clipboard
   P          Copy AI agent prompt for all todo tickets to clipboard
+             (or for the selected tickets, in selection order)
+  V          Copy prompt asking an agent to verify the acceptance criteria
+  a          Dispatch ticket (or the selected tickets) to the agent command
+             (transcripts are saved; press L in the ticket view to browse them)
+  A          Dispatch all todo tickets to the agent command
+  Q          Agent queue: queued, running and finished agent runs
+  R          Review queue: accept or reopen completed agent work
+  a / x      In the review column: approve (move to done) or reject
+             (back to todo with a comment)
+  o          Reopen a done ticket with a comment
+  S          Split ticket into child tickets
+  M          Merge the two selected tickets (second into first)
+  E          Epic tree: children grouped under parents, filter board by epic
+  Space      Toggle ticket selection (Esc clears the selection)
 
 Other
-  /          Search tickets
+  B          Switch to another board
+  W          Toggle workspace mode (tickets from several boards)
+  F          Toggle focus mode (collapse backlog and done columns)
+  s          Show board statistics (lead/cycle time)
+  T          Today: tickets due or updated today, by priority
+  z          Snooze ticket until a date (tomorrow, 3d, fri, 2025-03-14)
+  Z          Show or hide snoozed tickets
+  *          Star or unstar ticket
+  '          Starred tickets: Enter jumps to one
+  #          Rename or merge a tag across every ticket
+  /          Search tickets (title, tags, content)
+  Ctrl+P / : Command palette: fuzzy-search and run any action
+  Ctrl+O     Go to ticket: fuzzy-find any ticket by title or tag and open it
+  ,          Leader key: shows the keys that can follow, e.g. , m d moves
+             the ticket to done, , c p copies its prompt (leader_key)
   r          Refresh board
   ?          Toggle this help
   q          Quit
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/demo"
+)
+
+// benchBoardSizes are the synthetic board sizes (tickets) benchmarked.
+var benchBoardSizes = []int{100, 1000}
+
+// newBenchModel opens a synthetic board of n tickets across five columns.
+func newBenchModel(b *testing.B, n int) *Model {
+	b.Helper()
+	b.Setenv("XDG_STATE_HOME", b.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = b.TempDir()
+	cfg.Columns = demo.SyntheticColumns(5)
+	if err := cfg.EnsureDirectories(); err != nil {
+		b.Fatal(err)
+	}
+	if err := demo.Synthetic(cfg, n, 1); err != nil {
+		b.Fatal(err)
+	}
+	m, err := New(cfg)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { m.watcher.Close() })
+	m.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	m.viewMode = ViewBoard
+	return m
+}
+
+func BenchmarkLoadColumnTickets(b *testing.B) {
+	for _, n := range benchBoardSizes {
+		b.Run(fmt.Sprintf("tickets=%d", n), func(b *testing.B) {
+			m := newBenchModel(b, n)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := m.loadColumnTickets("col-1"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkRenderBoard(b *testing.B) {
+	for _, n := range benchBoardSizes {
+		b.Run(fmt.Sprintf("tickets=%d", n), func(b *testing.B) {
+			m := newBenchModel(b, n)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.renderBoard()
+			}
+		})
+		// Every frame re-renders after a selection change
+		b.Run(fmt.Sprintf("tickets=%d/moving", n), func(b *testing.B) {
+			m := newBenchModel(b, n)
+			tickets := len(m.getFilteredTickets(0))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.activeTicket = i % tickets
+				m.renderBoard()
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+)
+
+// openBoardPicker scans for boards and switches to the board picker view.
+func (m *Model) openBoardPicker() {
+	m.boardChoices = m.discoverBoards()
+	m.boardIndex = 0
+	for i, dir := range m.boardChoices {
+		if dir == m.config.KanbanDir {
+			m.boardIndex = i
+		}
+	}
+	m.viewMode = ViewBoardPicker
+}
+
+// discoverBoards lists the current board, then recently opened boards, then
+// boards found under the configured board roots.
+func (m *Model) discoverBoards() []string {
+	seen := map[string]bool{m.config.KanbanDir: true}
+	boards := []string{m.config.KanbanDir}
+
+	var recent []string
+	if m.state != nil {
+		recent = m.state.ExistingRecentBoards()
+	}
+	for _, dir := range append(recent, config.DiscoverBoards(m.config.BoardRoots)...) {
+		if !seen[dir] {
+			seen[dir] = true
+			boards = append(boards, dir)
+		}
+	}
+	return boards
+}
+
+// rememberBoard records the current board in the recent boards list.
+func (m *Model) rememberBoard() {
+	if m.state == nil {
+		return
+	}
+	m.state.AddRecentBoard(m.config.KanbanDir)
+	if err := m.state.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error saving state: %v", err))
+	}
+}
+
+// handleBoardPickerKeys handles keys in the board picker view.
+func (m *Model) handleBoardPickerKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q", "B":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.boardIndex < len(m.boardChoices)-1 {
+			m.boardIndex++
+		}
+
+	case "k", "up":
+		if m.boardIndex > 0 {
+			m.boardIndex--
+		}
+
+	case "enter":
+		if m.boardIndex >= len(m.boardChoices) {
+			return nil
+		}
+		dir := m.boardChoices[m.boardIndex]
+		m.viewMode = ViewBoard
+		if dir == m.config.KanbanDir {
+			return nil
+		}
+		return m.switchBoard(dir)
+	}
+	return nil
+}
+
+// switchBoard loads the board in kanbanDir in place of the current one,
+// swapping the file watcher and columns without restarting.
+func (m *Model) switchBoard(kanbanDir string) tea.Cmd {
+	cfg, err := config.LoadBoard(kanbanDir)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error loading board: %v", err))
+		return nil
+	}
+	if err := cfg.EnsureDirectories(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+
+	w, err := newBoardWatcher(cfg)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	m.watcher.Close()
+	m.watcher = w
+
+	// Keep board roots so the picker keeps working from the new board
+	if len(cfg.BoardRoots) == 0 {
+		cfg.BoardRoots = m.config.BoardRoots
+	}
+	m.config = cfg
+	m.workspace = nil
+	m.initColumns()
+	m.activeColumn = 0
+	m.activeTicket = 0
+	m.searchQuery = ""
+	m.epicFilter = ""
+	m.lastChangedPath = ""
+	m.pendingChunks = nil
+	m.gitRemoteRepo = nil
+	m.clearMarks()
+
+	m.rememberBoard()
+	m.loadSpellChecker()
+
+	if err := m.loadAllTickets(); err != nil {
+		m.setError(fmt.Sprintf("Error loading tickets: %v", err))
+	} else {
+		m.setSuccess(fmt.Sprintf("Switched to %s", kanbanDir))
+	}
+	return tea.Batch(m.watcherCmd(), m.linkCommitsCmd())
+}
+
+// renderBoardPicker renders the list of boards to switch to.
+func (m *Model) renderBoardPicker() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Switch Board")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	for i, dir := range m.boardChoices {
+		cursor := "  "
+		if i == m.boardIndex {
+			cursor = m.styles.HelpKey.Render("▶ ")
+		}
+		line := dir
+		if dir == m.config.KanbanDir {
+			line += m.styles.TicketDate.Render("  (current)")
+		}
+		b.WriteString(cursor + line + "\n")
+	}
+	if len(m.config.BoardRoots) == 0 {
+		b.WriteString("\n")
+		b.WriteString(m.styles.HelpDesc.Render("Recently opened boards are listed; add board_roots to your config to find more."))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "open"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/git"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// branchData is the data available to branch_pattern.
+type branchData struct {
+	Slug      string // filename without .md, e.g. 2025-01-15-fix-auth
+	TitleSlug string // slugified title, e.g. fix-auth
+	Date      string // creation date, e.g. 2025-01-15
+	Column    string // column directory
+}
+
+// invalidBranchChars are replaced when naming branches.
+var invalidBranchChars = strings.NewReplacer(" ", "-", "~", "-", "^", "-", ":", "-",
+	"?", "-", "*", "-", "[", "-", "\\", "-", "..", "-")
+
+// branchName renders the configured branch pattern for ticket.
+func (m *Model) branchName(ticket *models.Ticket) (string, error) {
+	pattern := m.config.BranchPattern
+	if pattern == "" {
+		pattern = config.DefaultBranchPattern
+	}
+	tmpl, err := template.New("branch").Parse(pattern)
+	if err != nil {
+		return "", fmt.Errorf("parsing branch_pattern: %w", err)
+	}
+
+	var b strings.Builder
+	err = tmpl.Execute(&b, branchData{
+		Slug:      ticket.Slug(),
+		TitleSlug: ticket.TitleSlug(),
+		Date:      ticket.Created.Format("2006-01-02"),
+		Column:    ticket.Column,
+	})
+	if err != nil {
+		return "", fmt.Errorf("rendering branch_pattern: %w", err)
+	}
+	return strings.Trim(invalidBranchChars.Replace(strings.TrimSpace(b.String())), "/-."), nil
+}
+
+// startBranch asks to create and check out a git branch for the selected ticket.
+func (m *Model) startBranch() {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return
+	}
+
+	name := ticket.Branch
+	if name == "" {
+		var err error
+		if name, err = m.branchName(ticket); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return
+		}
+	}
+
+	m.confirm(fmt.Sprintf("Check out branch %s?", name), func() tea.Cmd {
+		m.checkoutTicketBranch(ticket, name)
+		return nil
+	})
+}
+
+// checkoutTicketBranch creates (if needed) and checks out branch in the
+// ticket's project, recording it in the ticket.
+func (m *Model) checkoutTicketBranch(ticket *models.Ticket, branch string) {
+	projectDir := filepath.Dir(ticket.KanbanDir())
+	created, err := git.Checkout(projectDir, branch)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	if ticket.Branch != branch {
+		ticket.Branch = branch
+		if err := ticket.Save(); err != nil {
+			m.setError(fmt.Sprintf("Error saving ticket: %v", err))
+			return
+		}
+	}
+
+	if created {
+		m.setSuccess("Created and checked out " + branch)
+	} else {
+		m.setSuccess("Checked out " + branch)
+	}
+}
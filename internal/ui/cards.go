@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// cardHash hashes everything a rendered card depends on, so a card is only
+// re-rendered when its ticket or its presentation changed.
+func (m *Model) cardHash(ticket *models.Ticket, width int, isSelected bool) uint64 {
+	h := fnv.New64a()
+	done, total := m.epicProgress(ticket)
+	status := m.prStatuses[ticket.PR]
+	fmt.Fprintf(h, "card\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%d/%d\x00%d\x00%d\x00%t\x00%t\x00%t\x00%s\x00%t",
+		ticket.FilePath, ticket.Title, strings.Join(ticket.Tags, ","), m.formatDate(ticket.Updated),
+		ticket.Color, ticket.PR, status.State, status.Checks, done, total,
+		m.markIndex(ticket), width, isSelected, len(m.workspace) > 0, ticket.SnoozedAt(time.Now()), ticket.SnoozedUntil, m.isStarred(ticket))
+	return h.Sum64()
+}
+
+// renderCard returns the rendered card for a ticket, re-rendering it only
+// when something it depends on changed.
+func (m *Model) renderCard(ticket *models.Ticket, width int, isSelected bool) string {
+	return m.cachedRender(m.cardHash(ticket, width, isSelected), func() string {
+		return m.renderTicket(ticket, width, isSelected)
+	})
+}
+
+// styleColumn wraps a column's content in its border. Wrapping every line
+// is the most expensive step of a frame, so the result is reused while the
+// content and size are unchanged.
+func (m *Model) styleColumn(content string, width, height int, isActive bool) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "column\x00%d\x00%d\x00%t\x00", width, height, isActive)
+	h.Write([]byte(content))
+
+	return m.cachedRender(h.Sum64(), func() string {
+		style := m.styles.Column
+		if isActive {
+			style = m.styles.ColumnActive
+		}
+		return style.Width(width).Height(height).Render(content)
+	})
+}
+
+// cachedRender returns the rendering stored under key in this or the last
+// frame, calling render on a miss. Only renderings used in the current
+// frame survive finishRenderFrame, so the cache stays the size of what is
+// on screen however large the board is.
+func (m *Model) cachedRender(key uint64, render func() string) string {
+	s, ok := m.nextRenders[key]
+	if !ok {
+		if s, ok = m.renders[key]; !ok {
+			s = render()
+		}
+	}
+	if m.nextRenders == nil {
+		m.nextRenders = make(map[uint64]string)
+	}
+	m.nextRenders[key] = s
+	return s
+}
+
+// finishRenderFrame keeps the renderings used this frame for the next one.
+func (m *Model) finishRenderFrame() {
+	m.renders, m.nextRenders = m.nextRenders, nil
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openEntryChecklist shows the checklist of the column the ticket is being
+// moved into.
+func (m *Model) openEntryChecklist() {
+	m.checklistChecks = make([]bool, len(m.columns[m.moveTarget].Config.Checklist))
+	m.checklistIndex = 0
+	m.viewMode = ViewChecklist
+}
+
+// handleEntryChecklistKeys handles keys in the entry checklist. Enter moves
+// the ticket, recording unconfirmed items as skipped.
+func (m *Model) handleEntryChecklistKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewMoveTicket
+		if m.approving {
+			// Approvals skip the column picker
+			m.approving = false
+			m.viewMode = ViewBoard
+		}
+
+	case "j", "down", "tab":
+		if m.checklistIndex < len(m.checklistChecks)-1 {
+			m.checklistIndex++
+		}
+
+	case "k", "up", "shift+tab":
+		if m.checklistIndex > 0 {
+			m.checklistIndex--
+		}
+
+	case " ", "x":
+		m.checklistChecks[m.checklistIndex] = !m.checklistChecks[m.checklistIndex]
+
+	case "a":
+		// Confirm everything, or clear everything if all are confirmed
+		all := true
+		for _, checked := range m.checklistChecks {
+			all = all && checked
+		}
+		for i := range m.checklistChecks {
+			m.checklistChecks[i] = !all
+		}
+
+	case "enter":
+		var skipped []string
+		for i, item := range m.columns[m.moveTarget].Config.Checklist {
+			if !m.checklistChecks[i] {
+				skipped = append(skipped, item)
+			}
+		}
+		return m.moveWithReason(skipped)
+	}
+	return nil
+}
+
+// renderEntryChecklistScreen renders the entry checklist as a centered modal.
+func (m *Model) renderEntryChecklistScreen() string {
+	col := m.columns[m.moveTarget].Config
+
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Move to " + col.Label()))
+	b.WriteString("\n\n")
+
+	skipped := 0
+	for i, item := range col.Checklist {
+		box := "☐"
+		if m.checklistChecks[i] {
+			box = "☑"
+		} else {
+			skipped++
+		}
+		line := fmt.Sprintf("%s %s", box, item)
+		if i == m.checklistIndex {
+			b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if skipped > 0 {
+		b.WriteString(m.styles.StatusMessage.Render(fmt.Sprintf("%d item(s) will be recorded as skipped", skipped)))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.styles.HelpDesc.Render("Space toggle, a all, Enter move, Esc back"))
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
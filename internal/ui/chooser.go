@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// BoardChooser is a standalone fuzzy picker over a list of board directories,
+// used by "kanban open".
+type BoardChooser struct {
+	styles   Styles
+	boards   []string
+	filter   textinput.Model
+	index    int
+	chosen   string
+	width    int
+	finished bool
+}
+
+// NewBoardChooser creates a fuzzy picker over boards.
+func NewBoardChooser(boards []string) *BoardChooser {
+	fi := textinput.New()
+	fi.Placeholder = "Type to filter boards..."
+	fi.CharLimit = 100
+	fi.Width = 50
+	fi.Focus()
+
+	return &BoardChooser{
+		styles: DefaultStyles(),
+		boards: boards,
+		filter: fi,
+	}
+}
+
+// Chosen returns the selected board, or "" if the picker was cancelled.
+func (c *BoardChooser) Chosen() string {
+	return c.chosen
+}
+
+// Init initializes the picker.
+func (c *BoardChooser) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// matches returns the boards matching the current filter.
+func (c *BoardChooser) matches() []string {
+	query := c.filter.Value()
+	var out []string
+	for _, b := range c.boards {
+		if fuzzyMatch(query, b) {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// Update handles picker input.
+func (c *BoardChooser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		c.width = msg.Width
+		return c, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return c, tea.Quit
+		case "enter":
+			if matches := c.matches(); c.index < len(matches) {
+				c.chosen = matches[c.index]
+			}
+			return c, tea.Quit
+		case "down", "ctrl+n", "ctrl+j":
+			if c.index < len(c.matches())-1 {
+				c.index++
+			}
+			return c, nil
+		case "up", "ctrl+p", "ctrl+k":
+			if c.index > 0 {
+				c.index--
+			}
+			return c, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	c.filter, cmd = c.filter.Update(msg)
+	c.index = max(min(c.index, len(c.matches())-1), 0)
+	return c, cmd
+}
+
+// View renders the picker.
+func (c *BoardChooser) View() string {
+	var b strings.Builder
+
+	width := 70
+	if c.width > 0 {
+		width = max(min(c.width-8, 100), 40)
+	}
+
+	b.WriteString(c.styles.Header.Width(width).Render("  Open Board"))
+	b.WriteString("\n\n")
+	b.WriteString(c.styles.InputFocused.Width(width).Render(c.filter.View()))
+	b.WriteString("\n\n")
+
+	matches := c.matches()
+	if len(matches) == 0 {
+		b.WriteString(c.styles.HelpDesc.Render("  No matching boards"))
+		b.WriteString("\n")
+	}
+	for i, dir := range matches {
+		if i == c.index {
+			b.WriteString(c.styles.HelpKey.Render("▶ ") + dir + "\n")
+		} else {
+			b.WriteString("  " + dir + "\n")
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(c.styles.HelpBar.Width(width).Render("↑/↓ select    Enter open    Esc cancel"))
+
+	return c.styles.App.Render(b.String())
+}
+
+// fuzzyMatch reports whether the characters of query appear in s in order,
+// ignoring case and whitespace in the query.
+func fuzzyMatch(query, s string) bool {
+	target := []rune(strings.ToLower(s))
+	pos := 0
+	for _, r := range strings.ToLower(query) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		for pos < len(target) && target[pos] != r {
+			pos++
+		}
+		if pos == len(target) {
+			return false
+		}
+		pos++
+	}
+	return true
+}
@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// chord is a node in the leader key tree: a group of further chords, or an
+// action run when its key completes the sequence.
+type chord struct {
+	key      string
+	desc     string
+	run      func(m *Model) tea.Cmd
+	children []chord
+}
+
+// leaderChords returns the chord tree that follows the leader key.
+func (m *Model) leaderChords() []chord {
+	return []chord{
+		{key: "m", desc: "+move", children: m.moveChords()},
+		{key: "c", desc: "+copy", children: []chord{
+			{key: "p", desc: "ticket prompt", run: (*Model).copySelectedTicketPrompt},
+			{key: "P", desc: "todo/selected prompt", run: (*Model).copyTicketsPrompt},
+			{key: "v", desc: "verify prompt", run: (*Model).copyVerifyPrompt},
+			{key: "m", desc: "ticket markdown", run: (*Model).copyTicketMarkdown},
+			{key: "j", desc: "ticket JSON", run: (*Model).copyTicketJSON},
+		}},
+		{key: "a", desc: "+agent", children: []chord{
+			{key: "a", desc: "dispatch ticket", run: (*Model).dispatchSelected},
+			{key: "A", desc: "dispatch all todo", run: (*Model).dispatchTodo},
+			{key: "q", desc: "queue", run: func(m *Model) tea.Cmd { m.openQueue(); return nil }},
+			{key: "r", desc: "review queue", run: do((*Model).openReviewQueue)},
+			{key: "i", desc: "regenerate AGENT.md", run: do((*Model).regenerateAgentMd)},
+		}},
+		{key: "g", desc: "+git", children: []chord{
+			{key: "b", desc: "branch", run: do((*Model).startBranch)},
+			{key: "w", desc: "worktree", run: do((*Model).toggleWorktree)},
+			{key: "s", desc: "PR statuses", run: (*Model).refreshPRStatuses},
+			{key: "o", desc: "open PR", run: do((*Model).openSelectedPR)},
+			{key: "i", desc: "create issue", run: (*Model).createIssue},
+		}},
+		{key: "t", desc: "+ticket", children: []chord{
+			{key: "n", desc: "new", run: (*Model).newTicket},
+			{key: "v", desc: "new from clipboard", run: (*Model).pasteNewTicket},
+			{key: "t", desc: "new from template", run: do((*Model).openTemplates)},
+			{key: "e", desc: "edit", run: (*Model).editSelected},
+			{key: "E", desc: "external editor", run: (*Model).openExternalEditor},
+			{key: "i", desc: "quick edit", run: (*Model).startQuickEdit},
+			{key: "d", desc: "delete", run: do((*Model).confirmDeleteSelected)},
+			{key: "c", desc: "color", run: do((*Model).openColorPicker)},
+			{key: "s", desc: "split", run: (*Model).startSplit},
+			{key: "m", desc: "merge selected", run: (*Model).startMerge},
+			{key: "o", desc: "reopen", run: (*Model).reopenSelected},
+			{key: "z", desc: "snooze", run: (*Model).startSnooze},
+			{key: "*", desc: "star", run: do((*Model).starSelected)},
+			{key: "r", desc: "find and replace", run: (*Model).startReplace},
+			{key: "@", desc: "email", run: (*Model).openEmail},
+		}},
+		{key: "v", desc: "+view", children: []chord{
+			{key: "s", desc: "stats", run: do((*Model).openStats)},
+			{key: "t", desc: "today", run: do((*Model).openToday)},
+			{key: "z", desc: "snoozed tickets", run: do((*Model).toggleShowSnoozed)},
+			{key: "'", desc: "starred", run: do((*Model).openStarred)},
+			{key: "e", desc: "epics", run: do((*Model).openEpics)},
+			{key: "b", desc: "boards", run: do((*Model).openBoardPicker)},
+			{key: "w", desc: "workspace", run: (*Model).toggleWorkspace},
+			{key: "f", desc: "focus mode", run: do((*Model).toggleFocusMode)},
+			{key: "/", desc: "search", run: (*Model).openSearch},
+			{key: "c", desc: "sync conflicts", run: do((*Model).openConflicts)},
+			{key: "o", desc: "go to ticket", run: (*Model).openSwitcher},
+		}},
+		{key: "x", desc: "plugins", run: do((*Model).openPlugins)},
+		{key: "p", desc: "command palette", run: (*Model).openPalette},
+	}
+}
+
+// moveChords moves the selected ticket straight to a column: by number, or
+// by the first letter of its directory not taken by a later column (so
+// "d" is done rather than doing).
+func (m *Model) moveChords() []chord {
+	letters := make(map[int]string)
+	used := make(map[string]bool)
+	for i := len(m.columns) - 1; i >= 0; i-- {
+		for _, r := range m.columns[i].Config.Dir {
+			key := string(unicode.ToLower(r))
+			if unicode.IsLetter(r) && !used[key] {
+				used[key] = true
+				letters[i] = key
+				break
+			}
+		}
+	}
+
+	var chords []chord
+	for i, col := range m.columns {
+		target := i
+		run := func(m *Model) tea.Cmd { return m.moveSelectedTo(target) }
+		if i < 9 {
+			chords = append(chords, chord{key: fmt.Sprint(i + 1), desc: col.Config.Name, run: run})
+		}
+		if key, ok := letters[i]; ok {
+			chords = append(chords, chord{key: key, desc: col.Config.Name, run: run})
+		}
+	}
+	return chords
+}
+
+// moveSelectedTo moves the selected ticket to column index target, skipping
+// the move modal.
+func (m *Model) moveSelectedTo(target int) tea.Cmd {
+	if !m.hasSelectedTicket() || target < 0 || target >= len(m.columns) {
+		return nil
+	}
+	if target == m.activeColumn {
+		m.setStatus("Already in " + m.columns[target].Config.Name)
+		return nil
+	}
+	m.moveTarget = target
+	return m.confirmMove()
+}
+
+// startChord begins a leader key sequence.
+func (m *Model) startChord() {
+	m.chordKeys = []string{}
+}
+
+// chordLevel returns the chords available after the keys typed so far.
+func (m *Model) chordLevel() []chord {
+	level := m.leaderChords()
+	for _, key := range m.chordKeys {
+		next := level
+		level = nil
+		for _, c := range next {
+			if c.key == key {
+				level = c.children
+				break
+			}
+		}
+	}
+	return level
+}
+
+// handleChordKey advances the leader sequence, running the action it
+// completes. Esc or an unbound key cancels it.
+func (m *Model) handleChordKey(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	if key == "esc" {
+		m.chordKeys = nil
+		return nil
+	}
+	for _, c := range m.chordLevel() {
+		if c.key != key {
+			continue
+		}
+		if c.run != nil {
+			m.chordKeys = nil
+			return c.run(m)
+		}
+		m.chordKeys = append(m.chordKeys, key)
+		return nil
+	}
+	m.setStatus(fmt.Sprintf("No binding for %s", m.chordPrefix()+" "+key))
+	m.chordKeys = nil
+	return nil
+}
+
+// chordPrefix renders the sequence typed so far, e.g. ", m".
+func (m *Model) chordPrefix() string {
+	return strings.Join(append([]string{m.leaderKey()}, m.chordKeys...), " ")
+}
+
+// leaderKey returns the configured leader key (default ",").
+func (m *Model) leaderKey() string {
+	if m.config.LeaderKey == "" {
+		return ","
+	}
+	return m.config.LeaderKey
+}
+
+// renderChordHints renders the which-key popup listing the next keys.
+func (m *Model) renderChordHints() string {
+	var parts []string
+	for _, c := range m.chordLevel() {
+		desc := c.desc
+		if c.children != nil {
+			desc = m.styles.ModalTitle.Render(desc)
+		} else {
+			desc = m.styles.HelpDesc.Render(desc)
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(c.key), desc))
+	}
+	title := m.styles.HelpKey.Render(m.chordPrefix()) + m.styles.HelpDesc.Render("  (Esc cancel)")
+	return m.styles.HelpBar.Width(m.width - 4).Render(title + "\n" + strings.Join(parts, "   "))
+}
@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+)
+
+// codeBlock is a fenced code block of ticket content.
+type codeBlock struct {
+	lang string
+	code string
+}
+
+// parseCodeBlocks returns the fenced (``` or ~~~) code blocks of markdown
+// content. A block left open runs to the end of the content.
+func parseCodeBlocks(content string) []codeBlock {
+	var blocks []codeBlock
+	var fences fenceScanner
+	var current codeBlock
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		switch fences.scan(line) {
+		case openFence:
+			current = codeBlock{}
+			// The info string starts with the language, e.g. ```go title="main.go"
+			if info := strings.Fields(fences.info); len(info) > 0 {
+				current.lang = info[0]
+			}
+			lines = nil
+		case insideFence:
+			lines = append(lines, line)
+		case closeFence:
+			current.code = strings.Join(lines, "\n")
+			blocks = append(blocks, current)
+		}
+	}
+	if fences.fence != "" {
+		current.code = strings.Join(lines, "\n")
+		blocks = append(blocks, current)
+	}
+	return blocks
+}
+
+// lineCount describes the length of a code block: "1 line", "12 lines".
+func lineCount(code string) string {
+	if n := strings.Count(code, "\n") + 1; n != 1 {
+		return fmt.Sprintf("%d lines", n)
+	}
+	return "1 line"
+}
+
+// openCodeBlocks copies the viewed ticket's code block, or lists them to
+// choose from when there are several.
+func (m *Model) openCodeBlocks() {
+	if m.editingTicket == nil {
+		return
+	}
+	// The saved content rather than the editor's, which expands tabs
+	blocks := parseCodeBlocks(m.editingTicket.Content)
+	switch len(blocks) {
+	case 0:
+		m.setStatus("No code blocks in this ticket")
+	case 1:
+		m.copyCodeBlock(blocks[0])
+	default:
+		m.codeBlocks = blocks
+		m.codeBlockIndex = 0
+		m.viewMode = ViewCodeBlocks
+	}
+}
+
+// copyCodeBlock copies a code block, without its fences, to the clipboard.
+func (m *Model) copyCodeBlock(block codeBlock) {
+	if err := m.copyToClipboard(block.code); err != nil {
+		m.setError(fmt.Sprintf("Clipboard error: %v", err))
+		return
+	}
+	if block.lang != "" {
+		m.setSuccess(fmt.Sprintf("Copied %s code block (%s)", block.lang, lineCount(block.code)))
+	} else {
+		m.setSuccess(fmt.Sprintf("Copied code block (%s)", lineCount(block.code)))
+	}
+}
+
+// handleCodeBlocksKeys handles keys in the code block list.
+func (m *Model) handleCodeBlocksKeys(msg tea.KeyMsg) tea.Cmd {
+	switch key := msg.String(); key {
+	case "esc", "q":
+		m.viewMode = ViewTicket
+
+	case "j", "down":
+		if m.codeBlockIndex < len(m.codeBlocks)-1 {
+			m.codeBlockIndex++
+		}
+
+	case "k", "up":
+		if m.codeBlockIndex > 0 {
+			m.codeBlockIndex--
+		}
+
+	case "enter":
+		m.copyCodeBlock(m.codeBlocks[m.codeBlockIndex])
+		m.viewMode = ViewTicket
+
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		if i := int(key[0] - '1'); i < len(m.codeBlocks) {
+			m.copyCodeBlock(m.codeBlocks[i])
+			m.viewMode = ViewTicket
+		}
+	}
+	return nil
+}
+
+// renderCodeBlocks renders the code blocks of the viewed ticket, each
+// with its language and first line.
+func (m *Model) renderCodeBlocks() string {
+	var b strings.Builder
+	contentWidth := m.editorWidth()
+
+	b.WriteString(m.styles.Header.Width(contentWidth).Render("  Copy Code Block"))
+	b.WriteString("\n\n")
+	if m.editingTicket != nil {
+		b.WriteString(m.styles.HelpDesc.Render("Ticket: "))
+		b.WriteString(m.styles.TicketTitle.Render(m.editingTicket.Title))
+		b.WriteString("\n\n")
+	}
+
+	for i, block := range m.codeBlocks {
+		key := " "
+		if i < 9 {
+			key = fmt.Sprintf("%d", i+1)
+		}
+		lang := block.lang
+		if lang == "" {
+			lang = "text"
+		}
+		first, _, _ := strings.Cut(strings.TrimSpace(block.code), "\n")
+		info := fmt.Sprintf("%s · %s  ", lang, lineCount(block.code))
+		line := info + runewidth.Truncate(first, max(contentWidth-runewidth.StringWidth(info)-8, 10), "…")
+		if i == m.codeBlockIndex {
+			b.WriteString(m.styles.HelpKey.Render(key) + " " + m.styles.ButtonActive.Render(line))
+		} else {
+			b.WriteString(m.styles.HelpKey.Render(key) + "   " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("j/k select, Enter or 1-9 copy, Esc back"))
+	return m.styles.App.Render(b.String())
+}
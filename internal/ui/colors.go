@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// ticketPalette lists the colors offered by the ticket color picker. The
+// first entry clears the override.
+var ticketPalette = []struct {
+	name  string
+	color lipgloss.Color
+}{
+	{"none", ""},
+	{"red", GruvboxRed},
+	{"orange", GruvboxOrange},
+	{"yellow", GruvboxYellow},
+	{"green", GruvboxGreen},
+	{"aqua", GruvboxAqua},
+	{"blue", GruvboxBlue},
+	{"purple", GruvboxPurple},
+	{"gray", GruvboxGray},
+}
+
+// openColorPicker shows the palette for the selected ticket.
+func (m *Model) openColorPicker() {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return
+	}
+	m.colorIndex = 0
+	for i, p := range ticketPalette {
+		if string(p.color) == ticket.Color {
+			m.colorIndex = i
+		}
+	}
+	m.viewMode = ViewColorPicker
+}
+
+// handleColorPickerKeys handles keys in the color picker.
+func (m *Model) handleColorPickerKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewBoard
+
+	case "h", "left":
+		if m.colorIndex > 0 {
+			m.colorIndex--
+		}
+
+	case "l", "right":
+		if m.colorIndex < len(ticketPalette)-1 {
+			m.colorIndex++
+		}
+
+	case "enter":
+		m.viewMode = ViewBoard
+		ticket := m.getSelectedTicket()
+		if ticket == nil {
+			return nil
+		}
+		ticket.Color = string(ticketPalette[m.colorIndex].color)
+		if err := ticket.Save(); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		m.loadAllTickets()
+		m.selectTicketByPath(ticket.FilePath)
+		m.setStatus(fmt.Sprintf("Color: %s", ticketPalette[m.colorIndex].name))
+	}
+	return nil
+}
+
+// renderColorPicker renders the palette picker as a centered modal.
+func (m *Model) renderColorPicker() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Ticket Color"))
+	b.WriteString("\n\n")
+
+	for i, p := range ticketPalette {
+		style := m.styles.Button
+		if p.color != "" {
+			style = style.Copy().Foreground(p.color)
+		}
+		if i == m.colorIndex {
+			style = m.styles.ButtonActive
+			if p.color != "" {
+				style = style.Copy().Background(p.color)
+			}
+		}
+		b.WriteString(style.Padding(0, 1).MarginRight(0).Render(p.name))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("h/l to select, Enter to apply, Esc to cancel"))
+
+	modal := m.styles.Modal.Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// tagColor resolves a tag's configured color, accepting the names of the
+// ticket palette as well as hex colors.
+func (m *Model) tagColor(tag string) lipgloss.Color {
+	color := m.config.TagColor(tag)
+	for _, p := range ticketPalette[1:] {
+		if strings.EqualFold(color, p.name) {
+			return p.color
+		}
+	}
+	return lipgloss.Color(color)
+}
+
+// renderTags renders tags within width cells. With tag_colors configured,
+// each tag is a chip in its own color (tags without one keep the plain tag
+// style) and tags that don't fit are cut off with an ellipsis; otherwise
+// the tags are listed comma-separated.
+func (m *Model) renderTags(tags []string, width int) string {
+	if len(m.config.TagColors) == 0 {
+		return m.styles.TicketTags.Render(runewidth.Truncate(strings.Join(tags, ", "), width, "…"))
+	}
+
+	var b strings.Builder
+	used := 0
+	for i, tag := range tags {
+		chip := m.styles.TicketTags.Render(tag)
+		if color := m.tagColor(tag); color != "" {
+			chip = lipgloss.NewStyle().Foreground(GruvboxBg0).Background(color).Padding(0, 1).Render(tag)
+		}
+		sep := 0
+		if i > 0 {
+			sep = 1
+		}
+		// Leave room for the ellipsis unless this is the last tag
+		room := width - used - sep
+		if i < len(tags)-1 {
+			room -= 2
+		}
+		if lipgloss.Width(chip) > room {
+			if used+sep < width {
+				b.WriteString(strings.Repeat(" ", sep) + m.styles.TicketTags.Render("…"))
+			}
+			break
+		}
+		b.WriteString(strings.Repeat(" ", sep) + chip)
+		used += sep + lipgloss.Width(chip)
+	}
+	return b.String()
+}
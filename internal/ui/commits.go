@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/git"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Commit linking scans this many recent commits; linking is idempotent, so
+// rescanning old commits is harmless.
+const (
+	commitScanDepth = 200
+	shortHashLen    = 12
+)
+
+// commitsMsg delivers the recent commits of a board's project.
+type commitsMsg struct {
+	kanbanDir string
+	commits   []git.Commit
+	err       error
+}
+
+// linkCommitsCmd reads recent commits of every open board's project when git
+// integration is enabled.
+func (m *Model) linkCommitsCmd() tea.Cmd {
+	if !m.config.GitIntegration {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, kanbanDir := range m.boardDirs() {
+		kanbanDir := kanbanDir
+		cmds = append(cmds, func() tea.Msg {
+			commits, err := git.Log(filepath.Dir(kanbanDir), commitScanDepth)
+			return commitsMsg{kanbanDir: kanbanDir, commits: commits, err: err}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleCommits appends commits that mention a ticket's slug to its commits
+// list.
+func (m *Model) handleCommits(msg commitsMsg) {
+	if msg.err != nil {
+		m.setError(fmt.Sprintf("Git: %v", msg.err))
+		return
+	}
+
+	linked := 0
+	for _, ticket := range m.allTickets() {
+		if ticket.KanbanDir() != msg.kanbanDir {
+			continue
+		}
+		slug := ticket.Slug()
+
+		changed := false
+		// git log lists newest first; link oldest first so the list reads in order
+		for i := len(msg.commits) - 1; i >= 0; i-- {
+			commit := msg.commits[i]
+			hash := commit.Hash[:min(len(commit.Hash), shortHashLen)]
+			if !mentionsSlug(commit.Message, slug) || slices.Contains(ticket.Commits, hash) {
+				continue
+			}
+			ticket.Commits = append(ticket.Commits, hash)
+			changed = true
+			linked++
+		}
+		if changed {
+			if err := ticket.WriteFile(); err != nil {
+				m.setError(fmt.Sprintf("Error saving ticket: %v", err))
+				return
+			}
+		}
+	}
+
+	if linked > 0 {
+		m.setSuccess(fmt.Sprintf("Linked %d commits", linked))
+	}
+}
+
+// mentionsSlug reports whether message contains slug as a whole token, so
+// "2025-01-15-fix-auth" doesn't match "2025-01-15-fix-auth-flow".
+func mentionsSlug(message, slug string) bool {
+	if slug == "" {
+		return false
+	}
+	for i := 0; ; {
+		j := strings.Index(message[i:], slug)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(slug)
+		before, _ := utf8.DecodeLastRuneInString(message[:start])
+		after, _ := utf8.DecodeRuneInString(message[end:])
+		if !isSlugRune(before) && !isSlugRune(after) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+// isSlugRune reports whether r can be part of a slug; utf8.RuneError (no
+// rune at the edge of the message) cannot.
+func isSlugRune(r rune) bool {
+	return r == '-' || r == '_' || (r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+}
+
+// renderGitLinks renders a ticket's branch, worktree, pull request, issue and linked commits
+// for the ticket view.
+func (m *Model) renderGitLinks(ticket *models.Ticket) string {
+	var parts []string
+	if ticket.Branch != "" {
+		parts = append(parts, m.styles.HelpDesc.Render("Branch: ")+m.styles.TicketTags.Render(ticket.Branch))
+	}
+	if ticket.Worktree != "" {
+		parts = append(parts, m.styles.HelpDesc.Render("Worktree: ")+m.styles.TicketTags.Render(ticket.Worktree))
+	}
+	if ticket.PR != "" {
+		parts = append(parts, m.styles.HelpDesc.Render("PR: ")+m.renderPRBadge(ticket))
+	}
+	if ticket.Issue != "" {
+		parts = append(parts, m.styles.HelpDesc.Render("Issue: ")+m.styles.TicketTags.Render(ticket.Issue))
+	}
+	if len(ticket.Commits) > 0 {
+		short := make([]string, len(ticket.Commits))
+		for i, hash := range ticket.Commits {
+			short[i] = hash[:min(len(hash), 7)]
+		}
+		parts = append(parts, m.styles.HelpDesc.Render("Commits: ")+m.styles.TicketTags.Render(strings.Join(short, ", ")))
+	}
+	return strings.Join(parts, "\n")
+}
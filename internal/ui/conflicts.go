@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/conflicts"
+)
+
+// findConflicts looks for sync conflict copies in the columns, announcing
+// new ones, e.g. after a sync delivered them through the watcher.
+func (m *Model) findConflicts() {
+	found, err := conflicts.Find(m.config)
+	if err != nil {
+		slog.Warn("finding sync conflicts", "err", err)
+		return
+	}
+	if len(found) > len(m.syncConflicts) {
+		m.setStatus(fmt.Sprintf("%d sync conflicts — press ! to resolve", len(found)))
+	}
+	m.syncConflicts = found
+	if m.conflictIndex >= len(found) {
+		m.conflictIndex = max(len(found)-1, 0)
+	}
+}
+
+// openConflicts lists the sync conflict copies to resolve.
+func (m *Model) openConflicts() {
+	if len(m.syncConflicts) == 0 {
+		m.setStatus("No sync conflicts")
+		return
+	}
+	m.conflictIndex = 0
+	m.viewMode = ViewConflicts
+}
+
+// handleConflictsKeys handles keys in the conflicts view: o keeps the
+// original, c keeps the conflicted copy, m merges the two.
+func (m *Model) handleConflictsKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.conflictIndex < len(m.syncConflicts)-1 {
+			m.conflictIndex++
+		}
+
+	case "k", "up":
+		if m.conflictIndex > 0 {
+			m.conflictIndex--
+		}
+
+	case "enter":
+		m.compareConflict(m.syncConflicts[m.conflictIndex])
+
+	case "o":
+		c := m.syncConflicts[m.conflictIndex]
+		m.resolveConflict(c.KeepOriginal(m.config.KanbanDir), "Kept "+filepath.Base(c.Original))
+
+	case "c":
+		c := m.syncConflicts[m.conflictIndex]
+		m.resolveConflict(c.KeepCopy(m.config.KanbanDir), "Kept the conflicted copy of "+filepath.Base(c.Original))
+
+	case "m":
+		c := m.syncConflicts[m.conflictIndex]
+		m.resolveConflict(c.Merge(m.config.KanbanDir, time.Now()), "Merged into "+filepath.Base(c.Original))
+	}
+	return nil
+}
+
+// resolveConflict reports a resolution and reloads the board, returning to
+// it once no conflicts are left.
+func (m *Model) resolveConflict(err error, done string) {
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	m.loadAllTickets()
+	m.setSuccess(done + " (replaced file moved to trash)")
+	if len(m.syncConflicts) == 0 {
+		m.viewMode = ViewBoard
+	}
+}
+
+// compareConflict shows the original and the conflicted copy one after the
+// other in the pager.
+func (m *Model) compareConflict(c conflicts.Conflict) {
+	var b strings.Builder
+	for _, f := range []struct{ label, path string }{
+		{"Original", c.Original},
+		{"Conflicted copy", c.Copy},
+	} {
+		b.WriteString(fmt.Sprintf("──── %s: %s ────\n\n", f.label, filepath.Base(f.path)))
+		text, err := conflicts.Read(f.path)
+		if err != nil {
+			text = fmt.Sprintf("(%v)", err)
+		}
+		b.WriteString(strings.TrimRight(text, "\n"))
+		b.WriteString("\n\n")
+	}
+	m.openPager("Sync conflict  ·  "+filepath.Base(c.Original), b.String(), false)
+}
+
+// renderConflicts renders the list of conflict copies.
+func (m *Model) renderConflicts() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render(
+		fmt.Sprintf("  Sync Conflicts (%d)", len(m.syncConflicts)))
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	for i, c := range m.syncConflicts {
+		line := filepath.Join(c.Column, filepath.Base(c.Original))
+		detail := filepath.Base(c.Copy)
+		if c.Orphaned {
+			detail += " (original missing)"
+		}
+		line += "  " + m.styles.HelpDesc.Render(detail)
+		if i == m.conflictIndex {
+			b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "compare"},
+		{"o", "keep original"},
+		{"c", "keep copy"},
+		{"m", "merge"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// renderCriteria renders a ticket's acceptance criteria as a checklist with
+// a done count, or "" when it has none.
+func (m *Model) renderCriteria(ticket *models.Ticket) string {
+	items := ticket.AcceptanceCriteria()
+	if len(items) == 0 {
+		return ""
+	}
+
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render(fmt.Sprintf("Acceptance Criteria (%d/%d)", done, len(items))))
+	for _, item := range items {
+		b.WriteString("\n")
+		if item.Done {
+			b.WriteString(m.styles.TicketTags.Render("☑ ") + m.styles.TicketDate.Render(item.Text))
+		} else {
+			b.WriteString(m.styles.HelpDesc.Render("☐ ") + item.Text)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/user/kanban-tui/internal/config"
+)
+
+// formatDate renders a timestamp using the configured date format, or as a
+// relative time ("3d ago") when relative dates are enabled.
+func (m *Model) formatDate(t time.Time) string {
+	if m.config.RelativeDates {
+		return formatRelative(t, time.Now())
+	}
+	layout := m.config.DateFormat
+	if layout == "" {
+		layout = config.DefaultDateFormat
+	}
+	return t.Format(layout)
+}
+
+// formatRelative renders t relative to now, e.g. "just now", "2h ago", "3w ago".
+func formatRelative(t, now time.Time) string {
+	d := now.Sub(t)
+	suffix := "ago"
+	if d < 0 {
+		d = -d
+		suffix = "from now"
+	}
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm %s", int(d.Minutes()), suffix)
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh %s", int(d.Hours()), suffix)
+	case d < 14*24*time.Hour:
+		return fmt.Sprintf("%dd %s", int(d.Hours()/24), suffix)
+	case d < 60*24*time.Hour:
+		return fmt.Sprintf("%dw %s", int(d.Hours()/(24*7)), suffix)
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("%dmo %s", int(d.Hours()/(24*30)), suffix)
+	default:
+		return fmt.Sprintf("%dy %s", int(d.Hours()/(24*365)), suffix)
+	}
+}
+
+// formatAge renders a duration as a single compact unit, e.g. "6d" or "3w".
+func formatAge(d time.Duration) string {
+	now := time.Now()
+	age := strings.TrimSuffix(formatRelative(now.Add(-d), now), " ago")
+	if age == "just now" {
+		return "<1m"
+	}
+	return age
+}
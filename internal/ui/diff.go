@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/user/kanban-tui/internal/git"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// openTicketDiff shows the code changes associated with the viewed ticket.
+func (m *Model) openTicketDiff() {
+	ticket := m.editingTicket
+	if ticket == nil {
+		return
+	}
+	diff, err := ticketDiff(ticket)
+	if err != nil {
+		m.setError(fmt.Sprintf("Git error: %v", err))
+		return
+	}
+	if diff == "" {
+		m.setStatus("No changes linked to this ticket (needs a branch, worktree or linked commits)")
+		return
+	}
+	m.openPager("Changes  ·  "+ticket.ShortTitle(60), diff, true)
+}
+
+// ticketDiff collects a ticket's changes: the diff of its branch against the
+// default branch, or else its linked commits, followed by uncommitted changes
+// in its worktree.
+func ticketDiff(ticket *models.Ticket) (string, error) {
+	dir := filepath.Dir(ticket.KanbanDir())
+	var parts []string
+
+	base := git.DefaultBranch(dir)
+	if ticket.Branch != "" && base != "" && base != ticket.Branch && git.BranchExists(dir, ticket.Branch) {
+		diff, err := git.BranchDiff(dir, base, ticket.Branch)
+		if err != nil {
+			return "", err
+		}
+		if diff != "" {
+			parts = append(parts, fmt.Sprintf("# Branch %s (since %s)\n\n%s", ticket.Branch, base, diff))
+		}
+	} else {
+		for _, hash := range ticket.Commits {
+			commit, err := git.Show(dir, hash)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, commit)
+		}
+	}
+
+	if ticket.Worktree != "" {
+		if diff, err := git.WorkingDiff(ticket.Worktree); err == nil && diff != "" {
+			parts = append(parts, "# Uncommitted changes in "+ticket.Worktree+"\n\n"+diff)
+		}
+	}
+
+	return strings.Join(parts, "\n\n"), nil
+}
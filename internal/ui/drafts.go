@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// draftInterval is how often in-progress editor content is autosaved.
+const draftInterval = 5 * time.Second
+
+// draftTickMsg triggers a periodic draft autosave.
+type draftTickMsg struct{}
+
+// isEditing reports whether mode is an editable ticket editor mode.
+func isEditing(mode ViewMode) bool {
+	return mode == ViewNewTicket || mode == ViewEditTicket
+}
+
+// draftPath returns where the draft for the current editor session is kept:
+// .drafts/<ticket file> when editing, .drafts/new.md when creating.
+func (m *Model) draftPath() string {
+	if m.editingTicket != nil {
+		return filepath.Join(m.editingTicket.KanbanDir(), ".drafts", m.editingTicket.Filename())
+	}
+	return filepath.Join(m.config.KanbanDir, ".drafts", "new.md")
+}
+
+// editorSnapshot returns the editor's current title, tags and content as one
+// comparable string.
+func (m *Model) editorSnapshot() string {
+	return strings.Join([]string{
+		strings.TrimSpace(m.titleInput.Value()),
+		strings.Join(m.parseTagsInput(), ","),
+		strings.TrimSpace(m.contentInput.Value()),
+	}, "\x00")
+}
+
+// ticketSnapshot returns a ticket's title, tags and content in the same form
+// as editorSnapshot.
+func ticketSnapshot(t *models.Ticket) string {
+	return strings.Join([]string{t.Title, strings.Join(t.Tags, ","), t.Content}, "\x00")
+}
+
+// draftTick schedules the next autosave.
+func draftTick() tea.Cmd {
+	return tea.Tick(draftInterval, func(time.Time) tea.Msg { return draftTickMsg{} })
+}
+
+// startDraftSession runs when the editor opens: it offers any saved draft
+// for restore and starts the autosave timer.
+func (m *Model) startDraftSession() tea.Cmd {
+	m.pendingDraft = nil
+	m.draftSaved = m.editorSnapshot()
+
+	if draft, err := models.ParseTicket(m.draftPath()); err == nil {
+		if ticketSnapshot(draft) != m.editorSnapshot() {
+			m.pendingDraft = draft
+		}
+	}
+
+	if m.draftTicking {
+		return nil
+	}
+	m.draftTicking = true
+	return draftTick()
+}
+
+// handleDraftTick autosaves while the editor is open and stops the timer
+// once it closes.
+func (m *Model) handleDraftTick() tea.Cmd {
+	if !isEditing(m.viewMode) {
+		m.draftTicking = false
+		return nil
+	}
+	m.saveDraft()
+	return draftTick()
+}
+
+// saveDraft writes the editor content to the draft file if it changed since
+// the last save. Nothing is written while a recovered draft awaits a decision,
+// so it cannot be overwritten.
+func (m *Model) saveDraft() {
+	if m.pendingDraft != nil {
+		return
+	}
+	snapshot := m.editorSnapshot()
+	if snapshot == m.draftSaved {
+		return
+	}
+
+	draft := &models.Ticket{
+		Title:    strings.TrimSpace(m.titleInput.Value()),
+		Tags:     m.parseTagsInput(),
+		Content:  strings.TrimSpace(m.contentInput.Value()),
+		Created:  time.Now(),
+		Updated:  time.Now(),
+		FilePath: m.draftPath(),
+	}
+	if err := os.MkdirAll(filepath.Dir(draft.FilePath), 0755); err != nil {
+		m.setError(fmt.Sprintf("Error saving draft: %v", err))
+		return
+	}
+	if err := draft.WriteFile(); err != nil {
+		m.setError(fmt.Sprintf("Error saving draft: %v", err))
+		return
+	}
+	m.draftSaved = snapshot
+}
+
+// PreserveDraft saves the open editor's content as a draft, for when the
+// program is about to exit abnormally. It returns the draft file, or "" if no
+// editor is open or the draft could not be written.
+func (m *Model) PreserveDraft() (path string) {
+	defer func() {
+		// The model may be inconsistent after a panic
+		if recover() != nil {
+			path = ""
+		}
+	}()
+	if !isEditing(m.viewMode) {
+		return ""
+	}
+	m.saveDraft()
+	if _, err := os.Stat(m.draftPath()); err != nil {
+		return ""
+	}
+	return m.draftPath()
+}
+
+// restoreDraft loads the recovered draft into the editor.
+func (m *Model) restoreDraft() {
+	draft := m.pendingDraft
+	if draft == nil {
+		return
+	}
+	m.titleInput.SetValue(draft.Title)
+	m.tagsInput.SetValue(strings.Join(draft.Tags, ", "))
+	m.contentInput.SetValue(draft.Content)
+	m.pendingDraft = nil
+	m.draftSaved = m.editorSnapshot()
+	m.setSuccess("Draft restored")
+}
+
+// removeDraft deletes the current session's draft file.
+func (m *Model) removeDraft() {
+	m.pendingDraft = nil
+	os.Remove(m.draftPath())
+}
+
+// renderDraftBanner offers a recovered draft for restore.
+func (m *Model) renderDraftBanner() string {
+	if m.pendingDraft == nil {
+		return ""
+	}
+	return m.styles.StatusMessage.Render(fmt.Sprintf(
+		"Unsaved draft from %s  ·  Ctrl+R restore  ·  Ctrl+X discard",
+		m.pendingDraft.Updated.Format("Jan 02 15:04")))
+}
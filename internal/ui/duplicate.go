@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// handleDuplicateKeys handles keys in the duplicate ticket warning.
+func (m *Model) handleDuplicateKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		// Back to the editor with the input intact
+		m.viewMode = ViewNewTicket
+		m.duplicateOf = nil
+		m.updateEditorFocus()
+
+	case "c", "enter":
+		m.duplicateOf = nil
+		return m.createTicketChecked(false)
+
+	case "o":
+		existing := m.duplicateOf
+		m.duplicateOf = nil
+		m.viewMode = ViewBoard
+		m.resetEditorInputs()
+		if existing != nil && m.selectTicketByPath(existing.FilePath) {
+			return m.openTicketEditor(EditorModeView)
+		}
+	}
+	return nil
+}
+
+// renderDuplicateScreen renders the duplicate warning as a centered modal.
+func (m *Model) renderDuplicateScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Possible Duplicate"))
+	b.WriteString("\n\n")
+	if m.duplicateOf != nil {
+		b.WriteString("A similar ticket already exists:\n")
+		b.WriteString(m.styles.TicketTitle.Render(m.duplicateOf.Title))
+		b.WriteString(m.styles.TicketDate.Render(fmt.Sprintf("  [%s]", m.columnName(m.duplicateOf.Column))))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("o open existing, c create anyway, Esc keep editing"))
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
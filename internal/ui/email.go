@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/mail"
+)
+
+// emailSentMsg reports the delivery of an email.
+type emailSentMsg struct {
+	subject string
+	to      []string
+	err     error
+}
+
+// newEmailInput creates the recipients input for emailing tickets.
+func newEmailInput() textinput.Model {
+	ei := textinput.New()
+	ei.Placeholder = "ann@example.com, bob@example.com"
+	ei.CharLimit = 500
+	ei.Width = 50
+	return ei
+}
+
+// openEmail asks for the recipients of the selected ticket, or of a board
+// summary when there is no ticket to send, prefilled from the smtp config.
+func (m *Model) openEmail() tea.Cmd {
+	server, err := config.LoadSMTP()
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	m.emailServer = server
+	m.emailBoard = m.getSelectedTicket() == nil
+	m.emailInput.SetValue(strings.Join(server.To, ", "))
+	m.emailInput.CursorEnd()
+	m.emailInput.Focus()
+	m.viewMode = ViewEmail
+	return textinput.Blink
+}
+
+// handleEmailKeys handles keys in the email prompt: tab switches between
+// the ticket and a board summary, enter sends.
+func (m *Model) handleEmailKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.emailInput.Blur()
+		m.viewMode = ViewBoard
+
+	case "tab":
+		if m.getSelectedTicket() != nil {
+			m.emailBoard = !m.emailBoard
+		}
+
+	case "enter":
+		to, err := mail.ParseRecipients(m.emailInput.Value())
+		if err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		subject, body := m.emailContent()
+		m.emailInput.Blur()
+		m.viewMode = ViewBoard
+		m.setStatus("Sending " + subject + "...")
+
+		server := m.emailServer
+		message := mail.Message{From: server.From, To: to, Subject: subject, Body: body}
+		return func() tea.Msg {
+			return emailSentMsg{subject: subject, to: to, err: mail.Send(server, message)}
+		}
+	}
+	return nil
+}
+
+// emailContent returns the subject and body of the email being written.
+func (m *Model) emailContent() (subject, body string) {
+	if ticket := m.getSelectedTicket(); ticket != nil && !m.emailBoard {
+		return mail.TicketSummary(ticket, m.columns[m.activeColumn].Config.Name)
+	}
+	columns := make([]mail.Column, len(m.columns))
+	for i, col := range m.columns {
+		columns[i] = mail.Column{Name: col.Config.Name, Tickets: col.Tickets}
+	}
+	return mail.BoardSummary(config.BoardName(m.config.KanbanDir), columns, time.Now())
+}
+
+// handleEmailSent reports whether an email went out.
+func (m *Model) handleEmailSent(msg emailSentMsg) {
+	if msg.err != nil {
+		m.setError(fmt.Sprintf("Error sending email: %v", msg.err))
+		return
+	}
+	m.setSuccess(fmt.Sprintf("Sent %q to %s", msg.subject, strings.Join(msg.to, ", ")))
+}
+
+// renderEmailScreen renders the email prompt as a centered modal.
+func (m *Model) renderEmailScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Email"))
+	b.WriteString("\n\n")
+
+	subject, _ := m.emailContent()
+	b.WriteString(m.styles.HelpDesc.Render("Subject: ") + subject)
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("From:    ") + m.emailServer.From)
+	b.WriteString("\n\n")
+	b.WriteString("To:")
+	b.WriteString("\n\n")
+	b.WriteString(m.emailInput.View())
+	b.WriteString("\n\n")
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	help := "Enter to send, Esc to cancel"
+	if m.getSelectedTicket() != nil {
+		help = "Tab: ticket/board summary, " + help
+	}
+	b.WriteString(m.styles.HelpDesc.Render(help))
+
+	modal := m.styles.Modal.Width(70).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
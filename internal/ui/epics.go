@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// indexChildren rebuilds the parent → children index from the loaded tickets.
+func (m *Model) indexChildren() {
+	m.children = make(map[string][]*models.Ticket)
+	for _, col := range m.columns {
+		for _, t := range col.Tickets {
+			if t.Parent != "" {
+				m.children[t.Parent] = append(m.children[t.Parent], t)
+			}
+		}
+	}
+}
+
+// findTicketByFilename returns the ticket with the given filename, in any column.
+func (m *Model) findTicketByFilename(name string) *models.Ticket {
+	if name == "" {
+		return nil
+	}
+	for _, col := range m.columns {
+		for _, t := range col.Tickets {
+			if t.Filename() == name {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// reparent points the references to the ticket named from at the ticket
+// named to: the parent field of its children and [[from]] links, such as a
+// split epic's links to a child. Children stay under their epic, and links
+// keep working, when a ticket is merged or renamed.
+func (m *Model) reparent(from, to string) error {
+	for _, t := range m.allTickets() {
+		if t.Relink(from, to) {
+			if err := t.Save(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// epicProgress returns how many of a ticket's children are in the done column.
+func (m *Model) epicProgress(ticket *models.Ticket) (done, total int) {
+	kids := m.children[ticket.Filename()]
+	if len(kids) == 0 || len(m.columns) == 0 {
+		return 0, 0
+	}
+	doneDir := m.doneColumn()
+	for _, kid := range kids {
+		if kid.Column == doneDir {
+			done++
+		}
+	}
+	return done, len(kids)
+}
+
+// epics returns tickets that have at least one child, in board order.
+func (m *Model) epics() []*models.Ticket {
+	var epics []*models.Ticket
+	for _, col := range m.columns {
+		for _, t := range col.Tickets {
+			if len(m.children[t.Filename()]) > 0 {
+				epics = append(epics, t)
+			}
+		}
+	}
+	return epics
+}
+
+// columnName returns the display name of a column directory.
+func (m *Model) columnName(dir string) string {
+	for _, col := range m.columns {
+		if col.Config.Dir == dir {
+			return col.Config.Name
+		}
+	}
+	return dir
+}
+
+// handleEpicKeys handles keys in the epic tree view.
+func (m *Model) handleEpicKeys(msg tea.KeyMsg) tea.Cmd {
+	epics := m.epics()
+	switch msg.String() {
+	case "esc", "q", "E":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.epicIndex < len(epics)-1 {
+			m.epicIndex++
+		}
+
+	case "k", "up":
+		if m.epicIndex > 0 {
+			m.epicIndex--
+		}
+
+	case "enter":
+		if m.epicIndex < len(epics) {
+			m.epicFilter = epics[m.epicIndex].Filename()
+			m.activeTicket = 0
+			m.viewMode = ViewBoard
+		}
+	}
+	return nil
+}
+
+// renderEpicTree renders epics with their child tickets nested underneath.
+func (m *Model) renderEpicTree() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Epics")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	epics := m.epics()
+	if len(epics) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("No epics yet - split a ticket (S) or set a ticket's parent field."))
+		b.WriteString("\n\n")
+	}
+	m.epicIndex = max(min(m.epicIndex, len(epics)-1), 0)
+
+	for i, epic := range epics {
+		done, total := m.epicProgress(epic)
+		cursor := "  "
+		if i == m.epicIndex {
+			cursor = m.styles.HelpKey.Render("▶ ")
+		}
+		b.WriteString(fmt.Sprintf("%s%s %s\n",
+			cursor,
+			m.styles.TicketTitle.Render(epic.Title),
+			m.styles.TicketTags.Render(fmt.Sprintf("(%d/%d done)", done, total))))
+
+		for j, kid := range m.children[epic.Filename()] {
+			branch := "├─"
+			if j == total-1 {
+				branch = "└─"
+			}
+			b.WriteString(fmt.Sprintf("    %s %s %s\n",
+				branch,
+				kid.Title,
+				m.styles.TicketDate.Render("["+m.columnName(kid.Column)+"]")))
+		}
+		b.WriteString("\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "filter board"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
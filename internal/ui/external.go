@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/platform"
+)
+
+// externalEditMsg reports that the external editor exited.
+type externalEditMsg struct {
+	path string
+	err  error
+}
+
+// openExternalEditor suspends the TUI and opens the selected ticket's file
+// in the configured editor. The board is reloaded when the editor exits.
+func (m *Model) openExternalEditor() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return nil
+	}
+	if m.config.Editor == "" {
+		m.setError("No editor found: set editor in config.yaml or $EDITOR")
+		return nil
+	}
+	path := ticket.FilePath
+	return tea.ExecProcess(platform.EditorCommand(m.config.Editor, path), func(err error) tea.Msg {
+		return externalEditMsg{path: path, err: err}
+	})
+}
+
+// handleExternalEdit reloads the board after the external editor exits.
+func (m *Model) handleExternalEdit(msg externalEditMsg) {
+	if msg.err != nil {
+		m.setError(fmt.Sprintf("Editor %q failed: %v", m.config.Editor, msg.err))
+		return
+	}
+	m.loadAllTickets()
+	m.selectTicketByPath(msg.path)
+	m.setStatus("Closed editor")
+}
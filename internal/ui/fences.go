@@ -0,0 +1,58 @@
+package ui
+
+import "strings"
+
+// fenceScanner follows fenced code blocks (``` or ~~~) through markdown
+// content line by line. As in CommonMark, a block opens with three or more
+// backticks or tildes and only closes on a line of at least as many of the
+// same character with no info string, so a ```` block can quote ```.
+type fenceScanner struct {
+	// fence is the opening fence of the current block, "" outside one
+	fence string
+	// info is the opening fence's info string, e.g. go title="main.go"
+	info string
+}
+
+// fenceLine is what a line is to the fenced code blocks.
+type fenceLine int
+
+const (
+	outsideFence fenceLine = iota
+	openFence
+	insideFence
+	closeFence
+)
+
+// scan classifies the next line of the content.
+func (f *fenceScanner) scan(line string) fenceLine {
+	trimmed := strings.TrimSpace(line)
+	if f.fence == "" {
+		fence := fenceRun(trimmed)
+		info := strings.TrimSpace(trimmed[len(fence):])
+		// A backtick fence's info string can't hold backticks, which
+		// makes ```code``` inline code instead
+		if fence == "" || (fence[0] == '`' && strings.Contains(info, "`")) {
+			return outsideFence
+		}
+		f.fence, f.info = fence, info
+		return openFence
+	}
+	if fence := fenceRun(trimmed); fence != "" && fence[0] == f.fence[0] && len(fence) >= len(f.fence) && len(fence) == len(trimmed) {
+		f.fence, f.info = "", ""
+		return closeFence
+	}
+	return insideFence
+}
+
+// fenceRun returns the run of three or more backticks or tildes s starts
+// with, or "".
+func fenceRun(s string) string {
+	if s == "" || (s[0] != '`' && s[0] != '~') {
+		return ""
+	}
+	n := len(s) - len(strings.TrimLeft(s, s[:1]))
+	if n < 3 {
+		return ""
+	}
+	return s[:n]
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// slimColumnWidth is the inner width of a column collapsed in focus mode.
+const slimColumnWidth = 5
+
+// collapsed reports whether a column is drawn as a slim indicator: focus
+// mode hides the configured noise columns, except the active one, so a
+// ticket selected there (e.g. by a jump) stays visible.
+func (m *Model) collapsed(colIndex int) bool {
+	return m.focusMode && colIndex != m.activeColumn && m.config.FocusHidden(colIndex)
+}
+
+// toggleFocusMode collapses or restores the focus mode columns. The active
+// column moves off a collapsed column so navigation starts on active work.
+func (m *Model) toggleFocusMode() {
+	if m.focusMode {
+		m.focusMode = false
+		m.setStatus("Focus mode off")
+		return
+	}
+
+	var hidden []string
+	for i, col := range m.columns {
+		if m.config.FocusHidden(i) {
+			hidden = append(hidden, col.Config.Name)
+		}
+	}
+	if len(hidden) == len(m.columns) {
+		m.setStatus("Focus mode would hide every column: set focus_hide on the columns to hide")
+		return
+	}
+	m.focusMode = true
+	if m.config.FocusHidden(m.activeColumn) {
+		m.activeColumn = m.nextFocusedColumn(m.activeColumn, 1)
+		if m.config.FocusHidden(m.activeColumn) {
+			m.activeColumn = m.nextFocusedColumn(m.activeColumn, -1)
+		}
+		m.activeTicket = 0
+	}
+	m.setStatus(fmt.Sprintf("Focus mode: hiding %s", strings.Join(hidden, ", ")))
+}
+
+// nextFocusedColumn returns the first column from the one at from in
+// direction dir (1 or -1) that is not collapsed, or from if there is none.
+func (m *Model) nextFocusedColumn(from, dir int) int {
+	for i := from + dir; i >= 0 && i < len(m.columns); i += dir {
+		if !m.focusMode || !m.config.FocusHidden(i) {
+			return i
+		}
+	}
+	return from
+}
+
+// renderSlimColumn renders a collapsed column as a narrow strip with the
+// initial of its name and its ticket count.
+func (m *Model) renderSlimColumn(col ColumnData, colIndex int) string {
+	label := []rune(col.Config.Name)
+	if col.Config.Icon != "" {
+		label = []rune(col.Config.Icon)
+	}
+	initial := ""
+	if len(label) > 0 {
+		initial = string(label[0])
+	}
+
+	headerStyle := m.styles.ColumnHeader.Copy().Background(GetColumnColor(col.Config.Dir)).Padding(0)
+	content := headerStyle.Render(initial) + "\n" +
+		m.styles.ColumnCount.Copy().MarginLeft(0).Render(fmt.Sprint(len(m.getFilteredTickets(colIndex))))
+	return m.styleColumn(content, slimColumnWidth, m.height-10-m.extraToastLines(), false)
+}
@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/github"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// prStatusMsg delivers a fetched pull request status.
+type prStatusMsg struct {
+	ref    string
+	status github.Status
+	err    error
+}
+
+// githubRepo returns the repository bare PR numbers refer to.
+func (m *Model) githubRepo() string {
+	if m.config.GithubRepo != "" {
+		return m.config.GithubRepo
+	}
+	if m.gitRemoteRepo == nil {
+		repo := github.RepoFromGit(filepath.Dir(m.config.KanbanDir))
+		m.gitRemoteRepo = &repo
+	}
+	return *m.gitRemoteRepo
+}
+
+// refreshPRStatuses fetches the status of every linked pull request.
+func (m *Model) refreshPRStatuses() tea.Cmd {
+	client := github.NewClient()
+	seen := make(map[string]bool)
+	var cmds []tea.Cmd
+	for _, ticket := range m.allTickets() {
+		if ticket.PR == "" || seen[ticket.PR] {
+			continue
+		}
+		seen[ticket.PR] = true
+
+		ref := ticket.PR
+		pr, err := github.ParsePR(ref, m.githubRepo())
+		if err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		cmds = append(cmds, func() tea.Msg {
+			status, err := client.Status(context.Background(), pr)
+			return prStatusMsg{ref: ref, status: status, err: err}
+		})
+	}
+
+	if len(cmds) == 0 {
+		m.setStatus("No tickets link a PR")
+		return nil
+	}
+	m.setStatus(fmt.Sprintf("Fetching %d PR statuses...", len(cmds)))
+	return tea.Batch(cmds...)
+}
+
+// issueCreatedMsg delivers the URL of an issue created from a ticket.
+type issueCreatedMsg struct {
+	filename string
+	url      string
+	err      error
+}
+
+// createIssue creates a GitHub issue from the selected ticket: its title,
+// its content as the body and its tags as labels.
+func (m *Model) createIssue() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		m.setStatus("No ticket selected")
+		return nil
+	}
+	if ticket.Issue != "" {
+		m.setStatus("Ticket already has issue " + ticket.Issue)
+		return nil
+	}
+	filename := ticket.Filename()
+	if m.creatingIssues[filename] {
+		m.setStatus("Already creating an issue for this ticket")
+		return nil
+	}
+	if m.creatingIssues == nil {
+		m.creatingIssues = make(map[string]bool)
+	}
+	m.creatingIssues[filename] = true
+
+	client := github.NewClient()
+	repo := m.githubRepo()
+	issue := github.Issue{Title: ticket.Title, Body: ticket.Content, Labels: ticket.Tags}
+	m.setStatus("Creating issue in " + repo + "...")
+	return func() tea.Msg {
+		url, err := client.CreateIssue(context.Background(), repo, issue)
+		return issueCreatedMsg{filename: filename, url: url, err: err}
+	}
+}
+
+// handleIssueCreated stores a created issue's URL in its ticket.
+func (m *Model) handleIssueCreated(msg issueCreatedMsg) {
+	delete(m.creatingIssues, msg.filename)
+	if msg.err != nil {
+		m.setError(fmt.Sprintf("Error creating issue: %v", msg.err))
+		return
+	}
+	ticket := m.findTicketByFilename(msg.filename)
+	if ticket == nil {
+		m.setError("Created " + msg.url + " but the ticket is gone")
+		return
+	}
+	ticket.Issue = msg.url
+	if err := ticket.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error saving ticket: %v", err))
+		return
+	}
+	m.loadAllTickets()
+	m.setSuccess("Created issue " + msg.url)
+}
+
+// handlePRStatus records a fetched PR status.
+func (m *Model) handlePRStatus(msg prStatusMsg) {
+	if msg.err != nil {
+		m.setError(fmt.Sprintf("Error fetching %s: %v", msg.ref, msg.err))
+		return
+	}
+	if m.prStatuses == nil {
+		m.prStatuses = make(map[string]github.Status)
+	}
+	m.prStatuses[msg.ref] = msg.status
+}
+
+// openSelectedPR opens the selected ticket's pull request in the browser.
+func (m *Model) openSelectedPR() {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return
+	}
+	if ticket.PR == "" {
+		m.setStatus("Ticket has no pr")
+		return
+	}
+	pr, err := github.ParsePR(ticket.PR, m.githubRepo())
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if err := openBrowser(pr.URL()); err != nil {
+		m.setError(fmt.Sprintf("Error opening browser: %v", err))
+		return
+	}
+	m.setSuccess("Opened " + pr.String())
+}
+
+// renderPRBadge renders a card's pull request number, state and checks.
+func (m *Model) renderPRBadge(ticket *models.Ticket) string {
+	label := "PR " + ticket.PR
+	if pr, err := github.ParsePR(ticket.PR, m.githubRepo()); err == nil {
+		label = fmt.Sprintf("PR #%d", pr.Number)
+	}
+
+	status, ok := m.prStatuses[ticket.PR]
+	if !ok {
+		return m.styles.TicketDate.Render(label)
+	}
+
+	badge := m.styles.TicketDate.Render(label + " ")
+	badge += m.styles.TicketTags.Copy().Foreground(prStateColor(status.State)).Render(status.State)
+
+	switch status.Checks {
+	case "success":
+		badge += m.styles.TicketTags.Copy().Foreground(GruvboxGreen).Render(" ✓")
+	case "failure":
+		badge += m.styles.TicketTags.Copy().Foreground(GruvboxRed).Render(" ✗")
+	case "pending":
+		badge += m.styles.TicketTags.Copy().Foreground(GruvboxYellow).Render(" …")
+	}
+	return badge
+}
+
+// prStateColor returns the badge color for a pull request state.
+func prStateColor(state string) lipgloss.Color {
+	switch state {
+	case "open":
+		return GruvboxGreen
+	case "merged":
+		return GruvboxPurple
+	case "closed":
+		return GruvboxRed
+	default:
+		return GruvboxGray
+	}
+}
+
+// openBrowser opens url with the platform's default handler.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// highlightStyle is the chroma style matching the app's Gruvbox palette; the
+// theme sets it.
+var highlightStyle = "gruvbox"
+
+// highlightedContent returns the viewed ticket's content highlighted,
+// tokenizing it only when the ticket, its version or the theme changed.
+func (m *Model) highlightedContent(content string) string {
+	h := fnv.New64a()
+	var path string
+	var updated int64
+	if m.editingTicket != nil {
+		path, updated = m.editingTicket.FilePath, m.editingTicket.Updated.UnixNano()
+	}
+	fmt.Fprintf(h, "highlight\x00%s\x00%d\x00%s\x00%d\x00", path, updated, highlightStyle, lipgloss.ColorProfile())
+	h.Write([]byte(content))
+	if key := h.Sum64(); key != m.highlightKey || m.highlighted == "" {
+		m.highlightKey, m.highlighted = key, highlightMarkdown(content)
+	}
+	return m.highlighted
+}
+
+// highlightMarkdown colors markdown content, including fenced code blocks in
+// their own language, for the terminal's color profile. Content is returned
+// unchanged when the terminal has no color support or highlighting fails.
+func highlightMarkdown(content string) string {
+	var formatterName string
+	switch lipgloss.ColorProfile() {
+	case termenv.TrueColor:
+		formatterName = "terminal16m"
+	case termenv.ANSI256:
+		formatterName = "terminal256"
+	case termenv.ANSI:
+		formatterName = "terminal16"
+	default:
+		return content
+	}
+
+	lexer := lexers.Get("markdown")
+	if lexer == nil {
+		return content
+	}
+	iterator, err := lexer.Tokenise(nil, content)
+	if err != nil {
+		return content
+	}
+
+	// Format line by line so no color escape spans a newline; the view
+	// scrolls and wraps content per line.
+	formatter := formatters.Get(formatterName)
+	style := styles.Get(highlightStyle)
+	var lines []string
+	for _, tokens := range chroma.SplitTokensIntoLines(iterator.Tokens()) {
+		if n := len(tokens); n > 0 {
+			tokens[n-1].Value = strings.TrimSuffix(tokens[n-1].Value, "\n")
+		}
+		var b strings.Builder
+		if err := formatter.Format(&b, style, chroma.Literator(tokens...)); err != nil {
+			return content
+		}
+		lines = append(lines, b.String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/hooks"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// pendingHook is a lifecycle hook waiting to run.
+type pendingHook struct {
+	command string
+	payload hooks.Payload
+}
+
+// hookDoneMsg reports the hooks that failed in one run of queued hooks.
+type hookDoneMsg struct {
+	errs []error
+}
+
+// fireHook queues the hook configured for event on ticket, if any. from is
+// the column a moved ticket left.
+func (m *Model) fireHook(event hooks.Event, ticket *models.Ticket, from string) {
+	if command := hooks.Command(m.config.Hooks, event); command != "" {
+		m.pendingHooks = append(m.pendingHooks, pendingHook{command, hooks.NewPayload(event, ticket, from)})
+	}
+}
+
+// hookCmd runs the queued hooks in the background, one after another so
+// a ticket's hooks see its events in order.
+func (m *Model) hookCmd() tea.Cmd {
+	if len(m.pendingHooks) == 0 {
+		return nil
+	}
+	pending := m.pendingHooks
+	m.pendingHooks = nil
+	return func() tea.Msg {
+		var errs []error
+		for _, h := range pending {
+			if err := hooks.Run(h.command, h.payload); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return hookDoneMsg{errs: errs}
+	}
+}
+
+// handleHookDone reports failed hooks.
+func (m *Model) handleHookDone(msg hookDoneMsg) {
+	for _, err := range msg.errs {
+		m.setError(fmt.Sprintf("Hook error: %v", err))
+	}
+}
+
+// moveTicketTo moves ticket to the column dir, recording the move reason and
+// skipped checklist items on the new column entry, and runs the on_move hook,
+// plus on_done when dir is the done column.
+func (m *Model) moveTicketTo(ticket *models.Ticket, dir, reason string, skipped []string) error {
+	from, oldPath := ticket.Column, ticket.FilePath
+	if err := ticket.Move(ticket.KanbanDir(), dir); err != nil {
+		return err
+	}
+	m.followMove(oldPath, ticket.FilePath)
+	// A name taken in the target column gets a suffix; keep links and
+	// stars working
+	if oldName := filepath.Base(oldPath); oldName != ticket.Filename() {
+		if err := m.reparent(oldName, ticket.Filename()); err != nil {
+			return err
+		}
+		if err := m.renameStar(ticket, oldName); err != nil {
+			return err
+		}
+	}
+	if reason != "" || len(skipped) > 0 {
+		ticket.SetMoveReason(reason)
+		ticket.SetSkippedChecks(skipped)
+		if err := ticket.WriteFile(); err != nil {
+			return err
+		}
+	}
+	m.fireHook(hooks.Move, ticket, from)
+	if dir == m.doneColumn() {
+		m.fireHook(hooks.Done, ticket, from)
+	}
+	return nil
+}
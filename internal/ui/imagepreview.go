@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF decoder
+	_ "image/jpeg" // register JPEG decoder
+	_ "image/png"  // register PNG decoder
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// Image preview bounds in terminal cells.
+const (
+	maxPreviewCols = 40
+	maxPreviewRows = 12
+)
+
+var markdownImageRe = regexp.MustCompile(`!\[[^\]]*\]\(([^)\s]+)[^)]*\)`)
+
+// ticketImagePath returns the ticket's cover image: its cover field, or the
+// first markdown image in the content. Relative paths resolve against the
+// ticket's directory, then the project root.
+func (m *Model) ticketImagePath(ticket *models.Ticket) string {
+	ref := ticket.Cover
+	if ref == "" {
+		if match := markdownImageRe.FindStringSubmatch(ticket.Content); match != nil {
+			ref = match[1]
+		}
+	}
+	if ref == "" || strings.Contains(ref, "://") {
+		return ""
+	}
+	if filepath.IsAbs(ref) {
+		return ref
+	}
+
+	candidates := []string{
+		filepath.Join(filepath.Dir(ticket.FilePath), ref),
+		filepath.Join(filepath.Dir(m.config.KanbanDir), ref),
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// renderImagePreview renders the ticket's cover image as half-block
+// characters (two pixels per cell), caching the result per file and width.
+func (m *Model) renderImagePreview(ticket *models.Ticket, width int) string {
+	if !m.config.ImagePreviews {
+		return ""
+	}
+	path := m.ticketImagePath(ticket)
+	if path == "" {
+		return ""
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	key := fmt.Sprintf("%s|%d|%d", path, info.ModTime().UnixNano(), width)
+	if cached, ok := m.previewCache[key]; ok {
+		return cached
+	}
+
+	preview := ""
+	if f, err := os.Open(path); err == nil {
+		if img, _, err := image.Decode(f); err == nil {
+			preview = halfBlockImage(img, min(width, maxPreviewCols), maxPreviewRows)
+		}
+		f.Close()
+	}
+
+	if m.previewCache == nil {
+		m.previewCache = make(map[string]string)
+	}
+	m.previewCache[key] = preview
+	return preview
+}
+
+// halfBlockImage scales img to fit cols x rows cells and draws it with "▀",
+// using the foreground for the upper pixel and the background for the lower.
+func halfBlockImage(img image.Image, cols, rows int) string {
+	bounds := img.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 || cols <= 0 {
+		return ""
+	}
+
+	// Each cell is one pixel wide and two pixels tall
+	scale := min(float64(cols)/float64(bounds.Dx()), float64(rows*2)/float64(bounds.Dy()))
+	w := max(int(float64(bounds.Dx())*scale), 1)
+	h := max(int(float64(bounds.Dy())*scale), 2)
+
+	pixel := func(x, y int) lipgloss.Color {
+		sx := bounds.Min.X + x*bounds.Dx()/w
+		sy := bounds.Min.Y + y*bounds.Dy()/h
+		r, g, b, _ := img.At(sx, sy).RGBA()
+		return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8))
+	}
+
+	var sb strings.Builder
+	for y := 0; y+1 < h; y += 2 {
+		for x := 0; x < w; x++ {
+			sb.WriteString(lipgloss.NewStyle().
+				Foreground(pixel(x, y)).
+				Background(pixel(x, y+1)).
+				Render("▀"))
+		}
+		sb.WriteString("\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
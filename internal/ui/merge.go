@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/hooks"
+)
+
+// startMerge asks to merge the second selected ticket into the first.
+func (m *Model) startMerge() tea.Cmd {
+	tickets := m.markedTickets()
+	if len(tickets) != 2 {
+		m.setStatus("Select exactly two tickets (Space) to merge")
+		return nil
+	}
+
+	target, source := tickets[0], tickets[1]
+	m.confirm(
+		fmt.Sprintf("Merge %q into %q?\nThe second ticket will be moved to the trash.", source.Title, target.Title),
+		func() tea.Cmd {
+			target.MergeFrom(source, time.Now())
+			if err := target.Save(); err != nil {
+				m.setError(fmt.Sprintf("Error: %v", err))
+				return nil
+			}
+			if err := m.reparent(source.Filename(), target.Filename()); err != nil {
+				m.setError(fmt.Sprintf("Error: %v", err))
+				return nil
+			}
+			// Trashed rather than deleted, so a wrong merge can be undone
+			if _, err := source.Trash(source.KanbanDir()); err != nil {
+				m.setError(fmt.Sprintf("Error: %v", err))
+				return nil
+			}
+			m.fireHook(hooks.Delete, source, "")
+			m.clearMarks()
+			m.loadAllTickets()
+			m.selectTicketByPath(target.FilePath)
+			m.setSuccess(fmt.Sprintf("Merged into: %s", target.ShortTitle(30)))
+			return nil
+		},
+	)
+	return nil
+}
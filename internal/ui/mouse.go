@@ -0,0 +1,103 @@
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// wheelStep is the number of lines scrolled per wheel notch in the ticket
+// and agent feedback views.
+const wheelStep = 3
+
+// handleMouse scrolls the column or ticket view under the pointer and
+// resizes the editor pane when its border is dragged.
+func (m *Model) handleMouse(msg tea.MouseMsg) {
+	switch m.viewMode {
+	case ViewNewTicket, ViewEditTicket, ViewTicket:
+		if m.handleEditorDrag(msg) {
+			return
+		}
+	}
+
+	var delta int
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		delta = -1
+	case tea.MouseButtonWheelDown:
+		delta = 1
+	default:
+		return
+	}
+
+	switch m.viewMode {
+	case ViewBoard:
+		if col := m.columnAt(msg.X); col >= 0 {
+			m.scrollColumn(col, delta)
+		}
+	case ViewTicket, ViewAgentFeedback:
+		vp := &m.ticketViewer
+		if m.viewMode == ViewAgentFeedback {
+			vp = &m.feedbackViewer
+		}
+		if delta < 0 {
+			vp.LineUp(wheelStep)
+		} else {
+			vp.LineDown(wheelStep)
+		}
+	case ViewPager:
+		m.pagerScroll = max(m.pagerScroll+delta*wheelStep, 0)
+	}
+}
+
+// columnAt returns the index of the board column at screen x, or -1.
+func (m *Model) columnAt(x int) int {
+	if len(m.columns) == 0 {
+		return -1
+	}
+	if x < 2 {
+		return -1
+	}
+	// App padding is 2 cells; each column adds a border on both sides and a margin
+	left := 2
+	for i := range m.columns {
+		width := m.columnWidth()
+		if m.collapsed(i) {
+			width = slimColumnWidth
+		}
+		left += width + 3
+		if x < left {
+			return i
+		}
+	}
+	return -1
+}
+
+// columnWidth returns the inner width of each board column.
+func (m *Model) columnWidth() int {
+	slim := 0
+	for i := range m.columns {
+		if m.collapsed(i) {
+			slim++
+		}
+	}
+	full := len(m.columns) - slim
+	return max((m.width-4-slim*(slimColumnWidth+3)-full*2)/full, 20)
+}
+
+// visibleTicketCount returns how many cards fit in a column.
+func (m *Model) visibleTicketCount() int {
+	return max((m.height-12-m.extraToastLines())/4, 3)
+}
+
+// scrollColumn moves a column's scroll offset by delta cards, keeping the
+// selection inside the visible window when the column is active.
+func (m *Model) scrollColumn(colIndex, delta int) {
+	tickets := m.getFilteredTickets(colIndex)
+	visible := m.visibleTicketCount()
+	maxOffset := max(len(tickets)-visible, 0)
+
+	col := &m.columns[colIndex]
+	col.Offset = min(max(col.Offset+delta, 0), maxOffset)
+
+	if colIndex == m.activeColumn && len(tickets) > 0 {
+		m.activeTicket = min(max(m.activeTicket, col.Offset), col.Offset+visible-1)
+		m.activeTicket = min(m.activeTicket, len(tickets)-1)
+	}
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// newMoveReasonInput creates the input for why a ticket is moved.
+func newMoveReasonInput() textinput.Model {
+	ri := textinput.New()
+	ri.Placeholder = "Why is it moving? (e.g. blocked on API review)"
+	ri.CharLimit = 200
+	ri.Width = 50
+	return ri
+}
+
+// moveWithReason moves the selected ticket to moveTarget, first asking for
+// a reason when the config requires one for the transition. skipped are
+// the entry checklist items left unconfirmed.
+func (m *Model) moveWithReason(skipped []string) tea.Cmd {
+	from, to := m.columns[m.activeColumn].Config.Dir, m.columns[m.moveTarget].Config.Dir
+	if m.moveTarget == m.activeColumn || !m.config.ReasonRequired(from, to) {
+		return m.moveSelectedTicket(skipped, "")
+	}
+	m.moveSkipped = skipped
+	m.moveReasonInput.SetValue("")
+	m.moveReasonInput.Focus()
+	m.viewMode = ViewMoveReason
+	return textinput.Blink
+}
+
+// handleMoveReasonKeys handles keys while entering a move reason.
+func (m *Model) handleMoveReasonKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.moveReasonInput.Blur()
+		m.approving = false
+		m.viewMode = ViewBoard
+		m.setStatus("Move cancelled")
+
+	case "enter":
+		reason := strings.TrimSpace(m.moveReasonInput.Value())
+		if reason == "" {
+			m.setStatus("A reason is required for this move")
+			return nil
+		}
+		m.moveReasonInput.Blur()
+		return m.moveSelectedTicket(m.moveSkipped, reason)
+	}
+	return nil
+}
+
+// renderMoveReasonScreen renders the reason prompt as a centered modal.
+func (m *Model) renderMoveReasonScreen() string {
+	var b strings.Builder
+	from, to := m.columns[m.activeColumn].Config, m.columns[m.moveTarget].Config
+	b.WriteString(m.styles.ModalTitle.Render("Move from " + from.Label() + " to " + to.Label()))
+	b.WriteString("\n\n")
+	if ticket := m.getSelectedTicket(); ticket != nil {
+		b.WriteString(ticket.ShortTitle(50))
+		b.WriteString("\n\n")
+	}
+	b.WriteString("Reason (kept in the ticket's history):")
+	b.WriteString("\n\n")
+	b.WriteString(m.moveReasonInput.View())
+	b.WriteString("\n\n")
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.styles.HelpDesc.Render("Enter to move, Esc to cancel"))
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// outlineWidth is the width of the ticket view's outline sidebar.
+const outlineWidth = 30
+
+// heading is a markdown heading in ticket content.
+type heading struct {
+	level int
+	title string
+	line  int // index of the heading's line in the content
+}
+
+// parseHeadings returns the ATX headings (# Title) of markdown content,
+// skipping fenced code blocks.
+func parseHeadings(content string) []heading {
+	var headings []heading
+	var fences fenceScanner
+	for i, line := range strings.Split(content, "\n") {
+		if fences.scan(line) != outsideFence {
+			continue
+		}
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		if level == 0 || level > 6 || (len(line) > level && line[level] != ' ') {
+			continue
+		}
+		title := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(line[level:]), "#"))
+		if title != "" {
+			headings = append(headings, heading{level: level, title: title, line: i})
+		}
+	}
+	return headings
+}
+
+// headingOffsets returns the line of each heading in the ticket view once
+// the content is fitted to the viewer's width.
+func (m *Model) headingOffsets(content string, headings []heading) []int {
+	lines := strings.Split(content, "\n")
+	offsets := make([]int, len(headings))
+	for i, h := range headings {
+		if h.line > 0 {
+			offsets[i] = strings.Count(m.fitLines(strings.Join(lines[:h.line], "\n"), m.ticketViewer.Width), "\n") + 1
+		}
+	}
+	return offsets
+}
+
+// currentSection returns the index of the heading whose section is at the
+// top of the ticket view, or -1 above the first heading.
+func (m *Model) currentSection(offsets []int) int {
+	current := -1
+	for i, offset := range offsets {
+		if offset <= m.ticketViewer.YOffset {
+			current = i
+		}
+	}
+	return current
+}
+
+// jumpSection scrolls the ticket view to the next (dir 1) or previous
+// (dir -1) heading of the content.
+func (m *Model) jumpSection(dir int) {
+	content := m.contentInput.Value()
+	headings := parseHeadings(content)
+	if len(headings) == 0 {
+		m.setStatus("No headings in this ticket")
+		return
+	}
+	offsets := m.headingOffsets(content, headings)
+	y := m.ticketViewer.YOffset
+	target := -1
+	if dir > 0 {
+		for _, offset := range offsets {
+			if offset > y {
+				target = offset
+				break
+			}
+		}
+	} else {
+		for i := len(offsets) - 1; i >= 0; i-- {
+			if offsets[i] < y {
+				target = offsets[i]
+				break
+			}
+		}
+		if target < 0 {
+			target = 0
+		}
+	}
+	if target < 0 {
+		return
+	}
+	m.ticketViewer.SetYOffset(target)
+}
+
+// handleSectionKeys handles the ]] and [[ section jumps of the ticket
+// view, reporting whether key was part of one.
+func (m *Model) handleSectionKeys(key string) bool {
+	if key != "]" && key != "[" {
+		m.pendingBracket = ""
+		return false
+	}
+	if m.pendingBracket != key {
+		m.pendingBracket = key
+		return true
+	}
+	m.pendingBracket = ""
+	if key == "]" {
+		m.jumpSection(1)
+	} else {
+		m.jumpSection(-1)
+	}
+	return true
+}
+
+// toggleOutline shows or hides the ticket view's outline sidebar.
+func (m *Model) toggleOutline() {
+	if !m.showOutline && len(parseHeadings(m.contentInput.Value())) == 0 {
+		m.setStatus("No headings in this ticket")
+		return
+	}
+	m.showOutline = !m.showOutline
+}
+
+// renderOutline renders the content's headings as a sidebar height lines
+// tall, marking the section at the top of the view.
+func (m *Model) renderOutline(content string, headings []heading, height int) string {
+	current := m.currentSection(m.headingOffsets(content, headings))
+	textWidth := outlineWidth - 4
+	var lines []string
+	for i, h := range headings {
+		line := runewidth.Truncate(strings.Repeat("  ", h.level-1)+h.title, textWidth-2, "…")
+		if i == current {
+			lines = append(lines, m.styles.HelpKey.Render("▶ "+line))
+		} else {
+			lines = append(lines, "  "+m.styles.HelpDesc.Render(line))
+		}
+	}
+	// Keep the current heading in sight in long outlines
+	if start := current - height + 1; start > 0 {
+		lines = lines[start:]
+	}
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	return m.styles.Input.Copy().Width(outlineWidth).Height(height).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// openPager shows text in the scrollable pager; Esc returns to the current
+// view. Diffs are colored by line type.
+func (m *Model) openPager(title, text string, diff bool) {
+	m.pagerTitle = title
+	m.pagerText = strings.TrimRight(text, "\n")
+	m.pagerScroll = 0
+	m.pagerDiff = diff
+	m.pagerReturn = m.viewMode
+	m.viewMode = ViewPager
+}
+
+// handlePagerKeys scrolls the pager.
+func (m *Model) handlePagerKeys(msg tea.KeyMsg) tea.Cmd {
+	page := m.pagerHeight()
+	switch msg.String() {
+	case "esc", "q":
+		m.viewMode = m.pagerReturn
+	case "j", "down":
+		m.pagerScroll++
+	case "k", "up":
+		m.pagerScroll = max(m.pagerScroll-1, 0)
+	case "pgdown", " ", "ctrl+d":
+		m.pagerScroll += page
+	case "pgup", "ctrl+u":
+		m.pagerScroll = max(m.pagerScroll-page, 0)
+	case "g", "home":
+		m.pagerScroll = 0
+	case "G", "end":
+		m.pagerScroll = strings.Count(m.pagerText, "\n") + 1
+	}
+	return nil
+}
+
+// pagerHeight returns the number of lines shown at once.
+func (m *Model) pagerHeight() int {
+	return max(m.height-10, 5)
+}
+
+// renderPager renders the visible part of the pager text.
+func (m *Model) renderPager() string {
+	var b strings.Builder
+	contentWidth := max(m.width-8, 40)
+
+	b.WriteString(m.styles.Header.Width(contentWidth).Render("  " + m.pagerTitle))
+	b.WriteString("\n\n")
+
+	lines := strings.Split(m.pagerText, "\n")
+	height := m.pagerHeight()
+	m.pagerScroll = min(m.pagerScroll, max(len(lines)-height, 0))
+	end := min(m.pagerScroll+height, len(lines))
+	visible := make([]string, 0, end-m.pagerScroll)
+	for _, line := range lines[m.pagerScroll:end] {
+		line = runewidth.Truncate(strings.ReplaceAll(line, "\t", "    "), contentWidth-4, "…")
+		if m.pagerDiff {
+			line = styleDiffLine(line)
+		}
+		visible = append(visible, line)
+	}
+	b.WriteString(m.styles.Input.Width(contentWidth).Height(height).Render(strings.Join(visible, "\n")))
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render(fmt.Sprintf("lines %d-%d of %d  ·  j/k scroll, PgUp/PgDn page, g/G top/bottom, Esc back",
+		m.pagerScroll+1, end, len(lines))))
+	return m.styles.App.Render(b.String())
+}
+
+// styleDiffLine colors a unified diff line by its type.
+func styleDiffLine(line string) string {
+	var color lipgloss.Color
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"),
+		strings.HasPrefix(line, "diff "), strings.HasPrefix(line, "commit "):
+		return lipgloss.NewStyle().Bold(true).Foreground(GruvboxYellow).Render(line)
+	case strings.HasPrefix(line, "+"):
+		color = GruvboxGreen
+	case strings.HasPrefix(line, "-"):
+		color = GruvboxRed
+	case strings.HasPrefix(line, "@@"):
+		color = GruvboxAqua
+	default:
+		return line
+	}
+	return lipgloss.NewStyle().Foreground(color).Render(line)
+}
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// command is a board action listed in the command palette, with the
+// board key shown as a hint.
+type command struct {
+	name string
+	key  string
+	run  func(m *Model) tea.Cmd
+}
+
+// boardCommands lists every board action in the command palette.
+var boardCommands = []command{
+	{"New ticket", "n", (*Model).newTicket},
+	{"New ticket from clipboard", "v", (*Model).pasteNewTicket},
+	{"New ticket from template", "N", do((*Model).openTemplates)},
+	{"Run plugin", "X", do((*Model).openPlugins)},
+	{"View ticket", "enter", (*Model).viewSelected},
+	{"Go to ticket", "ctrl+o", (*Model).openSwitcher},
+	{"Edit ticket", "e", (*Model).editSelected},
+	{"Open ticket in external editor", "ctrl+e", (*Model).openExternalEditor},
+	{"Quick-edit title and tags", "i", (*Model).startQuickEdit},
+	{"Delete ticket", "d", do((*Model).confirmDeleteSelected)},
+	{"Move ticket", "m", do((*Model).openMove)},
+	{"Move ticket to the next column", ">", (*Model).moveSelectedNext},
+	{"Move ticket to the previous column", "<", (*Model).moveSelectedPrev},
+	{"Set card color", "c", do((*Model).openColorPicker)},
+	{"Toggle ticket selection", " ", do((*Model).toggleMark)},
+	{"Jump to last changed ticket", ".", do((*Model).jumpToLastChanged)},
+	{"Search tickets", "/", (*Model).openSearch},
+	{"Refresh board", "r", (*Model).refresh},
+	{"Copy ticket prompt", "p", (*Model).copySelectedTicketPrompt},
+	{"Copy todo/selected tickets prompt", "P", (*Model).copyTicketsPrompt},
+	{"Copy verify prompt (acceptance criteria)", "V", (*Model).copyVerifyPrompt},
+	{"Copy ticket as markdown", "y", (*Model).copyTicketMarkdown},
+	{"Copy ticket as JSON", "Y", (*Model).copyTicketJSON},
+	{"Dispatch ticket to agent", "a", (*Model).dispatchSelected},
+	{"Dispatch all todo tickets to agent", "A", (*Model).dispatchTodo},
+	{"Agent queue", "Q", do((*Model).openQueue)},
+	{"Review queue", "R", do((*Model).openReviewQueue)},
+	{"Reopen done ticket", "o", (*Model).reopenSelected},
+	{"Split ticket", "S", (*Model).startSplit},
+	{"Merge selected tickets", "M", (*Model).startMerge},
+	{"Epic tree", "E", do((*Model).openEpics)},
+	{"Create/check out git branch", "b", do((*Model).startBranch)},
+	{"Create/remove git worktree", "w", do((*Model).toggleWorktree)},
+	{"Fetch pull request statuses", "G", (*Model).refreshPRStatuses},
+	{"Open pull request in browser", "O", do((*Model).openSelectedPR)},
+	{"Create GitHub issue from ticket", "U", (*Model).createIssue},
+	{"Email ticket or board summary", "@", (*Model).openEmail},
+	{"Board statistics", "s", do((*Model).openStats)},
+	{"Today's worklist", "T", do((*Model).openToday)},
+	{"Snooze ticket", "z", (*Model).startSnooze},
+	{"Show/hide snoozed tickets", "Z", do((*Model).toggleShowSnoozed)},
+	{"Star/unstar ticket", "*", do((*Model).starSelected)},
+	{"Starred tickets", "'", do((*Model).openStarred)},
+	{"Rename/merge tag", "#", (*Model).startTagRename},
+	{"Find and replace in tickets", "%", (*Model).startReplace},
+	{"Resolve sync conflicts", "!", do((*Model).openConflicts)},
+	{"Regenerate AGENT.md from config", "I", do((*Model).regenerateAgentMd)},
+	{"Switch board", "B", do((*Model).openBoardPicker)},
+	{"Toggle workspace mode", "W", (*Model).toggleWorkspace},
+	{"Toggle focus mode", "F", do((*Model).toggleFocusMode)},
+	{"Help", "?", do((*Model).openHelp)},
+	{"Quit", "q", (*Model).quit},
+}
+
+// maxPaletteRows is the number of commands shown at once.
+const maxPaletteRows = 12
+
+// newPaletteInput creates the command palette filter input.
+func newPaletteInput() textinput.Model {
+	pi := textinput.New()
+	pi.Placeholder = "Type a command..."
+	pi.CharLimit = 50
+	pi.Width = 50
+	return pi
+}
+
+// openPalette shows the command palette.
+func (m *Model) openPalette() tea.Cmd {
+	m.paletteInput.SetValue("")
+	m.paletteInput.Focus()
+	m.paletteIndex = 0
+	m.viewMode = ViewPalette
+	return textinput.Blink
+}
+
+// paletteMatches returns the commands matching the palette filter, those
+// containing the query as a substring first.
+func (m *Model) paletteMatches() []command {
+	query := strings.ToLower(strings.TrimSpace(m.paletteInput.Value()))
+	var exact, fuzzy []command
+	for _, c := range boardCommands {
+		switch {
+		case strings.Contains(strings.ToLower(c.name), query):
+			exact = append(exact, c)
+		case fuzzyMatch(query, c.name):
+			fuzzy = append(fuzzy, c)
+		}
+	}
+	return append(exact, fuzzy...)
+}
+
+// handlePaletteKeys handles keys in the command palette; other keys edit
+// the filter.
+func (m *Model) handlePaletteKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "ctrl+p":
+		m.paletteInput.Blur()
+		m.viewMode = ViewBoard
+
+	case "down", "ctrl+n", "ctrl+j", "tab":
+		if m.paletteIndex < len(m.paletteMatches())-1 {
+			m.paletteIndex++
+		}
+
+	case "up", "ctrl+k", "shift+tab":
+		if m.paletteIndex > 0 {
+			m.paletteIndex--
+		}
+
+	case "enter":
+		matches := m.paletteMatches()
+		m.paletteInput.Blur()
+		m.viewMode = ViewBoard
+		if m.paletteIndex < len(matches) {
+			return matches[m.paletteIndex].run(m)
+		}
+	}
+	return nil
+}
+
+// renderPaletteScreen renders the command palette as a centered modal.
+func (m *Model) renderPaletteScreen() string {
+	var b strings.Builder
+	width := 60
+
+	b.WriteString(m.styles.ModalTitle.Render("Command Palette"))
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.InputFocused.Width(width - 6).Render(m.paletteInput.View()))
+	b.WriteString("\n\n")
+
+	matches := m.paletteMatches()
+	m.paletteIndex = max(min(m.paletteIndex, len(matches)-1), 0)
+	if len(matches) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("  No matching commands"))
+		b.WriteString("\n")
+	}
+
+	// Keep the highlighted command in the visible window
+	start := max(m.paletteIndex-maxPaletteRows+1, 0)
+	end := min(start+maxPaletteRows, len(matches))
+	for i := start; i < end; i++ {
+		c := matches[i]
+		key := c.key
+		if key == " " {
+			key = "space"
+		}
+		hint := m.styles.HelpKey.Render(key)
+		name := lipgloss.NewStyle().Width(width - 14).Render(c.name)
+		if i == m.paletteIndex {
+			b.WriteString(m.styles.HelpKey.Render("▶ ") + name + hint)
+		} else {
+			b.WriteString("  " + name + hint)
+		}
+		b.WriteString("\n")
+	}
+	if len(matches) > maxPaletteRows {
+		b.WriteString(m.styles.HelpDesc.Render(fmt.Sprintf("  %d commands", len(matches))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("↑/↓ select, Enter run, Esc close"))
+
+	modal := m.styles.Modal.Width(width).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
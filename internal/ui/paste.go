@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>()\[\]"']+`)
+
+// parsePastedTicket splits pasted text into a title (the first non-empty
+// line, without markdown heading or list markers) and content (the rest).
+// URLs found anywhere in the text are listed under a "Refs" section.
+func parsePastedTicket(text string, maxTitle int) (title, content string) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+
+	title = strings.TrimSpace(strings.TrimLeft(lines[0], "#-*> \t"))
+	if runes := []rune(title); len(runes) > maxTitle {
+		title = string(runes[:maxTitle])
+	}
+	content = strings.TrimSpace(strings.Join(lines[1:], "\n"))
+
+	var refs []string
+	seen := make(map[string]bool)
+	for _, url := range urlPattern.FindAllString(text, -1) {
+		url = strings.TrimRight(url, ".,;:!?")
+		if !seen[url] {
+			seen[url] = true
+			refs = append(refs, "- "+url)
+		}
+	}
+	if len(refs) > 0 {
+		if content != "" {
+			content += "\n\n"
+		}
+		content += "## Refs\n" + strings.Join(refs, "\n")
+	}
+	return title, content
+}
+
+// pasteNewTicket opens the new ticket editor pre-filled from the clipboard.
+func (m *Model) pasteNewTicket() tea.Cmd {
+	text, err := m.readClipboard()
+	if err != nil {
+		m.setError(fmt.Sprintf("Error reading clipboard: %v", err))
+		return nil
+	}
+	if strings.TrimSpace(text) == "" {
+		m.setStatus("Clipboard is empty")
+		return nil
+	}
+
+	title, content := parsePastedTicket(text, m.titleInput.CharLimit)
+	tags, _ := m.columns[m.activeColumn].Config.WithDefaults(nil, content)
+
+	m.viewMode = ViewNewTicket
+	m.editorMode = EditorModeCreate
+	m.editingTicket = nil
+	m.titleInput.SetValue(title)
+	m.tagsInput.SetValue(strings.Join(tags, ", "))
+	m.contentInput.SetValue(content)
+	m.editorFocus = 0
+	m.updateEditorFocus()
+	m.setStatus("Pasted from clipboard — review and press Ctrl+S to create")
+	return textinput.Blink
+}
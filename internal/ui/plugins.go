@@ -0,0 +1,241 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/hooks"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/plugins"
+)
+
+// pluginDoneMsg carries the actions a plugin returned. ticket is the path
+// of the ticket that was selected when it ran.
+type pluginDoneMsg struct {
+	name    string
+	ticket  string
+	actions []plugins.Action
+	err     error
+}
+
+// openPlugins lists the board's plugins.
+func (m *Model) openPlugins() {
+	list, err := plugins.List(m.config.KanbanDir)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if len(list) == 0 {
+		m.setStatus(fmt.Sprintf("No plugins in %s", filepath.Join(m.config.KanbanDir, plugins.DirName)))
+		return
+	}
+	m.pluginList = list
+	m.pluginIndex = 0
+	m.viewMode = ViewPlugins
+}
+
+// handlePluginsKeys handles keys in the plugin menu.
+func (m *Model) handlePluginsKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.pluginIndex < len(m.pluginList)-1 {
+			m.pluginIndex++
+		}
+
+	case "k", "up":
+		if m.pluginIndex > 0 {
+			m.pluginIndex--
+		}
+
+	case "enter":
+		m.viewMode = ViewBoard
+		return m.runPlugin(m.pluginList[m.pluginIndex])
+	}
+	return nil
+}
+
+// runPlugin runs a plugin in the background on the selected and marked
+// tickets.
+func (m *Model) runPlugin(p plugins.Plugin) tea.Cmd {
+	in := plugins.Input{
+		KanbanDir: m.config.KanbanDir,
+		Column:    m.columns[m.activeColumn].Config.Dir,
+		Selected:  []plugins.Ticket{},
+	}
+	for _, col := range m.columns {
+		in.Columns = append(in.Columns, col.Config.Dir)
+	}
+	selected := ""
+	if ticket := m.getSelectedTicket(); ticket != nil {
+		t := plugins.NewTicket(ticket)
+		in.Ticket = &t
+		selected = ticket.FilePath
+	}
+	for _, ticket := range m.markedTickets() {
+		in.Selected = append(in.Selected, plugins.NewTicket(ticket))
+	}
+
+	m.setStatus(fmt.Sprintf("Running plugin %s...", p.Name))
+	return func() tea.Msg {
+		actions, err := p.Run(in)
+		return pluginDoneMsg{name: p.Name, ticket: selected, actions: actions, err: err}
+	}
+}
+
+// handlePluginDone carries out a plugin's actions in order, stopping at
+// the first that fails.
+func (m *Model) handlePluginDone(msg pluginDoneMsg) {
+	if msg.err != nil {
+		m.setError(fmt.Sprintf("Error: %v", msg.err))
+		return
+	}
+	changed := false
+	defer func() {
+		if changed {
+			m.loadAllTickets()
+		}
+	}()
+
+	for _, a := range msg.actions {
+		switch a.Type {
+		case plugins.ActionMessage:
+			m.setStatus(a.Message)
+
+		case plugins.ActionCopy:
+			if err := m.copyToClipboard(a.Text); err != nil {
+				m.setError(fmt.Sprintf("Clipboard error: %v", err))
+				return
+			}
+			m.setSuccess(fmt.Sprintf("%s copied to clipboard", msg.name))
+
+		case plugins.ActionCreate:
+			dir := a.Column
+			if dir == "" {
+				dir = m.columns[m.activeColumn].Config.Dir
+			}
+			if !m.isColumnDir(dir) {
+				m.setError(fmt.Sprintf("Error: plugin %s: unknown column %s", msg.name, dir))
+				return
+			}
+			ticket := models.NewTicket(strings.TrimSpace(*a.Title), dir)
+			applyPluginFields(ticket, a)
+			if col, ok := m.config.ColumnByDir(dir); ok {
+				ticket.Tags, ticket.Content = col.WithDefaults(ticket.Tags, ticket.Content)
+			}
+			path, err := ticket.UniqueFilePath(m.config.ColumnPath(dir))
+			if err == nil {
+				ticket.FilePath = path
+				err = ticket.Save()
+			}
+			if err != nil {
+				m.setError(fmt.Sprintf("Error: %v", err))
+				return
+			}
+			changed = true
+			m.fireHook(hooks.Create, ticket, "")
+
+		case plugins.ActionUpdate, plugins.ActionMove:
+			ticket, err := m.pluginTicket(a.Path, msg.ticket)
+			if err != nil {
+				m.setError(fmt.Sprintf("Error: plugin %s: %v", msg.name, err))
+				return
+			}
+			if a.Type == plugins.ActionUpdate {
+				applyPluginFields(ticket, a)
+				err = ticket.Save()
+			} else if !m.isColumnDir(a.Column) {
+				err = fmt.Errorf("plugin %s: unknown column %s", msg.name, a.Column)
+			} else if a.Reason == "" && m.config.ReasonRequired(ticket.Column, a.Column) {
+				err = fmt.Errorf("plugin %s: moving %s to %s needs a reason", msg.name, ticket.Column, a.Column)
+			} else if ticket.Column != a.Column {
+				err = m.moveTicketTo(ticket, a.Column, a.Reason, nil)
+			}
+			if err != nil {
+				m.setError(fmt.Sprintf("Error: %v", err))
+				return
+			}
+			changed = true
+		}
+	}
+	if len(msg.actions) == 0 {
+		m.setStatus(fmt.Sprintf("Plugin %s finished", msg.name))
+	}
+}
+
+// pluginTicket reads the ticket an action refers to: path, relative to the
+// kanban directory unless absolute, or else the selected ticket.
+func (m *Model) pluginTicket(path, selected string) (*models.Ticket, error) {
+	if path == "" {
+		path = selected
+	}
+	if path == "" {
+		return nil, fmt.Errorf("no ticket selected")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(m.config.KanbanDir, path)
+	}
+	return models.ParseTicket(path)
+}
+
+// isColumnDir reports whether dir is the directory of a board column.
+func (m *Model) isColumnDir(dir string) bool {
+	for _, col := range m.columns {
+		if col.Config.Dir == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPluginFields sets the title, tags and content an action gives.
+func applyPluginFields(ticket *models.Ticket, a plugins.Action) {
+	if a.Title != nil && strings.TrimSpace(*a.Title) != "" {
+		ticket.Title = strings.TrimSpace(*a.Title)
+	}
+	if a.Tags != nil {
+		ticket.Tags = *a.Tags
+	}
+	if a.Content != nil {
+		ticket.Content = strings.TrimSpace(*a.Content)
+	}
+}
+
+// renderPlugins renders the plugin menu.
+func (m *Model) renderPlugins() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Plugins")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	for i, p := range m.pluginList {
+		line := p.Name + "  " + m.styles.HelpDesc.Render(filepath.Base(p.Path))
+		if i == m.pluginIndex {
+			b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "run on selected ticket"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
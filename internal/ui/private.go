@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/secret"
+)
+
+// newPrivateInput creates the textarea for a ticket's private notes.
+func newPrivateInput() textarea.Model {
+	ta := textarea.New()
+	ta.Placeholder = "Private notes, encrypted in the ticket file..."
+	ta.CharLimit = 0
+	ta.SetWidth(60)
+	ta.SetHeight(8)
+	ta.ShowLineNumbers = false
+	return ta
+}
+
+// boardKey returns the board's encryption key, looking it up in the
+// environment or keychain only once.
+func (m *Model) boardKey() ([]byte, error) {
+	if !m.privateKeyLoaded {
+		m.privateKey, m.privateKeyErr = secret.LoadKey(m.config.KanbanDir)
+		m.privateKeyLoaded = true
+	}
+	return m.privateKey, m.privateKeyErr
+}
+
+// openPrivate decrypts the viewed ticket's private notes for editing.
+func (m *Model) openPrivate() tea.Cmd {
+	ticket := m.editingTicket
+	if ticket == nil {
+		return nil
+	}
+	key, err := m.boardKey()
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	text, err := secret.Open(key, ticket.Private)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	m.privateInput.SetValue(text)
+	m.privateInput.Focus()
+	m.viewMode = ViewPrivate
+	return textarea.Blink
+}
+
+// handlePrivateKeys handles keys while editing private notes.
+func (m *Model) handlePrivateKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.privateInput.Blur()
+		m.viewMode = ViewTicket
+
+	case "ctrl+s":
+		m.privateInput.Blur()
+		m.viewMode = ViewTicket
+		m.savePrivate(strings.TrimSpace(m.privateInput.Value()))
+	}
+	return nil
+}
+
+// savePrivate seals text into the viewed ticket and saves it.
+func (m *Model) savePrivate(text string) {
+	ticket := m.editingTicket
+	key, err := m.boardKey()
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	sealed, err := secret.Seal(key, text)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	ticket.Private = sealed
+	if err := ticket.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	m.loadAllTickets()
+	if text == "" {
+		m.setSuccess("Private notes removed")
+	} else {
+		m.setSuccess("Private notes encrypted and saved")
+	}
+}
+
+// renderPrivateSection shows a ticket's private notes in the ticket view:
+// decrypted when the board key is available, else a hint.
+func (m *Model) renderPrivateSection(ticket *models.Ticket, width int) string {
+	if ticket.Private == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Copy().Foreground(GruvboxPurple).Render("🔒 Private"))
+	b.WriteString("\n")
+
+	var body string
+	key, err := m.boardKey()
+	if err == nil {
+		body, err = secret.Open(key, ticket.Private)
+	}
+	if err != nil {
+		body = m.styles.HelpDesc.Render(fmt.Sprintf("Encrypted — %v", err))
+	}
+	b.WriteString(m.styles.Input.Width(width).Render(body))
+	return b.String()
+}
+
+// renderPrivateScreen renders the private notes editor as a centered modal.
+func (m *Model) renderPrivateScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Private Notes"))
+	b.WriteString("\n\n")
+	if m.editingTicket != nil {
+		b.WriteString(m.editingTicket.ShortTitle(60))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.privateInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("Encrypted with the board key; title, tags and content stay readable"))
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("Ctrl+S to save, Esc to cancel"))
+
+	modal := m.styles.Modal.Width(70).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
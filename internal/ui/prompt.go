@@ -3,12 +3,17 @@ package ui
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"math"
+	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
+	"unicode/utf8"
 
-	"github.com/atotto/clipboard"
+	"github.com/user/kanban-tui/internal/config"
 	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/platform"
 )
 
 // TicketPromptData holds data for single ticket template rendering.
@@ -20,45 +25,156 @@ type TicketPromptData struct {
 	DonePath    string
 	DoingPath   string
 	AgentMdPath string
+	// ContextFiles are the ticket's context_files; Content is only set when
+	// inline_context_files is enabled
+	ContextFiles []ContextFile
+	// Criteria are the ticket's acceptance criteria
+	Criteria []models.ChecklistItem
+	// AgentInstructions is the ticket's agent_instructions; they are also
+	// appended to every rendered prompt, so templates rarely need it
+	AgentInstructions string
 }
 
+// ContextFile is a code file referenced by a ticket.
+type ContextFile struct {
+	Path    string
+	Content string
+}
+
+// maxInlineContextBytes caps how much of a context file is inlined into a
+// prompt, in bytes.
+const maxInlineContextBytes = 16 * 1024
+
 // BatchPromptData holds data for batch ticket template rendering.
 type BatchPromptData struct {
 	Tickets     []TicketPromptData
 	AgentMdPath string
+	// Part and Parts identify the chunk when a batch is split (1-based; Parts is 1 when unsplit)
+	Part  int
+	Parts int
 }
 
 // buildTicketPromptData creates template data from a ticket.
 func (m *Model) buildTicketPromptData(ticket *models.Ticket) TicketPromptData {
-	// Project root is parent of .kanban directory
-	projectRoot := filepath.Dir(m.config.KanbanDir)
-	relativePath, err := filepath.Rel(projectRoot, ticket.FilePath)
+	// Build paths relative to project root, to the columns AGENT.md names
+	filename := filepath.Base(ticket.FilePath)
+	donePath := filepath.Join(m.config.ColumnPath(m.config.AgentDoneColumn()), filename)
+	doingPath := filepath.Join(m.config.ColumnPath(m.config.AgentWorkColumn()), filename)
+
+	return TicketPromptData{
+		Title:             ticket.Title,
+		Tags:              strings.Join(ticket.Tags, ", "),
+		Content:           ticket.Content,
+		TicketPath:        m.projectPath(ticket.FilePath),
+		DonePath:          m.projectPath(donePath),
+		DoingPath:         m.projectPath(doingPath),
+		AgentMdPath:       m.agentMdPath(),
+		ContextFiles:      m.buildContextFiles(ticket, m.projectRoot()),
+		Criteria:          ticket.AcceptanceCriteria(),
+		AgentInstructions: strings.TrimSpace(ticket.AgentInstructions),
+	}
+}
+
+// projectRoot returns the project directory: the parent of the kanban directory.
+func (m *Model) projectRoot() string {
+	return filepath.Dir(m.config.KanbanDir)
+}
+
+// projectPath returns path relative to the project root, or path itself
+// when it has no relative form.
+func (m *Model) projectPath(path string) string {
+	rel, err := filepath.Rel(m.projectRoot(), path)
 	if err != nil {
-		relativePath = ticket.FilePath
+		return path
 	}
+	return rel
+}
 
-	// Build paths relative to project root
-	filename := filepath.Base(ticket.FilePath)
-	donePath := filepath.Join(".kanban", "done", filename)
-	doingPath := filepath.Join(".kanban", "doing", filename)
-	agentMdPath := filepath.Join(".kanban", "AGENT.md")
+// agentMdPath returns the path of AGENT.md relative to the project root.
+func (m *Model) agentMdPath() string {
+	return m.projectPath(filepath.Join(m.config.KanbanDir, config.AgentMdFile))
+}
 
-	return TicketPromptData{
-		Title:       ticket.Title,
-		Tags:        strings.Join(ticket.Tags, ", "),
-		Content:     ticket.Content,
-		TicketPath:  relativePath,
-		DonePath:    donePath,
-		DoingPath:   doingPath,
-		AgentMdPath: agentMdPath,
+// buildContextFiles resolves a ticket's context files, reading their contents
+// when inlining is enabled. Unreadable files are still referenced by path.
+func (m *Model) buildContextFiles(ticket *models.Ticket, projectRoot string) []ContextFile {
+	var files []ContextFile
+	for _, p := range ticket.ContextFiles {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		cf := ContextFile{Path: filepath.ToSlash(p)}
+		if m.config.InlineContextFiles {
+			fullPath := p
+			if !filepath.IsAbs(fullPath) {
+				fullPath = filepath.Join(projectRoot, p)
+			}
+			if content, err := readContextFile(fullPath); err == nil {
+				cf.Content = strings.TrimRight(content, "\n")
+			}
+		}
+		files = append(files, cf)
 	}
+	return files
+}
+
+// readContextFile reads at most maxInlineContextBytes of the file at path,
+// cutting before a partial character and marking the cut.
+func readContextFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// One byte past the cap tells whether there is more
+	data, err := io.ReadAll(io.LimitReader(f, maxInlineContextBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) <= maxInlineContextBytes {
+		return string(data), nil
+	}
+	cut := maxInlineContextBytes
+	for cut > maxInlineContextBytes-utf8.UTFMax && !utf8.RuneStart(data[cut]) {
+		cut--
+	}
+	return string(data[:cut]) + "\n... (truncated)", nil
+}
+
+// parsePrompt parses a prompt template along with the partials in the
+// prompts directory: each prompts/<name>.tmpl can be included with
+// {{template "<name>" .}}, so prompts share blocks such as guidelines.
+func (m *Model) parsePrompt(name, text string) (*template.Template, error) {
+	tmpl := template.New(name)
+	paths, err := filepath.Glob(filepath.Join(m.config.PromptsDir(), "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		partial := strings.TrimSuffix(filepath.Base(path), ".tmpl")
+		// Drop the file's final newline, so an include reads like inline text
+		body := strings.TrimSuffix(string(data), "\n")
+		if _, err := tmpl.New(partial).Parse(body); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filepath.Base(path), err)
+		}
+	}
+	if _, err := tmpl.Parse(text); err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	return tmpl, nil
 }
 
 // renderSingleTicketPrompt renders the single ticket template.
 func (m *Model) renderSingleTicketPrompt(ticket *models.Ticket) (string, error) {
-	tmpl, err := template.New("single").Parse(m.config.SingleTicketPrompt)
+	tmpl, err := m.parsePrompt("single", m.config.SingleTicketPrompt)
 	if err != nil {
-		return "", fmt.Errorf("parsing template: %w", err)
+		return "", err
 	}
 
 	data := m.buildTicketPromptData(ticket)
@@ -68,14 +184,31 @@ func (m *Model) renderSingleTicketPrompt(ticket *models.Ticket) (string, error)
 		return "", fmt.Errorf("executing template: %w", err)
 	}
 
-	return buf.String(), nil
+	return appendAgentInstructions(buf.String(), data), nil
 }
 
-// renderBatchTicketPrompt renders the batch ticket template.
-func (m *Model) renderBatchTicketPrompt(tickets []*models.Ticket) (string, error) {
-	tmpl, err := template.New("batch").Parse(m.config.BatchTicketPrompt)
+// renderVerifyPrompt renders the acceptance criteria verification template.
+func (m *Model) renderVerifyPrompt(ticket *models.Ticket) (string, error) {
+	tmpl, err := m.parsePrompt("verify", m.config.VerifyPrompt)
 	if err != nil {
-		return "", fmt.Errorf("parsing template: %w", err)
+		return "", err
+	}
+
+	data := m.buildTicketPromptData(ticket)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+
+	return appendAgentInstructions(buf.String(), data), nil
+}
+
+// renderBatchTicketPrompt renders the batch ticket template for one chunk of a batch.
+func (m *Model) renderBatchTicketPrompt(tickets []*models.Ticket, part, parts int) (string, error) {
+	tmpl, err := m.parsePrompt("batch", m.config.BatchTicketPrompt)
+	if err != nil {
+		return "", err
 	}
 
 	var ticketData []TicketPromptData
@@ -83,10 +216,11 @@ func (m *Model) renderBatchTicketPrompt(tickets []*models.Ticket) (string, error
 		ticketData = append(ticketData, m.buildTicketPromptData(t))
 	}
 
-	agentMdPath := filepath.Join(".kanban", "AGENT.md")
 	data := BatchPromptData{
 		Tickets:     ticketData,
-		AgentMdPath: agentMdPath,
+		AgentMdPath: m.agentMdPath(),
+		Part:        part,
+		Parts:       parts,
 	}
 
 	var buf bytes.Buffer
@@ -94,10 +228,129 @@ func (m *Model) renderBatchTicketPrompt(tickets []*models.Ticket) (string, error
 		return "", fmt.Errorf("executing template: %w", err)
 	}
 
-	return buf.String(), nil
+	prompt := buf.String()
+	for _, t := range ticketData {
+		prompt = appendAgentInstructions(prompt, t)
+	}
+	return prompt, nil
+}
+
+// appendAgentInstructions appends a ticket's agent_instructions to a
+// rendered prompt, naming the ticket so they stay apart in batch prompts.
+func appendAgentInstructions(prompt string, data TicketPromptData) string {
+	if data.AgentInstructions == "" {
+		return prompt
+	}
+	return strings.TrimRight(prompt, "\n") +
+		fmt.Sprintf("\n\nAdditional instructions for @%s:\n\n%s\n", data.TicketPath, data.AgentInstructions)
+}
+
+// chunkTickets splits tickets into consecutive chunks of at most size tickets.
+// A size of zero or less returns a single chunk.
+func chunkTickets(tickets []*models.Ticket, size int) [][]*models.Ticket {
+	if size <= 0 || len(tickets) <= size {
+		return [][]*models.Ticket{tickets}
+	}
+	var chunks [][]*models.Ticket
+	for start := 0; start < len(tickets); start += size {
+		end := min(start+size, len(tickets))
+		chunks = append(chunks, tickets[start:end])
+	}
+	return chunks
+}
+
+// renderBatchChunks renders one batch prompt per chunk of tickets.
+func (m *Model) renderBatchChunks(tickets []*models.Ticket) ([]string, error) {
+	chunks := chunkTickets(tickets, m.config.BatchChunkSize)
+	prompts := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		prompt, err := m.renderBatchTicketPrompt(chunk, i+1, len(chunks))
+		if err != nil {
+			return nil, err
+		}
+		prompts = append(prompts, prompt)
+	}
+	return prompts, nil
+}
+
+// writeBatchChunks writes chunked prompts to numbered files in the prompts
+// directory, replacing the chunk files of an earlier batch, and returns the
+// directory path.
+func (m *Model) writeBatchChunks(prompts []string) (string, error) {
+	dir := m.config.PromptsDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	stale, err := filepath.Glob(filepath.Join(dir, "batch-*-of-*.md"))
+	if err != nil {
+		return "", err
+	}
+	for _, path := range stale {
+		if err := os.Remove(path); err != nil {
+			return "", err
+		}
+	}
+	for i, prompt := range prompts {
+		name := fmt.Sprintf("batch-%02d-of-%02d.md", i+1, len(prompts))
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(prompt), 0644); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// estimateTokens approximates the token count of text using the configured
+// characters-per-token heuristic.
+func (m *Model) estimateTokens(text string) int {
+	charsPerToken := m.config.CharsPerToken
+	if charsPerToken <= 0 {
+		charsPerToken = config.DefaultCharsPerToken
+	}
+	return int(math.Ceil(float64(utf8.RuneCountInString(text)) / charsPerToken))
+}
+
+// formatTokens renders a token count compactly, e.g. "850" or "12.3k".
+func formatTokens(n int) string {
+	if n < 1000 {
+		return fmt.Sprintf("%d", n)
+	}
+	return fmt.Sprintf("%.1fk", float64(n)/1000)
+}
+
+// promptSizeNote describes the estimated size of a prompt for status messages,
+// flagging prompts likely to exceed typical context windows.
+func (m *Model) promptSizeNote(prompt string) string {
+	tokens := m.estimateTokens(prompt)
+	if tokens > m.config.PromptTokenWarning {
+		return fmt.Sprintf("~%s tokens, may exceed context window!", formatTokens(tokens))
+	}
+	return fmt.Sprintf("~%s tokens", formatTokens(tokens))
+}
+
+// Clipboard reads and writes the clipboard used for prompts and pasting.
+type Clipboard interface {
+	Copy(text string) error
+	Paste() (string, error)
+}
+
+// systemClipboard is the platform clipboard.
+type systemClipboard struct{}
+
+func (systemClipboard) Copy(text string) error { return platform.Copy(text) }
+func (systemClipboard) Paste() (string, error) { return platform.Paste() }
+
+// SetClipboard replaces the system clipboard, e.g. with an in-memory one in
+// tests.
+func (m *Model) SetClipboard(c Clipboard) {
+	m.clipboard = c
+}
+
+// copyToClipboard copies text to the clipboard.
+func (m *Model) copyToClipboard(text string) error {
+	return m.clipboard.Copy(text)
 }
 
-// copyToClipboard copies text to the system clipboard.
-func copyToClipboard(text string) error {
-	return clipboard.WriteAll(text)
+// readClipboard returns the clipboard's text.
+func (m *Model) readClipboard() (string, error) {
+	return m.clipboard.Paste()
 }
@@ -0,0 +1,575 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/agent"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// queueState is the lifecycle of a queued agent run.
+type queueState int
+
+const (
+	queueQueued queueState = iota
+	queueRunning
+	queueDone
+	queueFailed
+)
+
+func (s queueState) String() string {
+	switch s {
+	case queueRunning:
+		return "running"
+	case queueDone:
+		return "done"
+	case queueFailed:
+		return "failed"
+	default:
+		return "queued"
+	}
+}
+
+// queueItem is a ticket waiting for, or dispatched to, the agent command.
+// Tickets are tracked by file path, which moveTicketTo keeps current as the
+// ticket moves between columns while the agent works.
+type queueItem struct {
+	id       int
+	path     string
+	title    string
+	attempt  int
+	state    queueState
+	logPath  string
+	err      error
+	started  time.Time
+	finished time.Time
+	cancel   context.CancelFunc
+}
+
+// queueDoneMsg reports a finished agent run.
+type queueDoneMsg struct {
+	id  int
+	err error
+}
+
+// queueTickMsg refreshes elapsed times while agents are running.
+type queueTickMsg struct{}
+
+// failedTag marks tickets whose last agent run failed.
+const failedTag = "failed"
+
+// queueTickInterval is how often the queue view refreshes elapsed times.
+const queueTickInterval = time.Second
+
+// dispatchSelected queues the selected tickets, or the highlighted one.
+func (m *Model) dispatchSelected() tea.Cmd {
+	tickets := m.markedTickets()
+	if len(tickets) == 0 {
+		if ticket := m.getSelectedTicket(); ticket != nil {
+			tickets = []*models.Ticket{ticket}
+		}
+	}
+	return m.enqueue(tickets)
+}
+
+// dispatchTodo queues every ticket in the first column.
+func (m *Model) dispatchTodo() tea.Cmd {
+	if len(m.columns) == 0 {
+		return nil
+	}
+	return m.enqueue(m.columns[0].Tickets)
+}
+
+// enqueue adds tickets that are not already queued or running to the queue
+// and starts as many runs as the concurrency limit allows.
+func (m *Model) enqueue(tickets []*models.Ticket) tea.Cmd {
+	if m.config.AgentCommand == "" {
+		m.setStatus("Set agent_command in config to dispatch tickets")
+		return nil
+	}
+
+	added := 0
+	for _, t := range tickets {
+		if m.queuedItem(t.FilePath) != nil {
+			continue
+		}
+		m.queueSeq++
+		m.queue = append(m.queue, &queueItem{
+			id:    m.queueSeq,
+			path:  t.FilePath,
+			title: t.Title,
+		})
+		added++
+	}
+	if added == 0 {
+		m.setStatus("Nothing to dispatch")
+		return nil
+	}
+	m.clearMarks()
+
+	cmd := m.startQueued()
+	m.setSuccess(fmt.Sprintf("Dispatched %d ticket(s): %s", added, m.queueSummary()))
+	return cmd
+}
+
+// queuedItem returns the queued or running item for a ticket, if any.
+func (m *Model) queuedItem(path string) *queueItem {
+	for _, item := range m.queue {
+		if item.path == path &&
+			(item.state == queueQueued || item.state == queueRunning) {
+			return item
+		}
+	}
+	return nil
+}
+
+// startQueued starts queued runs up to agent_concurrency.
+func (m *Model) startQueued() tea.Cmd {
+	running := 0
+	for _, item := range m.queue {
+		if item.state == queueRunning {
+			running++
+		}
+	}
+	wasIdle := running == 0
+
+	var cmds []tea.Cmd
+	for _, item := range m.queue {
+		if running >= m.config.AgentConcurrency {
+			break
+		}
+		if item.state != queueQueued {
+			continue
+		}
+		if cmd := m.startRun(item); cmd != nil {
+			cmds = append(cmds, cmd)
+			running++
+		}
+	}
+	if wasIdle && running > 0 {
+		cmds = append(cmds, queueTick())
+	}
+	return tea.Batch(cmds...)
+}
+
+// startRun moves the item's ticket out of the first column and runs the
+// agent on its prompt.
+func (m *Model) startRun(item *queueItem) tea.Cmd {
+	ticket := m.queueTicket(item)
+	if ticket == nil {
+		m.finishItem(item, fmt.Errorf("ticket not found"))
+		return nil
+	}
+
+	// Count the attempt and clear the result of a previous failed run
+	ticket.Attempts++
+	ticket.RemoveTag(failedTag)
+	if err := ticket.Save(); err != nil {
+		m.finishItem(item, err)
+		return nil
+	}
+	item.attempt = ticket.Attempts
+
+	// The agent works on the ticket now; move it to the second column
+	startDir := m.columns[0].Config.Dir
+	if len(m.columns) > 2 && (ticket.Column == startDir || ticket.Column == m.failedColumn()) {
+		to := m.columns[1].Config.Dir
+		reason := m.runReason(ticket.Column, to, fmt.Sprintf("Agent run %d started", item.attempt))
+		if err := m.moveTicketTo(ticket, to, reason, nil); err != nil {
+			m.finishItem(item, err)
+			return nil
+		}
+		m.loadAllTickets()
+	}
+
+	prompt, err := m.renderSingleTicketPrompt(ticket)
+	if err != nil {
+		m.finishItem(item, err)
+		return nil
+	}
+
+	// Agents work in the ticket's worktree when it has one
+	dir := ticket.Worktree
+	if dir == "" {
+		dir = filepath.Dir(ticket.KanbanDir())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	item.state = queueRunning
+	item.started = time.Now()
+	item.cancel = cancel
+	item.logPath = agent.NewSessionPath(ticket.KanbanDir(), ticket.Slug(), item.started)
+
+	command, logPath, id := m.config.AgentCommand, item.logPath, item.id
+	slog.Info("agent run started", "ticket", filepath.Base(item.path), "attempt", item.attempt, "dir", dir, "log", logPath)
+	return func() tea.Msg {
+		// A run that starts after quit has a cancelled context and exits
+		// at once, so counting it here cannot keep WaitRuns waiting
+		m.runs.Add(1)
+		defer m.runs.Done()
+		err := agent.Run(ctx, command, dir, prompt, logPath)
+		return queueDoneMsg{id: id, err: err}
+	}
+}
+
+// handleQueueDone records a finished run, moves its ticket according to the
+// outcome and starts the next queued runs.
+func (m *Model) handleQueueDone(msg queueDoneMsg) tea.Cmd {
+	for _, item := range m.queue {
+		if item.id != msg.id {
+			continue
+		}
+		m.finishItem(item, msg.err)
+		slog.Info("agent run finished", "ticket", filepath.Base(item.path), "attempt", item.attempt, "duration", item.finished.Sub(item.started), "err", msg.err)
+		if ticket := m.queueTicket(item); ticket != nil {
+			// The agent may have edited the ticket before the watcher picked
+			// it up, so work on the file rather than the loaded copy
+			if fresh, err := models.ParseTicket(ticket.FilePath); err == nil {
+				ticket = fresh
+			}
+			m.moveAfterRun(ticket, msg.err)
+		}
+		if msg.err != nil {
+			m.setError(fmt.Sprintf("Agent failed on %s (attempt %d): %v", item.title, item.attempt, msg.err))
+		} else {
+			m.setSuccess("Agent finished: " + item.title)
+		}
+		break
+	}
+	return m.startQueued()
+}
+
+// moveAfterRun moves a ticket to the review column, or the done column,
+// after a successful run (unless the agent already did), or tags it failed
+// and moves it to the failed column after a run that ended with runErr.
+func (m *Model) moveAfterRun(ticket *models.Ticket, runErr error) {
+	defer m.loadAllTickets()
+
+	ok := runErr == nil
+	if !ok {
+		ticket.AddTag(failedTag)
+		if err := ticket.Save(); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return
+		}
+	}
+	if len(m.columns) < 2 {
+		return
+	}
+	target := m.failedColumn()
+	if ok {
+		target = m.doneColumn()
+		if i := m.reviewColumnIndex(); i >= 0 {
+			target = m.columns[i].Config.Dir
+		}
+	}
+	if ticket.Column == target {
+		return
+	}
+	reason := "Agent run finished"
+	if !ok {
+		reason = fmt.Sprintf("Agent run failed: %v", runErr)
+	}
+	if err := m.moveTicketTo(ticket, target, m.runReason(ticket.Column, target, reason), nil); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+	}
+}
+
+// runReason returns reason for an agent run's move between the columns from
+// and to when move_reasons asks for one there, and "" otherwise, so the
+// queue's automatic moves satisfy the same rule as manual ones.
+func (m *Model) runReason(from, to, reason string) string {
+	if m.config.ReasonRequired(from, to) {
+		return reason
+	}
+	return ""
+}
+
+// failedColumn returns the column directory failed tickets move to:
+// failed_column when it names a column, otherwise the first column.
+func (m *Model) failedColumn() string {
+	for _, col := range m.columns {
+		if col.Config.Dir == m.config.FailedColumn {
+			return col.Config.Dir
+		}
+	}
+	return m.columns[0].Config.Dir
+}
+
+// doneColumn returns the column directory successful runs move to: the
+// last column that is not the failed column.
+func (m *Model) doneColumn() string {
+	return m.config.DoneColumn()
+}
+
+// retryQueueItem re-dispatches the ticket of a failed run.
+func (m *Model) retryQueueItem(item *queueItem) tea.Cmd {
+	if item.state != queueFailed {
+		m.setStatus("Only failed runs can be retried")
+		return nil
+	}
+	ticket := m.queueTicket(item)
+	if ticket == nil {
+		m.setStatus("Ticket not found: " + item.title)
+		return nil
+	}
+	return m.enqueue([]*models.Ticket{ticket})
+}
+
+// finishItem marks an item done or failed.
+func (m *Model) finishItem(item *queueItem, err error) {
+	item.err = err
+	item.finished = time.Now()
+	item.state = queueDone
+	if err != nil {
+		item.state = queueFailed
+	}
+	if item.cancel != nil {
+		item.cancel()
+		item.cancel = nil
+	}
+}
+
+// findTicketByPath returns the loaded ticket stored at path.
+func (m *Model) findTicketByPath(path string) *models.Ticket {
+	for _, t := range m.allTickets() {
+		if t.FilePath == path {
+			return t
+		}
+	}
+	return nil
+}
+
+// queueTicket returns the loaded ticket of a queue item. A ticket the agent
+// moved itself is followed by filename, as long as only one ticket of the
+// board has it.
+func (m *Model) queueTicket(item *queueItem) *models.Ticket {
+	if t := m.findTicketByPath(item.path); t != nil {
+		return t
+	}
+	kanbanDir, name := filepath.Dir(filepath.Dir(item.path)), filepath.Base(item.path)
+	var found *models.Ticket
+	for _, t := range m.allTickets() {
+		if t.KanbanDir() == kanbanDir && t.Filename() == name {
+			if found != nil {
+				return nil
+			}
+			found = t
+		}
+	}
+	if found != nil {
+		item.path = found.FilePath
+	}
+	return found
+}
+
+// followMove points the queue items of the ticket that moved from oldPath
+// at its new path.
+func (m *Model) followMove(oldPath, newPath string) {
+	for _, item := range m.queue {
+		if item.path == oldPath {
+			item.path = newPath
+		}
+	}
+}
+
+// cancelRuns stops every running agent, e.g. when the program quits.
+func (m *Model) cancelRuns() {
+	for _, item := range m.queue {
+		if item.state == queueRunning && item.cancel != nil {
+			item.cancel()
+		}
+	}
+}
+
+// WaitRuns blocks until the agent processes cancelled on quit have exited.
+func (m *Model) WaitRuns() {
+	m.runs.Wait()
+}
+
+// queueSummary counts items by state, e.g. "2 running, 3 queued".
+func (m *Model) queueSummary() string {
+	counts := make(map[queueState]int)
+	for _, item := range m.queue {
+		counts[item.state]++
+	}
+	var parts []string
+	for _, state := range []queueState{queueRunning, queueQueued, queueDone, queueFailed} {
+		if counts[state] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[state], state))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// queueTick schedules the next elapsed time refresh.
+func queueTick() tea.Cmd {
+	return tea.Tick(queueTickInterval, func(time.Time) tea.Msg { return queueTickMsg{} })
+}
+
+// handleQueueTick keeps ticking while runs are in progress.
+func (m *Model) handleQueueTick() tea.Cmd {
+	for _, item := range m.queue {
+		if item.state == queueRunning {
+			return queueTick()
+		}
+	}
+	return nil
+}
+
+// openQueue shows the agent queue.
+func (m *Model) openQueue() {
+	m.queueIndex = max(min(m.queueIndex, len(m.queue)-1), 0)
+	m.viewMode = ViewQueue
+}
+
+// handleQueueKeys handles keys in the queue view.
+func (m *Model) handleQueueKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q", "Q":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.queueIndex < len(m.queue)-1 {
+			m.queueIndex++
+		}
+
+	case "k", "up":
+		if m.queueIndex > 0 {
+			m.queueIndex--
+		}
+
+	case "enter":
+		if m.queueIndex >= len(m.queue) || m.queue[m.queueIndex].logPath == "" {
+			return nil
+		}
+		data, err := os.ReadFile(m.queue[m.queueIndex].logPath)
+		if err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		m.openPager("Agent Session  ·  "+m.queue[m.queueIndex].title, string(data), false)
+
+	case "x":
+		// Cancel a running item or drop a queued one
+		if m.queueIndex >= len(m.queue) {
+			return nil
+		}
+		item := m.queue[m.queueIndex]
+		switch item.state {
+		case queueRunning:
+			item.cancel()
+			m.setStatus("Cancelling: " + item.title)
+		case queueQueued:
+			m.queue = append(m.queue[:m.queueIndex], m.queue[m.queueIndex+1:]...)
+			m.queueIndex = max(min(m.queueIndex, len(m.queue)-1), 0)
+		}
+
+	case "r":
+		if m.queueIndex < len(m.queue) {
+			return m.retryQueueItem(m.queue[m.queueIndex])
+		}
+
+	case "c":
+		// Clear finished items
+		var active []*queueItem
+		for _, item := range m.queue {
+			if item.state == queueQueued || item.state == queueRunning {
+				active = append(active, item)
+			}
+		}
+		m.queue = active
+		m.queueIndex = max(min(m.queueIndex, len(m.queue)-1), 0)
+	}
+	return nil
+}
+
+// queueStateColor returns the color for a queue state.
+func queueStateColor(state queueState) lipgloss.Color {
+	switch state {
+	case queueRunning:
+		return GruvboxYellow
+	case queueDone:
+		return GruvboxGreen
+	case queueFailed:
+		return GruvboxRed
+	default:
+		return GruvboxGray
+	}
+}
+
+// renderQueue renders the agent queue.
+func (m *Model) renderQueue() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Agent Queue")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	if len(m.queue) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("No agent runs. Press a on a ticket or A for all todo tickets."))
+		b.WriteString("\n\n")
+	} else {
+		b.WriteString(m.styles.HelpDesc.Render(fmt.Sprintf("%s  ·  concurrency %d", m.queueSummary(), m.config.AgentConcurrency)))
+		b.WriteString("\n\n")
+		now := time.Now()
+		for i, item := range m.queue {
+			state := lipgloss.NewStyle().Foreground(queueStateColor(item.state)).Render(fmt.Sprintf("%-8s", item.state))
+			var elapsed string
+			switch item.state {
+			case queueRunning:
+				elapsed = formatDuration(now.Sub(item.started))
+			case queueDone, queueFailed:
+				if !item.started.IsZero() {
+					elapsed = formatDuration(item.finished.Sub(item.started))
+				}
+			}
+			title := runewidth.Truncate(item.title, contentWidth-40, "...")
+			if item.attempt > 1 {
+				elapsed = strings.TrimSpace(fmt.Sprintf("%s  attempt %d", elapsed, item.attempt))
+			}
+			line := fmt.Sprintf("%s %s  %s", state, title, m.styles.TicketDate.Render(elapsed))
+			if i == m.queueIndex {
+				b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+			} else {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n")
+			if item.err != nil && i == m.queueIndex {
+				b.WriteString("    " + m.styles.HelpDesc.Render(item.err.Error()) + "\n")
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "transcript"},
+		{"r", "retry"},
+		{"x", "cancel"},
+		{"c", "clear finished"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// startQuickEdit edits the selected card's title and tags in place on the board.
+func (m *Model) startQuickEdit() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return nil
+	}
+
+	m.editingTicket = ticket
+	m.titleInput.SetValue(ticket.Title)
+	m.titleInput.CursorEnd()
+	m.tagsInput.SetValue(strings.Join(ticket.Tags, ", "))
+	m.tagsInput.CursorEnd()
+	m.editorFocus = 0
+	m.updateEditorFocus()
+	m.viewMode = ViewQuickEdit
+	return textinput.Blink
+}
+
+// handleQuickEditKeys handles keys while quick-editing a card.
+func (m *Model) handleQuickEditKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewBoard
+		m.resetEditorInputs()
+
+	case "tab", "shift+tab":
+		// Toggle between title and tags
+		m.editorFocus = 1 - m.editorFocus
+		m.updateEditorFocus()
+
+	case "enter":
+		return m.saveQuickEdit()
+	}
+	return nil
+}
+
+// saveQuickEdit writes the edited title and tags, leaving the content untouched.
+func (m *Model) saveQuickEdit() tea.Cmd {
+	ticket := m.editingTicket
+	if ticket == nil {
+		return nil
+	}
+
+	title := strings.TrimSpace(m.titleInput.Value())
+	if title == "" {
+		m.setError("Error: Title cannot be empty")
+		return nil
+	}
+
+	ticket.Title = title
+	ticket.Tags = m.parseTagsInput()
+	if err := ticket.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+	} else {
+		m.setSuccess(fmt.Sprintf("Updated: %s", title))
+	}
+
+	m.viewMode = ViewBoard
+	m.resetEditorInputs()
+	m.loadAllTickets()
+	m.selectTicketByPath(ticket.FilePath)
+	return nil
+}
+
+// renderQuickEditCard renders the selected card with editable title and tags.
+func (m *Model) renderQuickEditCard(width int) string {
+	m.titleInput.Width = width - 6
+	m.tagsInput.Width = width - 6
+
+	var b strings.Builder
+	b.WriteString(m.titleInput.View())
+	b.WriteString("\n")
+	b.WriteString(m.tagsInput.View())
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("Enter save · Tab field · Esc cancel"))
+
+	return m.styles.TicketSelected.Width(width).Render(b.String())
+}
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+	"github.com/user/kanban-tui/internal/config"
+)
+
+// isConfigFile reports whether path is the loaded config file.
+func (m *Model) isConfigFile(path string) bool {
+	return m.config.Path != "" && filepath.Clean(path) == filepath.Clean(m.config.Path)
+}
+
+// reloadConfig applies an edited config file without restarting: columns
+// are reconciled (keeping the selection on the same column directory when
+// it still exists), column directories created and the watcher replaced.
+// The board directory itself only changes on restart or with B.
+func (m *Model) reloadConfig(op fsnotify.Op) tea.Cmd {
+	// Editors that replace the file on save report a Create afterwards
+	if op&(fsnotify.Create|fsnotify.Write) == 0 || !config.Exists(m.config.Path) {
+		return m.watcherCmd()
+	}
+
+	cfg, err := config.Load(m.config.Path)
+	if err != nil {
+		m.setError(fmt.Sprintf("Config not reloaded: %v", err))
+		return m.watcherCmd()
+	}
+	cfg.KanbanDir = m.config.KanbanDir
+	if len(cfg.BoardRoots) == 0 {
+		cfg.BoardRoots = m.config.BoardRoots
+	}
+	if err := cfg.EnsureDirectories(); err != nil {
+		m.setError(fmt.Sprintf("Config not reloaded: %v", err))
+		return m.watcherCmd()
+	}
+
+	if !ApplyTheme(cfg.Theme) {
+		m.setError(fmt.Sprintf("Config not reloaded: unknown theme %q", cfg.Theme))
+		return m.watcherCmd()
+	}
+
+	prev := m.config
+	m.config = cfg
+	m.styles = DefaultStyles()
+	// Cached cards may show tag colors that changed
+	m.renders = nil
+	w, err := m.newWorkspaceWatcher()
+	if err != nil {
+		m.config = prev
+		ApplyTheme(prev.Theme)
+		m.styles = DefaultStyles()
+		m.setError(fmt.Sprintf("Config not reloaded: %v", err))
+		return m.watcherCmd()
+	}
+	m.watcher.Close()
+	m.watcher = w
+
+	// Keep the active column by directory
+	activeDir := ""
+	if m.activeColumn < len(m.columns) {
+		activeDir = m.columns[m.activeColumn].Config.Dir
+	}
+	m.initColumns()
+	m.activeColumn = 0
+	for i, col := range m.columns {
+		if col.Config.Dir == activeDir {
+			m.activeColumn = i
+		}
+	}
+	m.moveTarget = m.activeColumn
+	if m.viewMode == ViewMoveTicket || m.viewMode == ViewChecklist {
+		m.viewMode = ViewBoard
+	}
+	m.pendingChunks = nil
+	m.loadSpellChecker()
+	m.loadAllTickets()
+	m.activeTicket = min(m.activeTicket, max(len(m.getFilteredTickets(m.activeColumn))-1, 0))
+
+	slog.Info("config reloaded", "path", cfg.Path, "columns", len(cfg.Columns))
+	m.setSuccess("Config reloaded")
+	return m.watcherCmd()
+}
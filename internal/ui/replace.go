@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/replace"
+)
+
+// maxReplaceLines is the number of changed lines previewed per ticket.
+const maxReplaceLines = 8
+
+// newReplaceInput creates the input for the search and replacement text.
+func newReplaceInput() textinput.Model {
+	ri := textinput.New()
+	ri.CharLimit = 200
+	ri.Width = 50
+	return ri
+}
+
+// startReplace asks for the text to find across the board.
+func (m *Model) startReplace() tea.Cmd {
+	m.replaceFind = ""
+	m.replaceStarted = false
+	m.replaceInput.Placeholder = "Find"
+	m.replaceInput.SetValue("")
+	m.replaceInput.Focus()
+	m.viewMode = ViewReplace
+	return textinput.Blink
+}
+
+// handleReplaceKeys handles keys while entering the search and replacement
+// text; ctrl+r and ctrl+t toggle regexp and case-insensitive matching.
+func (m *Model) handleReplaceKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewBoard
+		m.replaceInput.Blur()
+
+	case "ctrl+r":
+		m.replaceOpts.Regexp = !m.replaceOpts.Regexp
+
+	case "ctrl+t":
+		m.replaceOpts.IgnoreCase = !m.replaceOpts.IgnoreCase
+
+	case "enter":
+		value := m.replaceInput.Value()
+		if !m.replaceStarted {
+			if value == "" {
+				return nil
+			}
+			m.replaceFind = value
+			m.replaceStarted = true
+			m.replaceInput.Placeholder = "Replace with (may be empty)"
+			m.replaceInput.SetValue("")
+			return nil
+		}
+		m.replaceInput.Blur()
+		m.viewMode = ViewBoard
+		m.previewReplace(m.replaceFind, value)
+	}
+	return nil
+}
+
+// previewReplace finds the tickets the replacement changes and steps
+// through them for confirmation.
+func (m *Model) previewReplace(find, replacement string) {
+	r, err := replace.New(find, replacement, m.replaceOpts)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	changes, err := r.Find(m.config)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if len(changes) == 0 {
+		m.setStatus(fmt.Sprintf("No tickets contain %s", find))
+		return
+	}
+	m.replaceChanges = changes
+	m.replaceIndex = 0
+	m.replaceApplied = 0
+	m.viewMode = ViewReplacePreview
+}
+
+// handleReplacePreviewKeys handles the per-ticket confirmation: y writes
+// the shown ticket, n skips it, a writes it and all remaining ones.
+func (m *Model) handleReplacePreviewKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "y", "enter":
+		if !m.applyReplaceChange(m.replaceIndex) {
+			m.finishReplace()
+			return nil
+		}
+		m.nextReplaceChange()
+
+	case "n":
+		m.nextReplaceChange()
+
+	case "a":
+		for i := m.replaceIndex; i < len(m.replaceChanges); i++ {
+			if !m.applyReplaceChange(i) {
+				break
+			}
+		}
+		m.finishReplace()
+
+	case "esc", "q":
+		m.finishReplace()
+	}
+	return nil
+}
+
+// applyReplaceChange writes the i-th change, reporting false on an error.
+func (m *Model) applyReplaceChange(i int) bool {
+	if err := m.replaceChanges[i].Apply(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return false
+	}
+	m.replaceApplied++
+	return true
+}
+
+// nextReplaceChange shows the next change, finishing after the last one.
+func (m *Model) nextReplaceChange() {
+	m.replaceIndex++
+	if m.replaceIndex >= len(m.replaceChanges) {
+		m.finishReplace()
+	}
+}
+
+// finishReplace returns to the board and reloads the rewritten tickets.
+func (m *Model) finishReplace() {
+	if m.replaceApplied > 0 {
+		m.loadAllTickets()
+		m.setSuccess(fmt.Sprintf("Replaced in %d of %d tickets", m.replaceApplied, len(m.replaceChanges)))
+	}
+	m.replaceChanges = nil
+	m.viewMode = ViewBoard
+}
+
+// replaceOptionsText describes the active matching options.
+func (m *Model) replaceOptionsText() string {
+	var opts []string
+	if m.replaceOpts.Regexp {
+		opts = append(opts, "regexp")
+	}
+	if m.replaceOpts.IgnoreCase {
+		opts = append(opts, "ignore case")
+	}
+	if len(opts) == 0 {
+		return "plain text, match case"
+	}
+	return strings.Join(opts, ", ")
+}
+
+// renderReplaceScreen renders the find and replace prompt as a centered
+// modal.
+func (m *Model) renderReplaceScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Find and Replace"))
+	b.WriteString("\n\n")
+	if m.replaceStarted {
+		b.WriteString(fmt.Sprintf("Replace %s with:", m.replaceFind))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.replaceInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("Matching: " + m.replaceOptionsText()))
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("Enter to continue, Ctrl+R regexp, Ctrl+T ignore case, Esc to cancel"))
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString("\n\n")
+		b.WriteString(toasts)
+	}
+
+	modal := m.styles.Modal.Width(70).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// renderReplacePreview renders the changes to the current ticket.
+func (m *Model) renderReplacePreview() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+	c := m.replaceChanges[m.replaceIndex]
+
+	header := m.styles.Header.Width(contentWidth).Render(
+		fmt.Sprintf("  Replace  ·  ticket %d of %d", m.replaceIndex+1, len(m.replaceChanges)))
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	rel, err := filepath.Rel(m.config.KanbanDir, c.Ticket.FilePath)
+	if err != nil {
+		rel = c.Ticket.FilePath
+	}
+	b.WriteString(m.styles.ModalTitle.Render(c.Ticket.Title))
+	b.WriteString("  ")
+	b.WriteString(m.styles.HelpDesc.Render(rel))
+	b.WriteString("\n\n")
+
+	removed := lipgloss.NewStyle().Foreground(ColorDanger)
+	added := lipgloss.NewStyle().Foreground(ColorSuccess)
+	lineWidth := contentWidth - 4
+	for i, l := range c.Lines {
+		if i == maxReplaceLines {
+			b.WriteString(m.styles.HelpDesc.Render(fmt.Sprintf("… %d more changed lines", len(c.Lines)-i)))
+			b.WriteString("\n")
+			break
+		}
+		label := "title"
+		if l.Number > 0 {
+			label = fmt.Sprintf("line %d", l.Number)
+		}
+		b.WriteString(m.styles.HelpDesc.Render(label))
+		b.WriteString("\n")
+		b.WriteString(removed.Render("- " + runewidth.Truncate(strings.ReplaceAll(l.Before, "\n", "⏎"), lineWidth, "…")))
+		b.WriteString("\n")
+		b.WriteString(added.Render("+ " + runewidth.Truncate(strings.ReplaceAll(l.After, "\n", "⏎"), lineWidth, "…")))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"y", "replace"},
+		{"n", "skip"},
+		{"a", "replace all remaining"},
+		{"Esc", "stop"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Editor width bounds and step, in terminal cells.
+const (
+	defaultEditorWidth = 80
+	minEditorWidth     = 40
+	editorResizeStep   = 4
+)
+
+// editorWidth returns the width of the ticket editor/viewer pane: the
+// persisted width if set, otherwise the default, clamped to the terminal.
+func (m *Model) editorWidth() int {
+	width := defaultEditorWidth
+	if m.state != nil && m.state.EditorWidth > 0 {
+		width = m.state.EditorWidth
+	}
+	return max(min(width, m.width-8), minEditorWidth)
+}
+
+// resizeEditor grows or shrinks the editor pane by delta cells and persists it.
+func (m *Model) resizeEditor(delta int) {
+	m.setEditorWidth(m.editorWidth() + delta)
+	m.saveEditorWidth()
+	m.setStatus(fmt.Sprintf("Editor width: %d", m.editorWidth()))
+}
+
+// setEditorWidth records a new editor width without saving it.
+func (m *Model) setEditorWidth(width int) {
+	if m.state == nil {
+		return
+	}
+	m.state.EditorWidth = max(min(width, m.width-8), minEditorWidth)
+}
+
+// saveEditorWidth persists the editor width, reporting failures in the status bar.
+func (m *Model) saveEditorWidth() {
+	if m.state == nil {
+		return
+	}
+	if err := m.state.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error saving state: %v", err))
+	}
+}
+
+// handleEditorDrag resizes the editor pane by dragging its right border.
+// It reports whether the event was consumed.
+func (m *Model) handleEditorDrag(msg tea.MouseMsg) bool {
+	// App padding is 2 cells and the bordered fields add one cell on the left
+	border := 2 + m.editorWidth() + 1
+
+	switch msg.Action {
+	case tea.MouseActionPress:
+		if msg.Button == tea.MouseButtonLeft && msg.X >= border-1 && msg.X <= border+1 {
+			m.resizingEditor = true
+			return true
+		}
+	case tea.MouseActionMotion:
+		if m.resizingEditor {
+			m.setEditorWidth(msg.X - 3)
+			return true
+		}
+	case tea.MouseActionRelease:
+		if m.resizingEditor {
+			m.resizingEditor = false
+			m.saveEditorWidth()
+			return true
+		}
+	}
+	return false
+}
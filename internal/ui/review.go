@@ -0,0 +1,357 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/git"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// reviewQueue returns tickets in the done column that carry agent feedback
+// and have not been reviewed since they were last completed.
+func (m *Model) reviewQueue() []*models.Ticket {
+	if len(m.columns) == 0 {
+		return nil
+	}
+
+	doneCol := m.columns[m.config.DoneColumnIndex()]
+	var queue []*models.Ticket
+	for _, t := range doneCol.Tickets {
+		if t.AgentFeedback != "" && t.NeedsReview() {
+			queue = append(queue, t)
+		}
+	}
+	return queue
+}
+
+// openReviewQueue switches to the review queue view.
+func (m *Model) openReviewQueue() {
+	m.viewMode = ViewReview
+	m.reviewIndex = 0
+}
+
+// selectedReviewTicket returns the highlighted ticket in the review queue.
+func (m *Model) selectedReviewTicket() *models.Ticket {
+	queue := m.reviewQueue()
+	if len(queue) == 0 {
+		return nil
+	}
+	m.reviewIndex = max(min(m.reviewIndex, len(queue)-1), 0)
+	return queue[m.reviewIndex]
+}
+
+// handleReviewKeys handles keys in the review queue view.
+func (m *Model) handleReviewKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q", "R":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.reviewIndex < len(m.reviewQueue())-1 {
+			m.reviewIndex++
+		}
+
+	case "k", "up":
+		if m.reviewIndex > 0 {
+			m.reviewIndex--
+		}
+
+	case "a":
+		return m.acceptReviewTicket()
+
+	case "o":
+		return m.startReopen(m.selectedReviewTicket())
+	}
+	return nil
+}
+
+// startReopen asks for a reopen comment for ticket, returning to the
+// current view afterwards.
+func (m *Model) startReopen(ticket *models.Ticket) tea.Cmd {
+	if ticket == nil {
+		return nil
+	}
+	m.reopenTarget = ticket
+	m.rejecting = false
+	m.prevMode = m.viewMode
+	m.viewMode = ViewReopenComment
+	m.commentInput.SetValue("")
+	m.commentInput.Focus()
+	return textinput.Blink
+}
+
+// handleReopenCommentKeys handles keys while entering a reopen comment.
+func (m *Model) handleReopenCommentKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = m.prevMode
+		m.commentInput.Blur()
+		m.reopenTarget = nil
+
+	case "enter":
+		comment := strings.TrimSpace(m.commentInput.Value())
+		if m.rejecting && comment == "" {
+			m.setStatus("A comment is required to reject")
+			return nil
+		}
+		// The comment doubles as the move reason, so move_reasons can
+		// require one for reopening too
+		if target := m.reopenColumn(); comment == "" && m.reopenTarget != nil && target != nil &&
+			m.config.ReasonRequired(m.reopenTarget.Column, target.Config.Dir) {
+			m.setStatus("A comment is required to move to " + target.Config.Name)
+			return nil
+		}
+		m.commentInput.Blur()
+		m.viewMode = m.prevMode
+		if m.reopenTarget != nil {
+			if m.rejecting {
+				m.rejectTicket(m.reopenTarget, comment)
+			} else {
+				m.reopenTicket(m.reopenTarget, comment)
+			}
+			m.reopenTarget = nil
+		}
+	}
+	return nil
+}
+
+// acceptReviewTicket marks the highlighted ticket as reviewed.
+func (m *Model) acceptReviewTicket() tea.Cmd {
+	ticket := m.selectedReviewTicket()
+	if ticket == nil {
+		return nil
+	}
+
+	ticket.ReviewedAt = time.Now()
+	ticket.ReviewedBy = m.reviewer()
+	if err := ticket.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	m.setSuccess(fmt.Sprintf("Accepted: %s", ticket.ShortTitle(30)))
+	m.loadAllTickets()
+	return nil
+}
+
+// reopenColumn returns the column reopened tickets move to: the configured
+// reopen_column, or the first column.
+func (m *Model) reopenColumn() *ColumnData {
+	for i := range m.columns {
+		if m.columns[i].Config.Dir == m.config.ReopenColumn {
+			return &m.columns[i]
+		}
+	}
+	if len(m.columns) == 0 {
+		return nil
+	}
+	return &m.columns[0]
+}
+
+// reopenTicket moves a ticket back to the reopen column, appends the comment
+// as a reopen note and increments its reopened count.
+func (m *Model) reopenTicket(ticket *models.Ticket, comment string) {
+	target := m.reopenColumn()
+	if target == nil {
+		return
+	}
+
+	ticket.ReopenedCount++
+	ticket.AppendNote("Reopened", comment, time.Now())
+	if err := m.moveTicketTo(ticket, target.Config.Dir, comment, nil); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	m.setSuccess(fmt.Sprintf("Reopened to %s: %s", target.Config.Name, ticket.ShortTitle(30)))
+	m.loadAllTickets()
+}
+
+// reviewColumnIndex returns the index of the review column: review_column,
+// or a column with dir "review" before the done column. It returns -1 if
+// there is none.
+func (m *Model) reviewColumnIndex() int {
+	dir := m.config.ReviewColumn
+	if dir == "" {
+		dir = "review"
+	}
+	if len(m.columns) == 0 {
+		return -1
+	}
+	for i, col := range m.columns[:m.config.DoneColumnIndex()] {
+		if col.Config.Dir == dir {
+			return i
+		}
+	}
+	return -1
+}
+
+// reviewer names who approves tickets: the git user.name of the project,
+// or the login user.
+func (m *Model) reviewer() string {
+	if name := git.UserName(filepath.Dir(m.config.KanbanDir)); name != "" {
+		return name
+	}
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// approveSelectedTicket moves the selected review ticket to the done column
+// like a manual move, through the column's entry checklist and any required
+// reason, and records who approved it and when.
+func (m *Model) approveSelectedTicket() tea.Cmd {
+	if m.getSelectedTicket() == nil {
+		return nil
+	}
+	done := m.doneColumn()
+	for i, col := range m.columns {
+		if col.Config.Dir == done {
+			m.moveTarget = i
+		}
+	}
+	m.approving = true
+	return m.confirmMove()
+}
+
+// stampApproval records the reviewer of a ticket approved into the done
+// column, after the move so it doesn't reappear in the review queue.
+func (m *Model) stampApproval(ticket *models.Ticket) error {
+	ticket.ReviewedAt = time.Now()
+	ticket.ReviewedBy = m.reviewer()
+	return ticket.Save()
+}
+
+// startReject asks for the required rejection comment for ticket.
+func (m *Model) startReject(ticket *models.Ticket) tea.Cmd {
+	cmd := m.startReopen(ticket)
+	m.rejecting = ticket != nil
+	return cmd
+}
+
+// rejectTicket sends a ticket back to the first column (or reopen_column)
+// with the rejection comment appended.
+func (m *Model) rejectTicket(ticket *models.Ticket, comment string) {
+	target := m.reopenColumn()
+	if target == nil {
+		return
+	}
+
+	ticket.ReopenedCount++
+	ticket.AppendNote("Rejected", comment, time.Now())
+	if err := m.moveTicketTo(ticket, target.Config.Dir, comment, nil); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	m.setStatus(fmt.Sprintf("Rejected to %s: %s", target.Config.Name, ticket.ShortTitle(30)))
+	m.loadAllTickets()
+	m.clampSelection()
+}
+
+// renderReviewQueue renders the list of completed tickets awaiting review.
+func (m *Model) renderReviewQueue() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Review Queue")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	queue := m.reviewQueue()
+	if len(queue) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("Nothing to review - all completed tickets have been reviewed."))
+		b.WriteString("\n\n")
+	} else {
+		selected := m.selectedReviewTicket()
+		for _, t := range queue {
+			line := fmt.Sprintf("%s  %s", t.ShortTitle(contentWidth-20), m.styles.TicketDate.Render(m.formatDate(t.Updated)))
+			if t == selected {
+				b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+			} else {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+
+		// Feedback of the highlighted ticket
+		b.WriteString(m.styles.ModalTitle.Copy().Foreground(GruvboxBlue).Render("Agent Feedback"))
+		b.WriteString("\n")
+		feedbackHeight := max(m.height-len(queue)-16, 3)
+		b.WriteString(m.styles.Input.Width(contentWidth).MaxHeight(feedbackHeight + 2).Render(selected.AgentFeedback))
+		b.WriteString("\n\n")
+	}
+
+	if m.viewMode == ViewReopenComment && m.prevMode == ViewReview {
+		b.WriteString(m.styles.ModalTitle.Render("Reopen comment"))
+		b.WriteString("\n")
+		b.WriteString(m.styles.InputFocused.Width(contentWidth).Render(m.commentInput.View()))
+		b.WriteString("\n\n")
+	}
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"a", "accept"},
+		{"o", "reopen"},
+		{"Esc", "back"},
+	}
+	if m.viewMode == ViewReopenComment && m.prevMode == ViewReview {
+		helpKeys = []struct{ key, desc string }{
+			{"Enter", "reopen"},
+			{"Esc", "cancel"},
+		}
+	}
+
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
+
+// renderReopenScreen renders the reopen comment prompt as a centered modal.
+func (m *Model) renderReopenScreen() string {
+	if m.prevMode == ViewReview {
+		return m.renderReviewQueue()
+	}
+
+	title := "Reopen Ticket"
+	if m.rejecting {
+		title = "Reject Ticket (comment required)"
+	}
+
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render(title))
+	b.WriteString("\n\n")
+	if m.reopenTarget != nil {
+		b.WriteString(m.reopenTarget.Title)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.commentInput.View())
+	b.WriteString("\n\n")
+	if target := m.reopenColumn(); target != nil {
+		b.WriteString(m.styles.HelpDesc.Render(fmt.Sprintf("Enter to move to %s, Esc to cancel", target.Config.Label())))
+	}
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString("\n\n")
+		b.WriteString(toasts)
+	}
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// toggleMark adds or removes the selected ticket from the multi-selection,
+// preserving the order in which tickets were marked.
+func (m *Model) toggleMark() {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return
+	}
+
+	if idx := m.markIndex(ticket); idx >= 0 {
+		m.marked = append(m.marked[:idx], m.marked[idx+1:]...)
+	} else {
+		m.marked = append(m.marked, ticket.FilePath)
+	}
+	m.setStatus(fmt.Sprintf("%d ticket(s) selected", len(m.marked)))
+}
+
+// markIndex returns the position of ticket in the selection, or -1.
+func (m *Model) markIndex(ticket *models.Ticket) int {
+	for i, path := range m.marked {
+		if path == ticket.FilePath {
+			return i
+		}
+	}
+	return -1
+}
+
+// clearMarks empties the multi-selection.
+func (m *Model) clearMarks() {
+	m.marked = nil
+}
+
+// markedTickets resolves the selection to tickets in selection order,
+// dropping any that no longer exist on the board.
+func (m *Model) markedTickets() []*models.Ticket {
+	byPath := make(map[string]*models.Ticket)
+	for _, col := range m.columns {
+		for _, t := range col.Tickets {
+			byPath[t.FilePath] = t
+		}
+	}
+
+	var tickets []*models.Ticket
+	var kept []string
+	for _, path := range m.marked {
+		if t, ok := byPath[path]; ok {
+			tickets = append(tickets, t)
+			kept = append(kept, path)
+		}
+	}
+	m.marked = kept
+	return tickets
+}
+
+// copyMarkedTicketsPrompt copies a batch prompt containing exactly the marked
+// tickets, in the order they were marked, then clears the selection.
+func (m *Model) copyMarkedTicketsPrompt() tea.Cmd {
+	tickets := m.markedTickets()
+	if len(tickets) == 0 {
+		m.setStatus("Selected tickets no longer exist")
+		return nil
+	}
+
+	cmd := m.copyBatchPrompt(tickets, "selected ticket(s)")
+	m.clearMarks()
+	return cmd
+}
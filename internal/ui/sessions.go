@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/agent"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// openSessions lists the agent transcripts of the ticket being viewed.
+func (m *Model) openSessions() {
+	ticket := m.editingTicket
+	if ticket == nil {
+		return
+	}
+	sessions, err := agent.ListSessions(ticket.KanbanDir(), ticket.Slug())
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if len(sessions) == 0 {
+		m.setStatus("No agent sessions for this ticket")
+		return
+	}
+	m.sessions = sessions
+	m.sessionTotal, m.sessionUsage = m.sessionsUsage(sessions)
+	m.sessionIndex = 0
+	m.viewMode = ViewSessions
+}
+
+// handleSessionsKeys handles keys in the session list.
+func (m *Model) handleSessionsKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q":
+		m.viewMode = ViewTicket
+
+	case "j", "down":
+		if m.sessionIndex < len(m.sessions)-1 {
+			m.sessionIndex++
+		}
+
+	case "k", "up":
+		if m.sessionIndex > 0 {
+			m.sessionIndex--
+		}
+
+	case "enter":
+		data, err := os.ReadFile(m.sessions[m.sessionIndex].Path)
+		if err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		started := m.sessions[m.sessionIndex].Started.Format("2006-01-02 15:04:05")
+		m.openPager("Agent Session  ·  "+started, string(data), false)
+	}
+	return nil
+}
+
+// renderSessions renders the session list for the viewed ticket.
+func (m *Model) renderSessions() string {
+	var b strings.Builder
+	contentWidth := m.editorWidth()
+
+	b.WriteString(m.styles.Header.Width(contentWidth).Render("  Agent Sessions"))
+	b.WriteString("\n\n")
+	if m.editingTicket != nil {
+		b.WriteString(m.styles.HelpDesc.Render("Ticket: "))
+		b.WriteString(m.styles.TicketTitle.Render(m.editingTicket.Title))
+		b.WriteString("\n")
+	}
+	if !m.sessionTotal.IsZero() {
+		b.WriteString(m.styles.HelpDesc.Render("Usage: "))
+		b.WriteString(formatUsage(m.sessionTotal))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	for i, s := range m.sessions {
+		line := fmt.Sprintf("%s  %s", s.Started.Format("2006-01-02 15:04:05"),
+			m.styles.TicketDate.Render(formatBytes(s.Size)))
+		if u := m.sessionUsage[i]; !u.IsZero() {
+			u.Runs = 0
+			line += "  " + m.styles.TicketDate.Render(formatUsage(u))
+		}
+		if i == m.sessionIndex {
+			b.WriteString(m.styles.ButtonActive.Render(line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("j/k select, Enter view transcript, Esc back"))
+	return m.styles.App.Render(b.String())
+}
+
+// formatBytes renders a size compactly, e.g. "812 B" or "14.2 KB".
+func formatBytes(n int64) string {
+	if n < 1024 {
+		return fmt.Sprintf("%d B", n)
+	}
+	return fmt.Sprintf("%.1f KB", float64(n)/1024)
+}
+
+// sessionCount returns how many transcripts a ticket has.
+func sessionCount(ticket *models.Ticket) int {
+	sessions, _ := agent.ListSessions(ticket.KanbanDir(), ticket.Slug())
+	return len(sessions)
+}
@@ -0,0 +1,330 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+)
+
+// Setup wizard steps.
+const (
+	setupStepDir = iota
+	setupStepColumns
+	setupStepTheme
+	setupStepAgentMd
+	setupStepConfirm
+)
+
+// defaultColumnColors are assigned to wizard-created columns in order.
+var defaultColumnColors = []string{"#f87171", "#fbbf24", "#60a5fa", "#a78bfa", "#4ade80"}
+
+// SetupModel is the first-run wizard that builds the initial configuration.
+type SetupModel struct {
+	config    *config.Config
+	styles    Styles
+	step      int
+	dirInput  textinput.Model
+	colsInput textinput.Model
+	theme     int
+	agentMd   bool
+	done      bool
+	err       string
+	width     int
+}
+
+// NewSetup creates the first-run wizard, pre-filled from cfg.
+func NewSetup(cfg *config.Config) *SetupModel {
+	di := textinput.New()
+	di.CharLimit = 200
+	di.Width = 50
+	di.SetValue(relativeToCwd(cfg.KanbanDir))
+	di.Focus()
+
+	var names []string
+	for _, col := range cfg.Columns {
+		names = append(names, col.Name)
+	}
+	ci := textinput.New()
+	ci.CharLimit = 200
+	ci.Width = 50
+	ci.SetValue(strings.Join(names, ", "))
+
+	theme := 0
+	for i, name := range ThemeNames {
+		if name == cfg.Theme {
+			theme = i
+		}
+	}
+	ApplyTheme(ThemeNames[theme])
+
+	return &SetupModel{
+		config:    cfg,
+		styles:    DefaultStyles(),
+		dirInput:  di,
+		colsInput: ci,
+		theme:     theme,
+		agentMd:   !cfg.DisableAgentMd,
+	}
+}
+
+// Completed reports whether the wizard finished (rather than being aborted).
+func (s *SetupModel) Completed() bool {
+	return s.done
+}
+
+// Config returns the configuration built by the wizard.
+func (s *SetupModel) Config() *config.Config {
+	return s.config
+}
+
+// Init initializes the wizard.
+func (s *SetupModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles wizard input.
+func (s *SetupModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		return s, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return s, tea.Quit
+		case "esc":
+			if s.step > setupStepDir {
+				s.step--
+				s.focusStep()
+			}
+			return s, nil
+		case "enter":
+			return s, s.advance()
+		}
+
+		if s.step == setupStepTheme {
+			// The wizard itself previews the highlighted theme
+			switch msg.String() {
+			case "left", "h", "shift+tab":
+				s.theme = (s.theme + len(ThemeNames) - 1) % len(ThemeNames)
+			case "right", "l", " ", "tab":
+				s.theme = (s.theme + 1) % len(ThemeNames)
+			}
+			ApplyTheme(ThemeNames[s.theme])
+			s.styles = DefaultStyles()
+			return s, nil
+		}
+
+		if s.step == setupStepAgentMd {
+			switch msg.String() {
+			case "y":
+				s.agentMd = true
+			case "n":
+				s.agentMd = false
+			case " ", "tab":
+				s.agentMd = !s.agentMd
+			}
+			return s, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch s.step {
+	case setupStepDir:
+		s.dirInput, cmd = s.dirInput.Update(msg)
+	case setupStepColumns:
+		s.colsInput, cmd = s.colsInput.Update(msg)
+	}
+	return s, cmd
+}
+
+// advance validates the current step and moves to the next, finishing the
+// wizard after the confirmation step.
+func (s *SetupModel) advance() tea.Cmd {
+	s.err = ""
+	switch s.step {
+	case setupStepDir:
+		dir := strings.TrimSpace(s.dirInput.Value())
+		if dir == "" {
+			s.err = "Board directory cannot be empty"
+			return nil
+		}
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			s.err = err.Error()
+			return nil
+		}
+		s.config.KanbanDir = abs
+
+	case setupStepColumns:
+		columns := parseColumnNames(s.colsInput.Value())
+		if len(columns) == 0 {
+			s.err = "Define at least one column"
+			return nil
+		}
+		s.config.Columns = columns
+
+	case setupStepTheme:
+		s.config.Theme = ThemeNames[s.theme]
+
+	case setupStepAgentMd:
+		s.config.DisableAgentMd = !s.agentMd
+
+	case setupStepConfirm:
+		s.done = true
+		return tea.Quit
+	}
+
+	s.step++
+	s.focusStep()
+	return textinput.Blink
+}
+
+// focusStep focuses the input belonging to the current step.
+func (s *SetupModel) focusStep() {
+	s.dirInput.Blur()
+	s.colsInput.Blur()
+	switch s.step {
+	case setupStepDir:
+		s.dirInput.Focus()
+	case setupStepColumns:
+		s.colsInput.Focus()
+	}
+}
+
+// View renders the wizard.
+func (s *SetupModel) View() string {
+	var b strings.Builder
+
+	width := 70
+	if s.width > 0 {
+		width = max(min(s.width-8, 80), 40)
+	}
+
+	b.WriteString(s.styles.Header.Width(width).Render("  Welcome to Kanban TUI"))
+	b.WriteString("\n\n")
+	b.WriteString(s.styles.HelpDesc.Render(fmt.Sprintf("No config found - let's set up your board (step %d of %d).", s.step+1, setupStepConfirm+1)))
+	b.WriteString("\n\n")
+
+	switch s.step {
+	case setupStepDir:
+		b.WriteString(s.styles.ModalTitle.Render("Board directory"))
+		b.WriteString("\n")
+		b.WriteString(s.styles.InputFocused.Width(width).Render(s.dirInput.View()))
+
+	case setupStepColumns:
+		b.WriteString(s.styles.ModalTitle.Render("Columns (comma-separated, in workflow order)"))
+		b.WriteString("\n")
+		b.WriteString(s.styles.InputFocused.Width(width).Render(s.colsInput.View()))
+
+	case setupStepTheme:
+		b.WriteString(s.styles.ModalTitle.Render("Theme"))
+		b.WriteString("\n")
+		for i, name := range ThemeNames {
+			button := s.styles.Button
+			if i == s.theme {
+				button = s.styles.ButtonActive
+			}
+			b.WriteString(button.Render(name))
+		}
+
+	case setupStepAgentMd:
+		b.WriteString(s.styles.ModalTitle.Render("Write AGENT.md with instructions for AI agents?"))
+		b.WriteString("\n")
+		yes, no := s.styles.Button, s.styles.ButtonActive
+		if s.agentMd {
+			yes, no = s.styles.ButtonActive, s.styles.Button
+		}
+		b.WriteString(yes.Render("Yes") + no.Render("No"))
+
+	case setupStepConfirm:
+		b.WriteString(s.styles.ModalTitle.Render("Ready to create your board"))
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("Directory: %s\n", s.config.KanbanDir))
+		var cols []string
+		for _, col := range s.config.Columns {
+			cols = append(cols, fmt.Sprintf("%s (%s/)", col.Name, col.Dir))
+		}
+		b.WriteString(fmt.Sprintf("Columns:   %s\n", strings.Join(cols, ", ")))
+		b.WriteString(fmt.Sprintf("Theme:     %s\n", s.config.Theme))
+		agentMd := "yes"
+		if s.config.DisableAgentMd {
+			agentMd = "no"
+		}
+		b.WriteString(fmt.Sprintf("AGENT.md:  %s\n", agentMd))
+	}
+	b.WriteString("\n\n")
+
+	if s.err != "" {
+		b.WriteString(s.styles.StatusMessage.Copy().Foreground(ColorDanger).Render(s.err))
+		b.WriteString("\n\n")
+	}
+
+	help := "Enter next    Esc back    Ctrl+C quit"
+	switch s.step {
+	case setupStepTheme:
+		help = "←/→ choose    Enter next    Esc back    Ctrl+C quit"
+	case setupStepConfirm:
+		help = "Enter create board    Esc back    Ctrl+C quit"
+	}
+	b.WriteString(s.styles.HelpBar.Width(width).Render(help))
+
+	return s.styles.App.Render(b.String())
+}
+
+// parseColumnNames turns "To Do, Doing, Done" into column configs with
+// directory names derived from the column names.
+func parseColumnNames(input string) []config.Column {
+	var columns []config.Column
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(input, ",") {
+		name := strings.TrimSpace(part)
+		dir := columnDirName(name)
+		if name == "" || dir == "" || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		columns = append(columns, config.Column{Name: name, Dir: dir})
+	}
+
+	// Spread the palette so the last column is always green
+	for i := range columns {
+		colorIdx := i
+		if i == len(columns)-1 {
+			colorIdx = len(defaultColumnColors) - 1
+		} else if colorIdx >= len(defaultColumnColors)-1 {
+			colorIdx = len(defaultColumnColors) - 2
+		}
+		columns[i].Color = defaultColumnColors[colorIdx]
+	}
+	return columns
+}
+
+// columnDirName derives a directory name from a column name ("To Do" → "todo").
+func columnDirName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// relativeToCwd shortens path relative to the working directory when possible.
+func relativeToCwd(path string) string {
+	cwd, err := filepath.Abs(".")
+	if err != nil {
+		return path
+	}
+	if rel, err := filepath.Rel(cwd, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return path
+}
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// copyTicketMarkdown copies the selected ticket's markdown file, frontmatter
+// included, to the clipboard.
+func (m *Model) copyTicketMarkdown() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		m.setStatus("No ticket selected")
+		return nil
+	}
+	if err := m.copyToClipboard(string(ticket.ToMarkdown())); err != nil {
+		m.setError(fmt.Sprintf("Clipboard error: %v", err))
+		return nil
+	}
+	m.setSuccess("Copied markdown of: " + ticket.ShortTitle(30))
+	return nil
+}
+
+// copyTicketJSON copies the selected ticket as JSON to the clipboard.
+func (m *Model) copyTicketJSON() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		m.setStatus("No ticket selected")
+		return nil
+	}
+	data, err := ticket.ToJSON()
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	if err := m.copyToClipboard(string(data)); err != nil {
+		m.setError(fmt.Sprintf("Clipboard error: %v", err))
+		return nil
+	}
+	m.setSuccess("Copied JSON of: " + ticket.ShortTitle(30))
+	return nil
+}
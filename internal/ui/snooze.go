@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// newSnoozeInput creates the input for the date a ticket is snoozed until.
+func newSnoozeInput() textinput.Model {
+	si := textinput.New()
+	si.Placeholder = "tomorrow, 3d, 2w, fri or 2025-03-14"
+	si.CharLimit = 20
+	si.Width = 40
+	return si
+}
+
+// startSnooze asks until when the selected ticket should be snoozed.
+func (m *Model) startSnooze() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return nil
+	}
+	m.snoozeTarget = ticket
+	m.snoozeInput.SetValue(ticket.SnoozedUntil)
+	m.snoozeInput.CursorEnd()
+	m.snoozeInput.Focus()
+	m.viewMode = ViewSnooze
+	return textinput.Blink
+}
+
+// handleSnoozeKeys handles keys while entering a snooze date.
+func (m *Model) handleSnoozeKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewBoard
+		m.snoozeInput.Blur()
+		m.snoozeTarget = nil
+
+	case "enter":
+		until, err := parseSnoozeDate(m.snoozeInput.Value(), time.Now())
+		if err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		m.snoozeInput.Blur()
+		m.viewMode = ViewBoard
+		if m.snoozeTarget != nil {
+			m.snoozeTicket(m.snoozeTarget, until)
+			m.snoozeTarget = nil
+		}
+	}
+	return nil
+}
+
+// snoozeTicket hides ticket until the YYYY-MM-DD date until, or wakes it
+// up when until is empty.
+func (m *Model) snoozeTicket(ticket *models.Ticket, until string) {
+	ticket.SnoozedUntil = until
+	if err := ticket.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if until == "" {
+		m.setSuccess(fmt.Sprintf("Unsnoozed: %s", ticket.ShortTitle(30)))
+	} else {
+		day, _ := time.ParseInLocation("2006-01-02", until, time.Local)
+		m.setSuccess(fmt.Sprintf("Snoozed until %s: %s", day.Format("Mon Jan 2"), ticket.ShortTitle(30)))
+	}
+	m.loadAllTickets()
+	m.clampSelection()
+}
+
+// toggleShowSnoozed shows or hides snoozed tickets on the board.
+func (m *Model) toggleShowSnoozed() {
+	m.showSnoozed = !m.showSnoozed
+	m.activeTicket = 0
+	if m.showSnoozed {
+		m.setStatus("Showing snoozed tickets")
+	} else {
+		m.setStatus("Hiding snoozed tickets")
+	}
+}
+
+// parseSnoozeDate turns a snooze date as typed into YYYY-MM-DD: an ISO
+// date, "tomorrow", a number of days or weeks ("3d", "2w"), or a weekday
+// name meaning its next occurrence. An empty input means no snooze. The
+// date must be after today.
+func parseSnoozeDate(input string, now time.Time) (string, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return "", nil
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	day, err := time.ParseInLocation("2006-01-02", input, time.Local)
+	switch {
+	case err == nil:
+	case input == "tomorrow":
+		day = today.AddDate(0, 0, 1)
+	case strings.HasSuffix(input, "d") || strings.HasSuffix(input, "w"):
+		n, err := strconv.Atoi(input[:len(input)-1])
+		if err != nil || n <= 0 {
+			return "", fmt.Errorf("unknown snooze date %q", input)
+		}
+		if strings.HasSuffix(input, "w") {
+			n *= 7
+		}
+		day = today.AddDate(0, 0, n)
+	default:
+		weekday, ok := parseWeekday(input)
+		if !ok {
+			return "", fmt.Errorf("unknown snooze date %q", input)
+		}
+		days := (int(weekday) - int(today.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		day = today.AddDate(0, 0, days)
+	}
+
+	if !day.After(today) {
+		return "", fmt.Errorf("snooze date %s is not in the future", day.Format("2006-01-02"))
+	}
+	return day.Format("2006-01-02"), nil
+}
+
+// parseWeekday parses a weekday name or its three-letter abbreviation.
+func parseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		if s == name || s == name[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// renderSnoozeScreen renders the snooze date prompt as a centered modal.
+func (m *Model) renderSnoozeScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Snooze Ticket"))
+	b.WriteString("\n\n")
+	if m.snoozeTarget != nil {
+		b.WriteString(m.snoozeTarget.Title)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.snoozeInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("Enter to snooze (empty to wake up), Esc to cancel"))
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString("\n\n")
+		b.WriteString(toasts)
+	}
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/spell"
+)
+
+// misspelling is a misspelled word in the editor and its suggestions.
+type misspelling struct {
+	word        string
+	suggestions []string
+}
+
+// loadSpellChecker loads the configured dictionary when spell checking is on.
+func (m *Model) loadSpellChecker() {
+	m.speller = nil
+	m.spellText, m.misspellings = "", nil
+	if !m.config.SpellCheck {
+		return
+	}
+
+	path := m.config.SpellDictionary
+	if path == "" {
+		path = spell.DefaultDictionary
+	}
+	speller, err := spell.Load(path)
+	if err != nil {
+		m.setError(fmt.Sprintf("Spell check disabled: %v", err))
+		return
+	}
+	m.speller = speller
+}
+
+// checkSpelling looks for misspelled words when the editor's text changed
+// since the last check, as suggestions are too slow to compute per frame.
+func (m *Model) checkSpelling() {
+	if m.speller == nil {
+		return
+	}
+	text := m.titleInput.Value() + "\n" + m.contentInput.Value()
+	if text == m.spellText && m.misspellings != nil {
+		return
+	}
+	m.spellText = text
+	m.misspellings = []misspelling{}
+	for _, word := range m.speller.Misspelled(text) {
+		m.misspellings = append(m.misspellings, misspelling{word: word, suggestions: m.speller.Suggest(word)})
+	}
+}
+
+// renderSpelling lists the misspelled words found by checkSpelling in the
+// editor's title and content with their suggestions, truncated to width. It is empty when spell checking is
+// off or nothing is misspelled.
+func (m *Model) renderSpelling(width int) string {
+	if m.speller == nil || len(m.misspellings) == 0 {
+		return ""
+	}
+
+	var parts []string
+	for _, ms := range m.misspellings {
+		part := m.styles.SpellError.Render(ms.word)
+		if len(ms.suggestions) > 0 {
+			part += m.styles.HelpDesc.Render(" → " + strings.Join(ms.suggestions, ", "))
+		}
+		parts = append(parts, part)
+	}
+
+	line := m.styles.HelpDesc.Render("Spelling: ") + strings.Join(parts, m.styles.HelpDesc.Render("  ·  "))
+	return lipgloss.NewStyle().MaxWidth(width).Render(line)
+}
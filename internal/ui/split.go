@@ -0,0 +1,266 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/hooks"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// startSplit opens the split view for the selected ticket.
+func (m *Model) startSplit() tea.Cmd {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return nil
+	}
+
+	m.splitTicket = ticket
+	m.splitItems = ticket.Checklist()
+	m.splitChecked = make([]bool, len(m.splitItems))
+	m.splitIndex = 0
+	m.splitInput.SetValue("")
+	m.viewMode = ViewSplit
+
+	// Without a checklist, go straight to pasting sub-titles
+	if len(m.splitItems) == 0 {
+		m.splitFocus = 1
+		return m.splitInput.Focus()
+	}
+	m.splitFocus = 0
+	m.splitInput.Blur()
+	return nil
+}
+
+// handleSplitKeys handles keys in the split ticket view.
+func (m *Model) handleSplitKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewBoard
+		m.splitTicket = nil
+		m.splitInput.Blur()
+		return nil
+
+	case "ctrl+s":
+		return m.splitSelectedTicket()
+
+	case "tab", "shift+tab":
+		if m.splitFocus == 0 {
+			m.splitFocus = 1
+			return m.splitInput.Focus()
+		}
+		if len(m.splitItems) > 0 {
+			m.splitFocus = 0
+			m.splitInput.Blur()
+		}
+		return nil
+	}
+
+	if m.splitFocus != 0 {
+		return nil
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		if m.splitIndex < len(m.splitItems)-1 {
+			m.splitIndex++
+		}
+	case "k", "up":
+		if m.splitIndex > 0 {
+			m.splitIndex--
+		}
+	case " ", "x":
+		if m.splitIndex < len(m.splitChecked) {
+			m.splitChecked[m.splitIndex] = !m.splitChecked[m.splitIndex]
+		}
+	}
+	return nil
+}
+
+// splitSelectedTicket creates a child ticket for every checked checklist item
+// and pasted sub-title, then rewrites the parent to link to the children. If
+// any step fails, the children created so far are removed again.
+func (m *Model) splitSelectedTicket() tea.Cmd {
+	parent := m.splitTicket
+	if parent == nil {
+		return nil
+	}
+
+	replacements := make(map[int]string)
+	var created []*models.Ticket
+
+	createChild := func(title string) (*models.Ticket, error) {
+		child := models.NewTicket(title, parent.Column)
+		child.Tags = append([]string{}, parent.Tags...)
+		child.Parent = parent.Filename()
+		path, err := child.UniqueFilePath(m.config.ColumnPath(parent.Column))
+		if err != nil {
+			return nil, err
+		}
+		child.FilePath = path
+		if err := child.Save(); err != nil {
+			return nil, err
+		}
+		created = append(created, child)
+		return child, nil
+	}
+	fail := func(err error) tea.Cmd {
+		left := 0
+		for _, child := range created {
+			if child.Delete() != nil {
+				left++
+			}
+		}
+		if left > 0 {
+			m.setError(fmt.Sprintf("Error: %v (%d child ticket(s) could not be removed)", err, left))
+		} else {
+			m.setError(fmt.Sprintf("Error: %v", err))
+		}
+		m.loadAllTickets()
+		return nil
+	}
+
+	for i, item := range m.splitItems {
+		if !m.splitChecked[i] {
+			continue
+		}
+		child, err := createChild(item.Text)
+		if err != nil {
+			return fail(err)
+		}
+		replacements[item.Line] = childLink(child, item.Done)
+	}
+
+	var pasted []string
+	for _, line := range strings.Split(m.splitInput.Value(), "\n") {
+		title := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*+"))
+		if title == "" {
+			continue
+		}
+		child, err := createChild(title)
+		if err != nil {
+			return fail(err)
+		}
+		pasted = append(pasted, childLink(child, false))
+	}
+
+	if len(created) == 0 {
+		m.setStatus("Nothing to split: check items or enter sub-titles")
+		return nil
+	}
+
+	parent.ReplaceLines(replacements)
+	if len(pasted) > 0 {
+		parent.AppendNote("Split into", strings.Join(pasted, "\n"), time.Now())
+	}
+	if err := parent.Save(); err != nil {
+		return fail(err)
+	}
+	for _, child := range created {
+		m.fireHook(hooks.Create, child, "")
+	}
+
+	m.viewMode = ViewBoard
+	m.splitTicket = nil
+	m.splitInput.Blur()
+	m.loadAllTickets()
+	m.setSuccess(fmt.Sprintf("Split into %d child ticket(s)", len(created)))
+	return nil
+}
+
+// childLink formats the checklist line linking a parent to a child ticket,
+// checked when the item it replaces was.
+func childLink(child *models.Ticket, done bool) string {
+	box := "[ ]"
+	if done {
+		box = "[x]"
+	}
+	return fmt.Sprintf("- %s %s → [[%s]]", box, child.Title, child.Filename())
+}
+
+// renderSplitScreen renders the split ticket view.
+func (m *Model) renderSplitScreen() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 80), 40)
+	m.splitInput.SetWidth(contentWidth - 4)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Split Ticket")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	if m.splitTicket != nil {
+		b.WriteString(m.styles.HelpDesc.Render("Splitting: "))
+		b.WriteString(m.styles.TicketTitle.Render(m.splitTicket.Title))
+		b.WriteString("\n\n")
+	}
+
+	// Checklist items
+	label := "Checklist items"
+	if m.splitFocus == 0 {
+		label = "▶ " + label
+	}
+	b.WriteString(m.styles.ModalTitle.Render(label))
+	b.WriteString("\n")
+	if len(m.splitItems) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("  (no checklist items in this ticket)"))
+		b.WriteString("\n")
+	}
+	for i, item := range m.splitItems {
+		box := "[ ]"
+		if m.splitChecked[i] {
+			box = "[x]"
+		}
+		cursor := "  "
+		if m.splitFocus == 0 && i == m.splitIndex {
+			cursor = m.styles.HelpKey.Render("▶ ")
+		}
+		b.WriteString(fmt.Sprintf("%s%s %s\n", cursor, box, item.Text))
+	}
+	b.WriteString("\n")
+
+	// Pasted sub-titles
+	label = "Additional sub-titles (one per line)"
+	inputStyle := m.styles.Input
+	if m.splitFocus == 1 {
+		label = "▶ " + label
+		inputStyle = m.styles.InputFocused
+	}
+	b.WriteString(m.styles.ModalTitle.Render(label))
+	b.WriteString("\n")
+	b.WriteString(inputStyle.Width(contentWidth).Render(m.splitInput.View()))
+	b.WriteString("\n\n")
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"Space", "toggle"},
+		{"Tab", "switch"},
+		{"Ctrl+S", "split"},
+		{"Esc", "cancel"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
+
+// newSplitInput creates the textarea used for pasting sub-titles.
+func newSplitInput() textarea.Model {
+	ta := textarea.New()
+	ta.Placeholder = "One child ticket title per line..."
+	ta.CharLimit = 0
+	ta.SetWidth(60)
+	ta.SetHeight(6)
+	ta.ShowLineNumbers = false
+	return ta
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// isStarred reports whether the ticket is starred. Stars are personal, so
+// they live in the user state rather than the ticket file.
+func (m *Model) isStarred(ticket *models.Ticket) bool {
+	return m.state != nil && m.state.IsStarred(ticket.KanbanDir(), ticket.Filename())
+}
+
+// toggleStar stars or unstars a ticket.
+func (m *Model) toggleStar(ticket *models.Ticket) {
+	if ticket == nil || m.state == nil {
+		return
+	}
+	starred := m.state.ToggleStar(ticket.KanbanDir(), ticket.Filename())
+	if err := m.state.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if starred {
+		m.setSuccess(fmt.Sprintf("Starred: %s", ticket.ShortTitle(30)))
+	} else {
+		m.setStatus(fmt.Sprintf("Unstarred: %s", ticket.ShortTitle(30)))
+	}
+}
+
+// renameStar keeps a ticket starred after its file was renamed from oldName.
+func (m *Model) renameStar(ticket *models.Ticket, oldName string) error {
+	if m.state == nil || !m.state.RenameStar(ticket.KanbanDir(), oldName, ticket.Filename()) {
+		return nil
+	}
+	return m.state.Save()
+}
+
+// starredTickets returns the starred tickets on the board, in column order.
+func (m *Model) starredTickets() []*models.Ticket {
+	var starred []*models.Ticket
+	for _, t := range m.allTickets() {
+		if m.isStarred(t) {
+			starred = append(starred, t)
+		}
+	}
+	return starred
+}
+
+// openStarred switches to the starred tickets list.
+func (m *Model) openStarred() {
+	m.viewMode = ViewStarred
+	m.starredIndex = 0
+}
+
+// handleStarredKeys handles keys in the starred tickets list.
+func (m *Model) handleStarredKeys(msg tea.KeyMsg) tea.Cmd {
+	starred := m.starredTickets()
+	switch msg.String() {
+	case "esc", "q", "'":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.starredIndex < len(starred)-1 {
+			m.starredIndex++
+		}
+
+	case "k", "up":
+		if m.starredIndex > 0 {
+			m.starredIndex--
+		}
+
+	case "*":
+		if m.starredIndex < len(starred) {
+			m.toggleStar(starred[m.starredIndex])
+		}
+
+	case "enter":
+		if m.starredIndex < len(starred) {
+			m.viewMode = ViewBoard
+			if m.selectTicketByPath(starred[m.starredIndex].FilePath) {
+				m.setStatus(fmt.Sprintf("Jumped to: %s", m.getSelectedTicket().ShortTitle(30)))
+			}
+		}
+	}
+	return nil
+}
+
+// renderStarred renders the starred tickets list.
+func (m *Model) renderStarred() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Starred")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	starred := m.starredTickets()
+	if len(starred) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("No starred tickets. Press * on a ticket to star it."))
+		b.WriteString("\n\n")
+	} else {
+		m.starredIndex = max(min(m.starredIndex, len(starred)-1), 0)
+		for i, t := range starred {
+			title := runewidth.FillRight(runewidth.Truncate(t.Title, contentWidth-30, "..."), contentWidth-30)
+			line := fmt.Sprintf("%s  %s  %s", title, m.styles.HelpDesc.Render(m.columnName(t.Column)),
+				m.styles.TicketDate.Render(m.formatDate(t.Updated)))
+			if i == m.starredIndex {
+				b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+			} else {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "jump to ticket"},
+		{"*", "unstar"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
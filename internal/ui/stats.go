@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// flowStats holds lead and cycle time samples for completed tickets.
+type flowStats struct {
+	Lead     []time.Duration
+	Cycle    []time.Duration
+	LastDone time.Time
+}
+
+// computeFlowStats gathers lead and cycle times from tickets in the done column.
+// Lead time runs from creation to entering the done column; cycle time runs
+// from first leaving the first column to entering the done column.
+func (m *Model) computeFlowStats() flowStats {
+	var stats flowStats
+	if len(m.columns) < 2 {
+		return stats
+	}
+
+	done := m.config.DoneColumnIndex()
+	doneDir := m.columns[done].Config.Dir
+	startDirs := make([]string, 0, len(m.columns)-1)
+	for _, col := range m.columns[1:] {
+		startDirs = append(startDirs, col.Config.Dir)
+	}
+
+	for _, t := range m.columns[done].Tickets {
+		done := t.LastEntered(doneDir)
+		if done.IsZero() {
+			continue
+		}
+		stats.Lead = append(stats.Lead, done.Sub(t.Created))
+		if done.After(stats.LastDone) {
+			stats.LastDone = done
+		}
+
+		var started time.Time
+		for _, dir := range startDirs {
+			if at := t.FirstEntered(dir); !at.IsZero() && (started.IsZero() || at.Before(started)) {
+				started = at
+			}
+		}
+		if !started.IsZero() {
+			stats.Cycle = append(stats.Cycle, done.Sub(started))
+		}
+	}
+
+	return stats
+}
+
+// percentile returns the p-th percentile (0-100) of samples using nearest rank.
+func percentile(samples []time.Duration, p float64) time.Duration {
+	if len(samples) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	rank = max(min(rank, len(sorted)-1), 0)
+	return sorted[rank]
+}
+
+// formatDuration renders a duration compactly, e.g. "3d 4h" or "25m".
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// handleStatsKeys handles keys in the stats view.
+func (m *Model) handleStatsKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "s", "q":
+		m.viewMode = ViewBoard
+	}
+	return nil
+}
+
+// renderStats renders board statistics including lead and cycle times.
+func (m *Model) renderStats() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 80), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Board Statistics")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	// Ticket counts and average time in column
+	b.WriteString(m.styles.ModalTitle.Render("Columns"))
+	b.WriteString("\n")
+	now := time.Now()
+	for _, col := range m.columns {
+		var total time.Duration
+		for _, t := range col.Tickets {
+			total += t.TimeInColumn(now)
+		}
+		avg := "-"
+		if len(col.Tickets) > 0 {
+			avg = formatDuration(total / time.Duration(len(col.Tickets)))
+		}
+		name := runewidth.FillRight(runewidth.Truncate(col.Config.Name, 20, "..."), 20)
+		b.WriteString(fmt.Sprintf("  %s %4d tickets   avg age %s\n", name, len(col.Tickets), avg))
+	}
+	b.WriteString("\n")
+
+	// Flow metrics for completed tickets
+	stats := m.computeFlowStats()
+	b.WriteString(m.styles.ModalTitle.Render("Flow"))
+	b.WriteString("\n")
+	writeRow := func(label string, samples []time.Duration) {
+		if len(samples) == 0 {
+			b.WriteString(fmt.Sprintf("  %-12s no data\n", label))
+			return
+		}
+		b.WriteString(fmt.Sprintf("  %-12s p50 %-9s p85 %-9s p95 %-9s (n=%d)\n",
+			label,
+			formatDuration(percentile(samples, 50)),
+			formatDuration(percentile(samples, 85)),
+			formatDuration(percentile(samples, 95)),
+			len(samples)))
+	}
+	writeRow("Lead time", stats.Lead)
+	writeRow("Cycle time", stats.Cycle)
+	if !stats.LastDone.IsZero() {
+		b.WriteString(fmt.Sprintf("  %-12s %s\n", "Last done", m.formatDate(stats.LastDone)))
+	}
+	b.WriteString("\n")
+
+	// Agent token usage and cost from session transcripts
+	b.WriteString(m.styles.ModalTitle.Render("Agent Usage"))
+	b.WriteString("\n")
+	b.WriteString(m.renderUsageStats())
+	b.WriteString("\n")
+
+	helpText := m.styles.HelpKey.Render("Esc/s") + " " + m.styles.HelpDesc.Render("back")
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(helpText))
+
+	return m.styles.App.Render(b.String())
+}
+
+// ticketAge describes how long a ticket has been in its current column.
+func ticketAge(t *models.Ticket) string {
+	return formatDuration(t.TimeInColumn(time.Now()))
+}
+
+// oldestAge describes how long the longest-waiting ticket of a column has
+// been there, as a flow health hint in the column header. It is empty for
+// empty columns, for the done column, where finished tickets rest, and when
+// disabled in the config.
+func (m *Model) oldestAge(colIndex int) string {
+	tickets := m.columns[colIndex].Tickets
+	if m.config.HideOldestAge || len(tickets) == 0 || colIndex == m.config.DoneColumnIndex() {
+		return ""
+	}
+	now := time.Now()
+	var oldest time.Duration
+	for _, t := range tickets {
+		if age := t.TimeInColumn(now); age > oldest {
+			oldest = age
+		}
+	}
+	return formatAge(oldest)
+}
@@ -62,11 +62,16 @@ type Styles struct {
 	TicketTitle    lipgloss.Style
 	TicketTags     lipgloss.Style
 	TicketDate     lipgloss.Style
+	TicketMark     lipgloss.Style
+	SpellError     lipgloss.Style
 	HelpBar        lipgloss.Style
 	HelpKey        lipgloss.Style
 	HelpDesc       lipgloss.Style
 	StatusBar      lipgloss.Style
 	StatusMessage  lipgloss.Style
+	ToastInfo      lipgloss.Style
+	ToastSuccess   lipgloss.Style
+	ToastError     lipgloss.Style
 	Modal          lipgloss.Style
 	ModalTitle     lipgloss.Style
 	Input          lipgloss.Style
@@ -138,6 +143,14 @@ func DefaultStyles() Styles {
 		TicketDate: lipgloss.NewStyle().
 			Foreground(GruvboxGray),
 
+		TicketMark: lipgloss.NewStyle().
+			Foreground(GruvboxAqua).
+			Bold(true),
+
+		SpellError: lipgloss.NewStyle().
+			Foreground(GruvboxRed).
+			Underline(true),
+
 		HelpBar: lipgloss.NewStyle().
 			Foreground(GruvboxFg3).
 			Background(GruvboxBg1).
@@ -158,6 +171,16 @@ func DefaultStyles() Styles {
 		StatusMessage: lipgloss.NewStyle().
 			Foreground(GruvboxGreen),
 
+		ToastInfo: lipgloss.NewStyle().
+			Foreground(ColorSecondary),
+
+		ToastSuccess: lipgloss.NewStyle().
+			Foreground(ColorSuccess),
+
+		ToastError: lipgloss.NewStyle().
+			Foreground(ColorDanger).
+			Bold(true),
+
 		Modal: lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
 			BorderForeground(GruvboxOrange).
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// newSwitcherInput creates the filter input of the ticket quick-switcher.
+func newSwitcherInput() textinput.Model {
+	si := textinput.New()
+	si.Placeholder = "Go to ticket..."
+	si.CharLimit = 100
+	si.Width = 60
+	return si
+}
+
+// openSwitcher shows the ticket quick-switcher.
+func (m *Model) openSwitcher() tea.Cmd {
+	m.switcherInput.SetValue("")
+	m.switcherInput.Focus()
+	m.switcherIndex = 0
+	m.viewMode = ViewSwitcher
+	return textinput.Blink
+}
+
+// switcherMatches returns the tickets of every column matching the
+// switcher filter: titles containing the query first, then tags
+// containing it, then fuzzy title and tag matches.
+func (m *Model) switcherMatches() []*models.Ticket {
+	query := models.FoldForSearch(strings.TrimSpace(m.switcherInput.Value()))
+	tickets := m.allTickets()
+	if query == "" {
+		return tickets
+	}
+
+	var title, tags, fuzzy []*models.Ticket
+	for _, t := range tickets {
+		tagText := models.FoldForSearch(strings.Join(t.Tags, " "))
+		switch {
+		case strings.Contains(models.FoldForSearch(t.Title), query):
+			title = append(title, t)
+		case strings.Contains(tagText, query):
+			tags = append(tags, t)
+		case fuzzyMatch(query, models.FoldForSearch(t.Title)+" "+tagText):
+			fuzzy = append(fuzzy, t)
+		}
+	}
+	return append(append(title, tags...), fuzzy...)
+}
+
+// handleSwitcherKeys handles keys in the quick-switcher; other keys edit
+// the filter.
+func (m *Model) handleSwitcherKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "ctrl+o":
+		m.switcherInput.Blur()
+		m.viewMode = ViewBoard
+
+	case "down", "ctrl+n", "ctrl+j", "tab":
+		if m.switcherIndex < len(m.switcherMatches())-1 {
+			m.switcherIndex++
+		}
+
+	case "up", "ctrl+k", "shift+tab":
+		if m.switcherIndex > 0 {
+			m.switcherIndex--
+		}
+
+	case "enter":
+		matches := m.switcherMatches()
+		m.switcherInput.Blur()
+		m.viewMode = ViewBoard
+		if m.switcherIndex < len(matches) && m.selectTicketByPath(matches[m.switcherIndex].FilePath) {
+			return m.openTicketEditor(EditorModeView)
+		}
+	}
+	return nil
+}
+
+// renderSwitcherScreen renders the quick-switcher as a centered modal.
+func (m *Model) renderSwitcherScreen() string {
+	var b strings.Builder
+	width := max(min(m.width-8, 90), 50)
+
+	b.WriteString(m.styles.ModalTitle.Render("Go to Ticket"))
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.InputFocused.Width(width - 6).Render(m.switcherInput.View()))
+	b.WriteString("\n\n")
+
+	matches := m.switcherMatches()
+	m.switcherIndex = max(min(m.switcherIndex, len(matches)-1), 0)
+	if len(matches) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("  No matching tickets"))
+		b.WriteString("\n")
+	}
+
+	// Keep the highlighted ticket in the visible window
+	titleWidth := width - 40
+	start := max(m.switcherIndex-maxPaletteRows+1, 0)
+	end := min(start+maxPaletteRows, len(matches))
+	for i := start; i < end; i++ {
+		t := matches[i]
+		title := runewidth.FillRight(runewidth.Truncate(t.Title, titleWidth, "…"), titleWidth)
+		column := runewidth.FillRight(runewidth.Truncate(m.columnName(t.Column), 12, "…"), 12)
+		line := title + "  " + m.styles.HelpDesc.Render(column) + "  "
+		if len(t.Tags) > 0 {
+			line += m.renderTags(t.Tags, 18)
+		}
+		if i == m.switcherIndex {
+			b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+	if len(matches) > maxPaletteRows {
+		b.WriteString(m.styles.HelpDesc.Render(fmt.Sprintf("  %d tickets", len(matches))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.styles.HelpDesc.Render("↑/↓ select, Enter open, Esc close"))
+
+	modal := m.styles.Modal.Width(width).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/retag"
+)
+
+// newTagRenameInput creates the input for the tag names of a rename.
+func newTagRenameInput() textinput.Model {
+	ti := textinput.New()
+	ti.CharLimit = 50
+	ti.Width = 40
+	return ti
+}
+
+// startTagRename asks for the tag to rename, suggesting the selected
+// ticket's first tag.
+func (m *Model) startTagRename() tea.Cmd {
+	m.tagRenameFrom = ""
+	m.tagRenameInput.Placeholder = "Tag to rename"
+	m.tagRenameInput.SetValue("")
+	if ticket := m.getSelectedTicket(); ticket != nil && len(ticket.Tags) > 0 {
+		m.tagRenameInput.SetValue(ticket.Tags[0])
+		m.tagRenameInput.CursorEnd()
+	}
+	m.tagRenameInput.Focus()
+	m.viewMode = ViewTagRename
+	return textinput.Blink
+}
+
+// handleTagRenameKeys handles keys while entering the old and new tag.
+func (m *Model) handleTagRenameKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = ViewBoard
+		m.tagRenameInput.Blur()
+
+	case "enter":
+		value := strings.TrimSpace(m.tagRenameInput.Value())
+		if value == "" {
+			return nil
+		}
+		if m.tagRenameFrom == "" {
+			m.tagRenameFrom = value
+			m.tagRenameInput.Placeholder = "New name (an existing tag merges)"
+			m.tagRenameInput.SetValue("")
+			return nil
+		}
+		m.tagRenameInput.Blur()
+		m.viewMode = ViewBoard
+		m.confirmTagRename(m.tagRenameFrom, value)
+	}
+	return nil
+}
+
+// confirmTagRename asks before renaming the tag across the board.
+func (m *Model) confirmTagRename(from, to string) {
+	plan, err := retag.NewPlan(m.config, from, to)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if len(plan.Changes) == 0 {
+		m.setStatus(fmt.Sprintf("No tickets are tagged %s", from))
+		return
+	}
+	m.confirm(fmt.Sprintf("Rename tag %s to %s in %d tickets?", from, to, len(plan.Changes)), func() tea.Cmd {
+		if err := plan.Apply(); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+		} else {
+			m.setSuccess(fmt.Sprintf("Renamed tag %s to %s in %d tickets", from, to, len(plan.Changes)))
+		}
+		m.loadAllTickets()
+		return nil
+	})
+}
+
+// renderTagRenameScreen renders the tag rename prompt as a centered modal.
+func (m *Model) renderTagRenameScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Rename Tag"))
+	b.WriteString("\n\n")
+	if m.tagRenameFrom != "" {
+		b.WriteString(fmt.Sprintf("Rename %s to:", m.tagRenameFrom))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(m.tagRenameInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("Enter to continue, Esc to cancel"))
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString("\n\n")
+		b.WriteString(toasts)
+	}
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
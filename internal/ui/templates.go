@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/kanban-tui/internal/templates"
+)
+
+// newTemplateVarInput creates the input for a template variable's value.
+func newTemplateVarInput() textinput.Model {
+	ti := textinput.New()
+	ti.CharLimit = 100
+	ti.Width = 40
+	return ti
+}
+
+// openTemplates lists the board's ticket templates to create a ticket from.
+func (m *Model) openTemplates() {
+	list, err := templates.Load(m.config.KanbanDir)
+	if err != nil {
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	if len(list) == 0 {
+		m.setStatus(fmt.Sprintf("No templates in %s", filepath.Join(m.config.KanbanDir, templates.DirName)))
+		return
+	}
+	m.ticketTemplates = list
+	m.templateIndex = 0
+	m.viewMode = ViewTemplates
+}
+
+// handleTemplatesKeys handles keys in the template list.
+func (m *Model) handleTemplatesKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.templateIndex < len(m.ticketTemplates)-1 {
+			m.templateIndex++
+		}
+
+	case "k", "up":
+		if m.templateIndex > 0 {
+			m.templateIndex--
+		}
+
+	case "enter":
+		m.templateVars = m.ticketTemplates[m.templateIndex].Variables()
+		m.templateValues = make(map[string]string)
+		return m.nextTemplateVar()
+	}
+	return nil
+}
+
+// nextTemplateVar asks for the next variable of the chosen template, or
+// opens the new ticket editor filled from it once all are answered.
+func (m *Model) nextTemplateVar() tea.Cmd {
+	if len(m.templateValues) < len(m.templateVars) {
+		m.templateVarInput.SetValue("")
+		m.templateVarInput.Placeholder = m.templateVars[len(m.templateValues)]
+		m.templateVarInput.Focus()
+		m.viewMode = ViewTemplateVars
+		return textinput.Blink
+	}
+
+	m.templateVarInput.Blur()
+	tmpl := m.ticketTemplates[m.templateIndex]
+	title, tags, content := tmpl.Fill(m.templateValues)
+	tags, content = m.columns[m.activeColumn].Config.WithDefaults(tags, content)
+
+	m.viewMode = ViewNewTicket
+	m.editorMode = EditorModeCreate
+	m.editingTicket = nil
+	m.titleInput.SetValue(title)
+	m.tagsInput.SetValue(strings.Join(tags, ", "))
+	m.contentInput.SetValue(content)
+	m.editorFocus = 0
+	m.updateEditorFocus()
+	m.setStatus(fmt.Sprintf("From template %s — review and press Ctrl+S to create", tmpl.Name))
+	return textinput.Blink
+}
+
+// handleTemplateVarKeys handles keys while entering a template variable.
+func (m *Model) handleTemplateVarKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.templateVarInput.Blur()
+		m.viewMode = ViewBoard
+
+	case "enter":
+		m.templateValues[m.templateVars[len(m.templateValues)]] = strings.TrimSpace(m.templateVarInput.Value())
+		return m.nextTemplateVar()
+	}
+	return nil
+}
+
+// renderTemplates renders the template list.
+func (m *Model) renderTemplates() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  New Ticket from Template")
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	for i, tmpl := range m.ticketTemplates {
+		line := tmpl.Name
+		if tmpl.Title != "" {
+			line += "  " + m.styles.HelpDesc.Render(tmpl.Title)
+		}
+		if i == m.templateIndex {
+			b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "use template"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
+
+// renderTemplateVarScreen renders the variable prompt as a centered modal.
+func (m *Model) renderTemplateVarScreen() string {
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render("Template: " + m.ticketTemplates[m.templateIndex].Name))
+	b.WriteString("\n\n")
+	b.WriteString(fmt.Sprintf("%s (%d of %d):", m.templateVars[len(m.templateValues)],
+		len(m.templateValues)+1, len(m.templateVars)))
+	b.WriteString("\n\n")
+	b.WriteString(m.templateVarInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("Enter to continue, Esc to cancel"))
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
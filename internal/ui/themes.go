@@ -0,0 +1,66 @@
+package ui
+
+import "github.com/charmbracelet/lipgloss"
+
+// palette holds the colors of a theme, in the slots of the Gruvbox palette.
+type palette struct {
+	bg0Hard, bg0, bg1, bg2, bg3, bg4                     lipgloss.Color
+	fg0, fg1, fg2, fg3, fg4                              lipgloss.Color
+	red, green, yellow, blue, purple, aqua, orange, gray lipgloss.Color
+	// highlight is the chroma style for ticket content
+	highlight string
+}
+
+// ThemeNames lists the available themes, the default first.
+var ThemeNames = []string{"dark", "light"}
+
+// themes maps theme names to their palettes: Gruvbox dark and light.
+var themes = map[string]palette{
+	"dark": {
+		bg0Hard: "#1d2021", bg0: "#282828", bg1: "#3c3836", bg2: "#504945", bg3: "#665c54", bg4: "#7c6f64",
+		fg0: "#fbf1c7", fg1: "#ebdbb2", fg2: "#d5c4a1", fg3: "#bdae93", fg4: "#a89984",
+		red: "#fb4934", green: "#b8bb26", yellow: "#fabd2f", blue: "#83a598",
+		purple: "#d3869b", aqua: "#8ec07c", orange: "#fe8019", gray: "#928374",
+		highlight: "gruvbox",
+	},
+	"light": {
+		bg0Hard: "#f9f5d7", bg0: "#fbf1c7", bg1: "#ebdbb2", bg2: "#d5c4a1", bg3: "#bdae93", bg4: "#a89984",
+		fg0: "#282828", fg1: "#3c3836", fg2: "#504945", fg3: "#665c54", fg4: "#7c6f64",
+		red: "#9d0006", green: "#79740e", yellow: "#b57614", blue: "#076678",
+		purple: "#8f3f71", aqua: "#427b58", orange: "#af3a03", gray: "#928374",
+		highlight: "gruvbox-light",
+	},
+}
+
+// ApplyTheme switches the palette to the named theme; styles created
+// afterwards (see DefaultStyles) use it. An empty name selects the default,
+// and an unknown one reports false and leaves the palette unchanged.
+func ApplyTheme(name string) bool {
+	if name == "" {
+		name = ThemeNames[0]
+	}
+	p, ok := themes[name]
+	if !ok {
+		return false
+	}
+
+	GruvboxBg0Hard, GruvboxBg0, GruvboxBg1, GruvboxBg2, GruvboxBg3, GruvboxBg4 = p.bg0Hard, p.bg0, p.bg1, p.bg2, p.bg3, p.bg4
+	GruvboxFg0, GruvboxFg1, GruvboxFg2, GruvboxFg3, GruvboxFg4 = p.fg0, p.fg1, p.fg2, p.fg3, p.fg4
+	GruvboxRed, GruvboxGreen, GruvboxYellow, GruvboxBlue = p.red, p.green, p.yellow, p.blue
+	GruvboxPurple, GruvboxAqua, GruvboxOrange, GruvboxGray = p.purple, p.aqua, p.orange, p.gray
+
+	ColorPrimary = GruvboxOrange
+	ColorSecondary = GruvboxBlue
+	ColorSuccess = GruvboxGreen
+	ColorWarning = GruvboxYellow
+	ColorDanger = GruvboxRed
+	ColorMuted = GruvboxGray
+	ColorBorder = GruvboxBg3
+	ColorBg = GruvboxBg0
+	ColorBgLight = GruvboxBg1
+	ColorFg = GruvboxFg1
+	ColorFgDim = GruvboxFg4
+
+	highlightStyle = p.highlight
+	return true
+}
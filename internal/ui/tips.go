@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// onboardingTip is one page of the first-launch tips overlay.
+type onboardingTip struct {
+	title string
+	body  string
+}
+
+var onboardingTips = []onboardingTip{
+	{"Move between columns", "◀ h        l ▶\n\nUse h/l or the arrow keys to switch columns.\nThe active column has an orange border."},
+	{"Move between tickets", "▲ k\n▼ j\n\nUse j/k or the arrow keys to select a ticket,\nthen Enter to view it or e to edit it."},
+	{"Create and organize", "n  new ticket in the active column\nm  move the selected ticket\nd  delete the selected ticket"},
+	{"Work with AI agents", "p  copy an agent prompt for the selected ticket\nP  copy a batch prompt for the first column\nR  review completed agent work"},
+	{"Find your way", "/  search tickets\n?  all keyboard shortcuts\nq  quit"},
+}
+
+// handleTipsKeys handles keys in the onboarding tips overlay.
+func (m *Model) handleTipsKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "l", "right", "n", " ":
+		if m.tipIndex < len(onboardingTips)-1 {
+			m.tipIndex++
+		} else {
+			m.dismissTips()
+		}
+	case "h", "left", "p":
+		if m.tipIndex > 0 {
+			m.tipIndex--
+		}
+	case "enter", "esc", "q":
+		m.dismissTips()
+	}
+	return nil
+}
+
+// dismissTips closes the tips overlay and remembers not to show it again.
+func (m *Model) dismissTips() {
+	m.viewMode = ViewBoard
+	if m.state == nil {
+		return
+	}
+	m.state.TipsDismissed = true
+	if err := m.state.Save(); err != nil {
+		m.setError(fmt.Sprintf("Error saving state: %v", err))
+	}
+}
+
+// renderTips renders the current onboarding tip as a centered overlay.
+func (m *Model) renderTips() string {
+	tip := onboardingTips[m.tipIndex]
+
+	var b strings.Builder
+	b.WriteString(m.styles.ModalTitle.Render(fmt.Sprintf("Welcome! %s (%d/%d)", tip.title, m.tipIndex+1, len(onboardingTips))))
+	b.WriteString("\n\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorFg).Render(tip.body))
+	b.WriteString("\n\n")
+	b.WriteString(m.styles.HelpDesc.Render("→/Space next, ← back, Enter/Esc dismiss for good"))
+
+	modal := m.styles.Modal.Width(60).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+)
+
+// toastLevel is the severity of a toast, which sets its styling and how
+// long it stays visible.
+type toastLevel int
+
+const (
+	toastInfo toastLevel = iota
+	toastSuccess
+	toastError
+)
+
+// toastDurations is how long toasts of each level stay visible.
+var toastDurations = map[toastLevel]time.Duration{
+	toastInfo:    3 * time.Second,
+	toastSuccess: 3 * time.Second,
+	toastError:   6 * time.Second,
+}
+
+// maxToasts is the number of toasts shown at once; older ones are dropped,
+// errors last.
+const maxToasts = 4
+
+// toast is a transient feedback message.
+type toast struct {
+	text    string
+	level   toastLevel
+	expires time.Time
+}
+
+// toastExpireMsg triggers a redraw when a toast expires.
+type toastExpireMsg struct{}
+
+// setStatus shows an informational toast.
+func (m *Model) setStatus(msg string) {
+	m.pushToast(toastInfo, msg, toastDurations[toastInfo])
+}
+
+// setSuccess shows a toast confirming a completed operation.
+func (m *Model) setSuccess(msg string) {
+	m.pushToast(toastSuccess, msg, toastDurations[toastSuccess])
+}
+
+// setError shows an error toast, which stays up longer than the others.
+func (m *Model) setError(msg string) {
+	slog.Warn("error shown", "msg", msg)
+	m.pushToast(toastError, msg, toastDurations[toastError])
+}
+
+// pushToast adds a toast visible for d, replacing an identical live one so
+// repeated operations don't fill the stack.
+func (m *Model) pushToast(level toastLevel, text string, d time.Duration) {
+	m.pruneToasts()
+	for i, t := range m.toasts {
+		if t.text == text && t.level == level {
+			m.toasts = append(m.toasts[:i], m.toasts[i+1:]...)
+			break
+		}
+	}
+	m.toasts = append(m.toasts, toast{text: text, level: level, expires: time.Now().Add(d)})
+	if len(m.toasts) > maxToasts {
+		// Drop the oldest toast, sparing errors while others remain
+		drop := 0
+		for i, t := range m.toasts {
+			if t.level != toastError {
+				drop = i
+				break
+			}
+		}
+		m.toasts = append(m.toasts[:drop], m.toasts[drop+1:]...)
+	}
+}
+
+// pruneToasts drops expired toasts.
+func (m *Model) pruneToasts() {
+	now := time.Now()
+	live := m.toasts[:0]
+	for _, t := range m.toasts {
+		if now.Before(t.expires) {
+			live = append(live, t)
+		}
+	}
+	m.toasts = live
+}
+
+// toastCmd schedules a redraw for when the next toast expires, unless one
+// is already scheduled.
+func (m *Model) toastCmd() tea.Cmd {
+	m.pruneToasts()
+	if m.toastTicking || len(m.toasts) == 0 {
+		return nil
+	}
+	next := m.toasts[0].expires
+	for _, t := range m.toasts[1:] {
+		if t.expires.Before(next) {
+			next = t.expires
+		}
+	}
+	m.toastTicking = true
+	return tea.Tick(time.Until(next), func(time.Time) tea.Msg { return toastExpireMsg{} })
+}
+
+// extraToastLines is how many lines stacked toasts take beyond the single
+// status line the board layout reserves.
+func (m *Model) extraToastLines() int {
+	now := time.Now()
+	n := 0
+	for _, t := range m.toasts {
+		if now.Before(t.expires) {
+			n++
+		}
+	}
+	return max(n-1, 0)
+}
+
+// renderToasts renders the live toasts stacked oldest first, or "" if there
+// are none.
+func (m *Model) renderToasts() string {
+	now := time.Now()
+	var lines []string
+	for _, t := range m.toasts {
+		if !now.Before(t.expires) {
+			continue
+		}
+		text := t.text
+		if m.width > 8 {
+			text = runewidth.Truncate(text, m.width-8, "…")
+		}
+		switch t.level {
+		case toastSuccess:
+			lines = append(lines, m.styles.ToastSuccess.Render("✓ "+text))
+		case toastError:
+			lines = append(lines, m.styles.ToastError.Render("✗ "+text))
+		default:
+			lines = append(lines, m.styles.ToastInfo.Render("• "+text))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
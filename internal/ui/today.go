@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// todayEntry is a ticket on the today list with why it is there.
+type todayEntry struct {
+	ticket *models.Ticket
+	reason string
+	due    bool // Due today or overdue, rather than only touched today
+}
+
+// todayList returns the daily worklist: tickets due today or overdue
+// outside the done column, and tickets updated today, across every column.
+// They are ordered by priority (unset last), due tickets before merely
+// touched ones, then by most recent update.
+func (m *Model) todayList() []todayEntry {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	var entries []todayEntry
+	done := m.config.DoneColumnIndex()
+	for i, col := range m.columns {
+		finished := i == done
+		for _, t := range col.Tickets {
+			if due, ok := t.DueDate(); ok && !due.After(today) && !finished {
+				reason := "due today"
+				if days := int(today.Sub(due).Hours() / 24); days > 0 {
+					reason = fmt.Sprintf("overdue %dd", days)
+				}
+				entries = append(entries, todayEntry{ticket: t, reason: reason, due: true})
+			} else if !t.Updated.Before(today) {
+				entries = append(entries, todayEntry{ticket: t, reason: "updated " + formatRelative(t.Updated, now)})
+			}
+		}
+	}
+
+	rank := func(p int) int {
+		if p <= 0 {
+			return int(^uint(0) >> 1)
+		}
+		return p
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if rank(a.ticket.Priority) != rank(b.ticket.Priority) {
+			return rank(a.ticket.Priority) < rank(b.ticket.Priority)
+		}
+		if a.due != b.due {
+			return a.due
+		}
+		return a.ticket.Updated.After(b.ticket.Updated)
+	})
+	return entries
+}
+
+// openToday switches to the today view.
+func (m *Model) openToday() {
+	m.viewMode = ViewToday
+	m.todayIndex = 0
+}
+
+// handleTodayKeys handles keys in the today view.
+func (m *Model) handleTodayKeys(msg tea.KeyMsg) tea.Cmd {
+	entries := m.todayList()
+	switch msg.String() {
+	case "esc", "q", "T":
+		m.viewMode = ViewBoard
+
+	case "j", "down":
+		if m.todayIndex < len(entries)-1 {
+			m.todayIndex++
+		}
+
+	case "k", "up":
+		if m.todayIndex > 0 {
+			m.todayIndex--
+		}
+
+	case "enter":
+		if m.todayIndex < len(entries) {
+			m.viewMode = ViewBoard
+			if m.selectTicketByPath(entries[m.todayIndex].ticket.FilePath) {
+				m.setStatus(fmt.Sprintf("Jumped to: %s", m.getSelectedTicket().ShortTitle(30)))
+			}
+		}
+	}
+	return nil
+}
+
+// renderToday renders the daily worklist.
+func (m *Model) renderToday() string {
+	var b strings.Builder
+
+	contentWidth := max(min(m.width-8, 100), 40)
+
+	header := m.styles.Header.Width(contentWidth).Render("  Today  ·  " + time.Now().Format("Mon Jan 2"))
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	entries := m.todayList()
+	if len(entries) == 0 {
+		b.WriteString(m.styles.HelpDesc.Render("Nothing due or touched today."))
+		b.WriteString("\n\n")
+	} else {
+		m.todayIndex = max(min(m.todayIndex, len(entries)-1), 0)
+		for i, e := range entries {
+			priority := "  "
+			if e.ticket.Priority > 0 {
+				priority = fmt.Sprintf("P%d", e.ticket.Priority)
+			}
+			reasonStyle := m.styles.TicketDate
+			if e.due && e.reason != "due today" {
+				reasonStyle = lipgloss.NewStyle().Foreground(GruvboxRed)
+			}
+			title := runewidth.FillRight(runewidth.Truncate(e.ticket.Title, contentWidth-44, "..."), contentWidth-44)
+			column := runewidth.FillRight(runewidth.Truncate(m.columnName(e.ticket.Column), 12, "..."), 12)
+			line := fmt.Sprintf("%s  %s  %s  %s", m.styles.HelpKey.Render(priority), title,
+				m.styles.HelpDesc.Render(column), reasonStyle.Render(e.reason))
+			if i == m.todayIndex {
+				b.WriteString(m.styles.HelpKey.Render("▶ ") + line)
+			} else {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if toasts := m.renderToasts(); toasts != "" {
+		b.WriteString(toasts)
+		b.WriteString("\n\n")
+	}
+
+	helpKeys := []struct{ key, desc string }{
+		{"j/k", "select"},
+		{"Enter", "jump to ticket"},
+		{"Esc", "back"},
+	}
+	var parts []string
+	for _, k := range helpKeys {
+		parts = append(parts, fmt.Sprintf("%s %s", m.styles.HelpKey.Render(k.key), m.styles.HelpDesc.Render(k.desc)))
+	}
+	b.WriteString(m.styles.HelpBar.Width(contentWidth).Render(strings.Join(parts, "    ")))
+
+	return m.styles.App.Render(b.String())
+}
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/user/kanban-tui/internal/agent"
+)
+
+// maxUsageRows is the number of most expensive tickets listed in the stats view.
+const maxUsageRows = 5
+
+// usagePattern compiles the configured agent usage pattern (nil for the
+// default patterns).
+func (m *Model) usagePattern() (*regexp.Regexp, error) {
+	if m.config.AgentUsagePattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile(m.config.AgentUsagePattern)
+}
+
+// openStats gathers agent usage from the board's transcripts and shows the
+// stats view.
+func (m *Model) openStats() {
+	m.agentUsage = nil
+	pattern, err := m.usagePattern()
+	if err != nil {
+		m.setError(fmt.Sprintf("Invalid agent_usage_pattern: %v", err))
+	} else {
+		m.agentUsage = make(map[string]agent.Usage)
+		for _, dir := range m.kanbanDirs() {
+			usage, err := agent.BoardUsage(dir, pattern)
+			if err != nil {
+				m.setError(fmt.Sprintf("Error: %v", err))
+				continue
+			}
+			for slug, u := range usage {
+				m.agentUsage[slug] = m.agentUsage[slug].Add(u)
+			}
+		}
+	}
+	m.viewMode = ViewStats
+}
+
+// kanbanDirs returns the kanban directories of the loaded tickets, which
+// differ from the config's in workspace mode.
+func (m *Model) kanbanDirs() []string {
+	seen := map[string]bool{m.config.KanbanDir: true}
+	dirs := []string{m.config.KanbanDir}
+	for _, col := range m.columns {
+		for _, t := range col.Tickets {
+			if dir := t.KanbanDir(); !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	return dirs
+}
+
+// renderUsageStats renders board-wide agent usage and the most expensive
+// tickets for the stats view.
+func (m *Model) renderUsageStats() string {
+	if len(m.agentUsage) == 0 {
+		return "  no agent usage recorded\n"
+	}
+
+	titles := make(map[string]string)
+	for _, col := range m.columns {
+		for _, t := range col.Tickets {
+			titles[t.Slug()] = t.Title
+		}
+	}
+
+	var total agent.Usage
+	slugs := make([]string, 0, len(m.agentUsage))
+	for slug, u := range m.agentUsage {
+		total = total.Add(u)
+		slugs = append(slugs, slug)
+	}
+	sort.Slice(slugs, func(i, j int) bool {
+		a, b := m.agentUsage[slugs[i]], m.agentUsage[slugs[j]]
+		if a.Cost != b.Cost {
+			return a.Cost > b.Cost
+		}
+		if a.TotalTokens() != b.TotalTokens() {
+			return a.TotalTokens() > b.TotalTokens()
+		}
+		return slugs[i] < slugs[j]
+	})
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("  %-12s %s\n", "Board", formatUsage(total)))
+	if total.InputTokens+total.OutputTokens > 0 {
+		b.WriteString(fmt.Sprintf("  %-12s in %s / out %s\n", "",
+			formatTokens(int(total.InputTokens)), formatTokens(int(total.OutputTokens))))
+	}
+	for _, slug := range slugs[:min(len(slugs), maxUsageRows)] {
+		title, ok := titles[slug]
+		if !ok {
+			title = slug + " (deleted)"
+		}
+		name := runewidth.FillRight(runewidth.Truncate(title, 30, "..."), 30)
+		b.WriteString(fmt.Sprintf("  %s %s\n", name, formatUsage(m.agentUsage[slug])))
+	}
+	return b.String()
+}
+
+// sessionsUsage returns the usage of each session and their total.
+func (m *Model) sessionsUsage(sessions []agent.Session) (agent.Usage, []agent.Usage) {
+	pattern, _ := m.usagePattern()
+	var total agent.Usage
+	perSession := make([]agent.Usage, len(sessions))
+	for i, s := range sessions {
+		u, err := agent.SessionUsage(s.Path, pattern)
+		if err != nil {
+			continue
+		}
+		perSession[i] = u
+		total = total.Add(u)
+	}
+	return total, perSession
+}
+
+// formatUsage renders cost, tokens and run count, e.g. "$1.24  85.2k tokens  3 runs".
+func formatUsage(u agent.Usage) string {
+	parts := []string{fmt.Sprintf("$%.2f", u.Cost)}
+	if tokens := u.TotalTokens(); tokens > 0 {
+		parts = append(parts, formatTokens(int(tokens))+" tokens")
+	}
+	if u.Runs == 1 {
+		parts = append(parts, "1 run")
+	} else if u.Runs > 1 {
+		parts = append(parts, fmt.Sprintf("%d runs", u.Runs))
+	}
+	return strings.Join(parts, "  ")
+}
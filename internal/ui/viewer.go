@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/viewport"
+)
+
+// scrollViewer scrolls the ticket or agent feedback content with the
+// pager keys, reporting whether key was one of them.
+func scrollViewer(vp *viewport.Model, key string) bool {
+	switch key {
+	case "j", "down":
+		vp.LineDown(1)
+	case "k", "up":
+		vp.LineUp(1)
+	case "pgdown", " ", "ctrl+d":
+		vp.ViewDown()
+	case "pgup", "ctrl+u":
+		vp.ViewUp()
+	case "g", "home":
+		vp.GotoTop()
+	case "G", "end":
+		vp.GotoBottom()
+	default:
+		return false
+	}
+	return true
+}
+
+// renderViewer fits content into the viewport and renders its visible
+// lines in a box width columns wide showing height lines.
+func (m *Model) renderViewer(vp *viewport.Model, content string, width, height int) string {
+	vp.Width = width - 4
+	vp.Height = height
+	vp.SetContent(m.fitLines(content, vp.Width))
+	// Keep the offset in range after the content or pane shrank
+	vp.SetYOffset(vp.YOffset)
+	return m.styles.Input.Copy().Width(width).Render(vp.View())
+}
+
+// scrollIndicator describes the visible part of the viewport, or returns
+// "" when all of its content fits.
+func (m *Model) scrollIndicator(vp *viewport.Model) string {
+	total := vp.TotalLineCount()
+	if total <= vp.Height {
+		return ""
+	}
+	end := min(vp.YOffset+vp.Height, total)
+	return m.styles.HelpDesc.Render(fmt.Sprintf("lines %d-%d of %d (%.0f%%)  ·  j/k scroll, PgUp/PgDn page, g/G top/bottom",
+		vp.YOffset+1, end, total, vp.ScrollPercent()*100))
+}
@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// wordsPerMinute is the reading speed used for read time estimates.
+const wordsPerMinute = 200
+
+// renderContentStats renders live word, character, read time and token counts
+// for the editor's content.
+func (m *Model) renderContentStats() string {
+	content := m.contentInput.Value()
+	words := len(strings.Fields(content))
+	chars := utf8.RuneCountInString(content)
+
+	readTime := "<1 min read"
+	if words >= wordsPerMinute {
+		readTime = fmt.Sprintf("~%d min read", (words+wordsPerMinute/2)/wordsPerMinute)
+	}
+
+	return m.styles.TicketDate.Render(fmt.Sprintf("%d words  ·  %d chars  ·  %s  ·  ~%s tokens",
+		words, chars, readTime, formatTokens(m.estimateTokens(content))))
+}
@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/watcher"
+)
+
+// boardDirs returns the kanban directories tickets are loaded from: the
+// current board, plus the workspace boards in workspace mode.
+func (m *Model) boardDirs() []string {
+	return append([]string{m.config.KanbanDir}, m.workspace...)
+}
+
+// workspaceCandidates returns the boards to aggregate: the configured
+// workspace_boards, or else the recent boards.
+func (m *Model) workspaceCandidates() []string {
+	candidates := m.config.WorkspaceBoards
+	if len(candidates) == 0 && m.state != nil {
+		candidates = m.state.ExistingRecentBoards()
+	}
+
+	var boards []string
+	seen := map[string]bool{m.config.KanbanDir: true}
+	for _, dir := range candidates {
+		abs, err := filepath.Abs(config.ExpandHome(dir))
+		if err != nil || seen[abs] {
+			continue
+		}
+		if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+			continue
+		}
+		seen[abs] = true
+		boards = append(boards, abs)
+	}
+	return boards
+}
+
+// toggleWorkspace switches between the current board alone and an aggregate
+// view of tickets from all workspace boards. Columns are matched by directory
+// name; tickets in columns the current board lacks are not shown.
+func (m *Model) toggleWorkspace() tea.Cmd {
+	prev := m.workspace
+	if len(m.workspace) > 0 {
+		m.workspace = nil
+	} else {
+		m.workspace = m.workspaceCandidates()
+		if len(m.workspace) == 0 {
+			m.setStatus("No other boards: open some boards or set workspace_boards")
+			return nil
+		}
+	}
+
+	// The old watcher's command ends when it is closed; the new one gets
+	// its own below
+	w, err := m.newWorkspaceWatcher()
+	if err != nil {
+		m.workspace = prev
+		m.setError(fmt.Sprintf("Error: %v", err))
+		return nil
+	}
+	m.watcher.Close()
+	m.watcher = w
+	if len(m.workspace) > 0 {
+		m.setStatus(fmt.Sprintf("Workspace mode: %d boards", len(m.workspace)+1))
+	} else {
+		m.setStatus("Workspace mode off")
+	}
+
+	m.activeTicket = 0
+	m.clearMarks()
+	m.loadAllTickets()
+	return m.watcherCmd()
+}
+
+// newWorkspaceWatcher watches the current board's columns plus the matching
+// columns of every workspace board.
+func (m *Model) newWorkspaceWatcher() (*watcher.Watcher, error) {
+	w, err := newBoardWatcher(m.config)
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range m.workspace {
+		for _, col := range m.config.Columns {
+			colPath := filepath.Join(dir, col.Dir)
+			if _, err := os.Stat(colPath); err != nil {
+				continue
+			}
+			if err := models.Files.Watch(w, colPath); err != nil {
+				w.Close()
+				return nil, fmt.Errorf("watching %s: %w", colPath, err)
+			}
+		}
+	}
+	return w, nil
+}
+
+// boardName returns a short display name for a kanban directory: the project
+// directory containing ".kanban", or the directory itself.
+func boardName(kanbanDir string) string {
+	if filepath.Base(kanbanDir) == ".kanban" {
+		return filepath.Base(filepath.Dir(kanbanDir))
+	}
+	return filepath.Base(kanbanDir)
+}
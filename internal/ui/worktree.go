@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/git"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// worktreePath returns where the ticket's worktree is created:
+// worktree_dir/<slug>, defaulting to <project>-worktrees/<slug>.
+func (m *Model) worktreePath(ticket *models.Ticket) string {
+	projectDir := filepath.Dir(ticket.KanbanDir())
+	base := config.ExpandHome(m.config.WorktreeDir)
+	if base == "" {
+		base = projectDir + "-worktrees"
+	} else if !filepath.IsAbs(base) {
+		base = filepath.Join(projectDir, base)
+	}
+	return filepath.Join(base, ticket.Slug())
+}
+
+// toggleWorktree creates a worktree for the selected ticket, or offers to
+// remove the one it already has.
+func (m *Model) toggleWorktree() {
+	ticket := m.getSelectedTicket()
+	if ticket == nil {
+		return
+	}
+	if ticket.Worktree != "" {
+		m.confirmRemoveWorktree(ticket)
+		return
+	}
+
+	branch := ticket.Branch
+	if branch == "" {
+		var err error
+		if branch, err = m.branchName(ticket); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return
+		}
+	}
+	path := m.worktreePath(ticket)
+
+	m.confirm(fmt.Sprintf("Create worktree %s on branch %s?", path, branch), func() tea.Cmd {
+		if err := git.AddWorktree(filepath.Dir(ticket.KanbanDir()), path, branch); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		ticket.Branch = branch
+		ticket.Worktree = path
+		if err := ticket.Save(); err != nil {
+			m.setError(fmt.Sprintf("Error saving ticket: %v", err))
+			return nil
+		}
+		m.setSuccess("Created worktree " + path)
+		return nil
+	})
+}
+
+// confirmRemoveWorktree asks to remove the ticket's worktree. The branch is
+// kept.
+func (m *Model) confirmRemoveWorktree(ticket *models.Ticket) {
+	m.confirm(fmt.Sprintf("Remove worktree %s?", ticket.Worktree), func() tea.Cmd {
+		if err := git.RemoveWorktree(filepath.Dir(ticket.KanbanDir()), ticket.Worktree); err != nil {
+			m.setError(fmt.Sprintf("Error: %v", err))
+			return nil
+		}
+		path := ticket.Worktree
+		ticket.Worktree = ""
+		if err := ticket.Save(); err != nil {
+			m.setError(fmt.Sprintf("Error saving ticket: %v", err))
+			return nil
+		}
+		m.setSuccess("Removed worktree " + path)
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+// fitLines fits text to width columns for the ticket and agent feedback
+// views: soft-wrapped at word boundaries, or with wrapping toggled off,
+// each line cut at the pane edge. Color escapes are kept intact.
+func (m *Model) fitLines(text string, width int) string {
+	text = strings.ReplaceAll(text, "\t", "    ")
+	if width < 1 {
+		return text
+	}
+	if !m.noWrap {
+		// Words longer than the line are broken where they overflow
+		return wrap.String(wordwrap.String(text, width), width)
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = truncate.StringWithTail(line, uint(width), "…")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// toggleWrap switches soft wrapping in the ticket and agent feedback views.
+func (m *Model) toggleWrap() {
+	m.noWrap = !m.noWrap
+	m.ticketViewer.GotoTop()
+	m.feedbackViewer.GotoTop()
+	if m.noWrap {
+		m.setStatus("Wrapping off: long lines are cut at the edge")
+	} else {
+		m.setStatus("Wrapping on")
+	}
+}
+
+// wrapHint returns the help bar label of the wrap toggle.
+func (m *Model) wrapHint() string {
+	if m.noWrap {
+		return "wrap"
+	}
+	return "no wrap"
+}
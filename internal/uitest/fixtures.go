@@ -0,0 +1,60 @@
+package uitest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+)
+
+// NewBoard creates an empty board with the default columns (todo, doing,
+// done) in a temporary directory.
+func NewBoard(t testing.TB) *config.Config {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	cfg.KanbanDir = filepath.Join(t.TempDir(), ".kanban")
+	cfg.Path = filepath.Join(cfg.KanbanDir, "config.yaml")
+	if err := cfg.EnsureDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+// AddTicket writes a ticket to a column of the board.
+func AddTicket(t testing.TB, cfg *config.Config, column, title string, tags ...string) *models.Ticket {
+	t.Helper()
+	ticket := models.NewTicket(title, column)
+	ticket.Tags = append([]string{}, tags...)
+	path, err := ticket.UniqueFilePath(cfg.ColumnPath(column))
+	if err == nil {
+		ticket.FilePath = path
+		err = ticket.Save()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ticket
+}
+
+// ColumnTickets returns the titles of the tickets stored in a column.
+func ColumnTickets(t testing.TB, cfg *config.Config, column string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(cfg.ColumnPath(column))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var titles []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		ticket, err := models.ParseTicketHeader(filepath.Join(cfg.ColumnPath(column), entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		titles = append(titles, ticket.Title)
+	}
+	return titles
+}
@@ -0,0 +1,906 @@
+package uitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/models"
+	"github.com/user/kanban-tui/internal/secret"
+	"github.com/user/kanban-tui/internal/state"
+	"github.com/user/kanban-tui/internal/ui"
+)
+
+func TestCreateMoveDelete(t *testing.T) {
+	cfg := NewBoard(t)
+	h := New(t, cfg)
+	h.WaitFor("To Do", "No tickets")
+
+	h.Press("n")
+	h.Type("Write the harness")
+	h.Press("ctrl+s")
+	h.WaitFor("Created: Write the harness")
+	if got := ColumnTickets(t, cfg, "todo"); !reflect.DeepEqual(got, []string{"Write the harness"}) {
+		t.Fatalf("todo = %q", got)
+	}
+
+	h.Press(">")
+	h.WaitUntil("ticket to move to doing", func(string) bool {
+		return len(ColumnTickets(t, cfg, "doing")) == 1
+	})
+	if got := ColumnTickets(t, cfg, "todo"); len(got) != 0 {
+		t.Errorf("todo still has %q", got)
+	}
+
+	h.Press("l", "d")
+	h.WaitFor("Write the harness", "trash")
+	h.Press("y")
+	h.WaitUntil("ticket to be trashed", func(string) bool {
+		return len(ColumnTickets(t, cfg, "doing")) == 0
+	})
+	trashed, _ := os.ReadDir(filepath.Join(cfg.KanbanDir, models.TrashDir))
+	if len(trashed) != 1 {
+		t.Errorf("trash has %d files, want 1", len(trashed))
+	}
+}
+
+func TestSearch(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Fix login redirect")
+	AddTicket(t, cfg, "doing", "Update changelog")
+	h := New(t, cfg)
+	h.WaitFor("Fix login redirect", "Update changelog")
+
+	h.Press("/")
+	h.Type("login")
+	h.Press("enter")
+	h.WaitUntil("search to filter the board", func(screen string) bool {
+		return strings.Contains(screen, "Fix login redirect") && !strings.Contains(screen, "Update changelog")
+	})
+
+	// Esc in the search prompt clears the filter
+	h.Press("/", "esc")
+	h.WaitFor("Fix login redirect", "Update changelog")
+}
+
+func TestPromptCopy(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Add retries", "backend")
+	h := New(t, cfg)
+	h.WaitFor("Add retries")
+
+	h.Press("p")
+	h.WaitFor("Copied")
+	prompt, _ := h.Clipboard.Paste()
+	if !strings.Contains(prompt, ticket.FilePath) && !strings.Contains(prompt, ticket.Filename()) {
+		t.Errorf("prompt does not reference the ticket file:\n%s", prompt)
+	}
+}
+
+func TestCopyTicket(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Add retries", "backend")
+	h := New(t, cfg)
+	h.WaitFor("Add retries")
+
+	h.Press("y")
+	h.WaitFor("Copied markdown")
+	text, _ := h.Clipboard.Paste()
+	if !strings.HasPrefix(text, "---\ntitle: Add retries\n") {
+		t.Errorf("markdown copy:\n%s", text)
+	}
+
+	h.Press("Y")
+	h.WaitFor("Copied JSON")
+	text, _ = h.Clipboard.Paste()
+	var got map[string]any
+	if err := json.Unmarshal([]byte(text), &got); err != nil {
+		t.Fatalf("JSON copy %s: %v", text, err)
+	}
+	if got["title"] != "Add retries" || got["column"] != "todo" {
+		t.Errorf("JSON copy = %v", got)
+	}
+}
+
+func TestAgentInstructions(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Migrate billing")
+	ticket.AgentInstructions = "Keep the old endpoint working.\n"
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	AddTicket(t, cfg, "doing", "Update docs")
+	h := New(t, cfg)
+	h.WaitFor("Migrate billing", "Update docs")
+
+	h.Press("p")
+	h.WaitFor("Copied")
+	prompt, _ := h.Clipboard.Paste()
+	if !strings.HasSuffix(prompt, "Additional instructions for @"+filepath.Join(".kanban", "todo", ticket.Filename())+":\n\nKeep the old endpoint working.\n") {
+		t.Errorf("prompt does not end with the ticket's instructions:\n%s", prompt)
+	}
+
+	h.Press("P")
+	h.WaitFor("Copied")
+	batch, _ := h.Clipboard.Paste()
+	if strings.Count(batch, "Keep the old endpoint working.") != 1 {
+		t.Errorf("batch prompt should carry the instructions once:\n%s", batch)
+	}
+}
+
+func TestViewerWrap(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Write the spec")
+	ticket.Content = strings.Repeat("lorem ipsum ", 30) + "closing-words"
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Write the spec")
+
+	// Wrapped, the end of the long line is on screen; unwrapped, it is cut
+	h.Press("enter")
+	h.WaitFor("View Ticket", "closing-words")
+	h.Press("w")
+	h.WaitUntil("line to be cut", func(screen string) bool {
+		return strings.Contains(screen, "Wrapping off") && !strings.Contains(screen, "closing-words")
+	})
+	h.Press("w")
+	h.WaitFor("closing-words")
+}
+
+func TestViewerScroll(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Long spec")
+	var lines []string
+	for i := 1; i <= 60; i++ {
+		lines = append(lines, fmt.Sprintf("step %d", i))
+	}
+	ticket.Content = strings.Join(lines, "\n")
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Long spec")
+
+	h.Press("enter")
+	h.WaitUntil("first lines to show", func(screen string) bool {
+		return strings.Contains(screen, "lines 1-") && !strings.Contains(screen, "step 60")
+	})
+	h.Press("G")
+	h.WaitUntil("last line to show", func(screen string) bool {
+		return strings.Contains(screen, "step 60") && strings.Contains(screen, "of 60 (100%)")
+	})
+	h.Press("g")
+	h.WaitFor("lines 1-")
+}
+
+func TestViewerSections(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Long spec")
+	content := "# Overview\n" + strings.Repeat("intro\n", 40) +
+		"```sh\n# not a heading\n```\n## Rollout\n" + strings.Repeat("step\n", 40) + "## Risks\nnone"
+	ticket.Content = content
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Long spec")
+
+	h.Press("enter", "o")
+	h.WaitFor("▶ Overview", "Rollout", "Risks")
+	// The comment in the code block is not a section
+	h.Press("]", "]")
+	h.WaitFor("▶   Rollout")
+	h.Press("[", "[")
+	h.WaitFor("▶ Overview")
+}
+
+func TestCopyCodeBlock(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Fix the build")
+	ticket.Content = "Run:\n\n```sh\nmake test\n```\n\nThen:\n\n```go\nfunc main() {\n\tbuild()\n}\n```\n\nDocs:\n\n````md\n```sh\nmake\n```\n````\n"
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Fix the build")
+
+	h.Press("enter", "c")
+	h.WaitFor("Copy Code Block", "sh · 1 line ", "go · 3 lines")
+	h.Press("2")
+	h.WaitFor("Copied go code block (3 lines)")
+	if code, _ := h.Clipboard.Paste(); code != "func main() {\n\tbuild()\n}" {
+		t.Errorf("copied %q", code)
+	}
+
+	// A longer fence quotes a shorter one
+	h.Press("c")
+	h.WaitFor("md · 3 lines")
+	h.Press("3")
+	h.WaitFor("Copied md code block")
+	if code, _ := h.Clipboard.Paste(); code != "```sh\nmake\n```" {
+		t.Errorf("copied %q", code)
+	}
+}
+
+func TestInlineContextTruncation(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.InlineContextFiles = true
+	root := filepath.Dir(cfg.KanbanDir)
+	// Three-byte runes, so a byte cut would split one
+	if err := os.WriteFile(filepath.Join(root, "notes.txt"), []byte(strings.Repeat("€", 20000)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ticket := AddTicket(t, cfg, "todo", "Read the notes")
+	ticket.ContextFiles = []string{"notes.txt"}
+	if err := ticket.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Read the notes")
+
+	h.Press("p")
+	h.WaitFor("Copied")
+	prompt, _ := h.Clipboard.Paste()
+	if !strings.Contains(prompt, "€\n... (truncated)") || !utf8.ValidString(prompt) {
+		t.Errorf("context file not truncated on a rune boundary (%d bytes)", len(prompt))
+	}
+	// The cap is 16 KiB of text, not 16K characters
+	if n := strings.Count(prompt, "€"); n != 16*1024/3 {
+		t.Errorf("inlined %d runes, want %d", n, 16*1024/3)
+	}
+}
+
+func TestPromptPathsFollowColumns(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.Columns = append(cfg.Columns[:2:2], config.Column{Name: "Review", Dir: "review"}, cfg.Columns[2])
+	if err := cfg.EnsureDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	ticket := AddTicket(t, cfg, "todo", "Fix login")
+	h := New(t, cfg)
+	h.WaitFor("Fix login")
+
+	// Finished tickets go to review, as AGENT.md says, not to done
+	h.Press("p")
+	h.WaitFor("Copied")
+	prompt, _ := h.Clipboard.Paste()
+	want := fmt.Sprintf("mv %q %q", filepath.Join(".kanban", "doing", ticket.Filename()), filepath.Join(".kanban", "review", ticket.Filename()))
+	if !strings.Contains(prompt, want) {
+		t.Errorf("prompt does not contain %s:\n%s", want, prompt)
+	}
+}
+
+func TestSpellingSuggestions(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.SpellCheck = true
+	cfg.SpellDictionary = filepath.Join(t.TempDir(), "words")
+	if err := os.WriteFile(cfg.SpellDictionary, []byte("fix\nthe\nlogin\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("No tickets")
+
+	h.Press("n")
+	h.Type("Fix the logn")
+	h.WaitFor("Spelling:", "logn", "→ login")
+	h.Press("backspace", "backspace")
+	h.Type("gin")
+	h.WaitUntil("misspelling fixed", func(view string) bool { return !strings.Contains(view, "Spelling:") })
+}
+
+func TestPromptPartials(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.SingleTicketPrompt = "Implement @{{.TicketPath}}\n\n{{template \"guidelines\" .}}\nThanks"
+	if err := os.MkdirAll(cfg.PromptsDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	partial := "## Guidelines\n- Keep {{.Title}} small\n"
+	if err := os.WriteFile(filepath.Join(cfg.PromptsDir(), "guidelines.tmpl"), []byte(partial), 0644); err != nil {
+		t.Fatal(err)
+	}
+	AddTicket(t, cfg, "todo", "Add retries")
+	h := New(t, cfg)
+	h.WaitFor("Add retries")
+
+	h.Press("p")
+	h.WaitFor("Copied")
+	prompt, _ := h.Clipboard.Paste()
+	if !strings.HasSuffix(prompt, "## Guidelines\n- Keep Add retries small\nThanks") {
+		t.Errorf("prompt does not include the partial:\n%s", prompt)
+	}
+}
+
+func TestFocusMode(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "doing", "Ship the release")
+	AddTicket(t, cfg, "done", "Old work")
+	h := New(t, cfg)
+	h.WaitFor("Ship the release", "Old work")
+
+	h.Press("F")
+	h.WaitUntil("done column to collapse", func(screen string) bool {
+		return strings.Contains(screen, "Focus mode: hiding Done") && !strings.Contains(screen, "Old work")
+	})
+
+	// The collapsed column can't be entered, but tickets still move into it
+	h.Press("l", "l", ">")
+	h.WaitUntil("ticket to move to done", func(string) bool {
+		return len(ColumnTickets(t, cfg, "done")) == 2
+	})
+
+	h.Press("F")
+	h.WaitFor("Focus mode off", "Old work", "Ship the release")
+}
+
+func TestSnooze(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Renew certificate")
+	h := New(t, cfg)
+	h.WaitFor("Renew certificate")
+
+	h.Press("z")
+	h.Type("2w")
+	h.Press("enter")
+	h.WaitUntil("ticket to be hidden", func(screen string) bool {
+		return strings.Contains(screen, "Snoozed until") && strings.Contains(screen, "No tickets")
+	})
+
+	h.Press("Z")
+	h.WaitFor("Renew certificate", "snoozed until")
+}
+
+func TestStarred(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Plain ticket")
+	watched := AddTicket(t, cfg, "doing", "Keep an eye on this")
+	// Its file name is taken in done, so moving it there renames it
+	other := AddTicket(t, cfg, "done", "Keep an eye on this")
+	other.Title = "Older ticket"
+	if err := other.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if watched.Filename() != other.Filename() {
+		t.Fatalf("file names differ: %s, %s", watched.Filename(), other.Filename())
+	}
+	h := New(t, cfg)
+	h.WaitFor("Keep an eye on this")
+
+	h.Press("l", "*")
+	h.WaitFor("Starred: Keep an eye on this", "★ Keep an eye on this")
+	h.Press(">")
+	h.WaitFor("Moved to Done", "★ Keep an eye on this")
+
+	h.Press("h", "h", "'")
+	h.WaitUntil("starred list", func(screen string) bool {
+		return strings.Contains(screen, "Keep an eye on this") &&
+			!strings.Contains(screen, "Plain ticket") && !strings.Contains(screen, "Older ticket")
+	})
+	h.Press("enter")
+	h.WaitFor("Jumped to: Keep an eye on this")
+}
+
+func TestQuickSwitcher(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Update changelog")
+	AddTicket(t, cfg, "done", "Migrate database", "postgres")
+	h := New(t, cfg)
+	h.WaitFor("Migrate database")
+
+	h.Press("ctrl+o")
+	h.Type("pgres")
+	h.WaitUntil("switcher to filter by tag", func(screen string) bool {
+		return strings.Contains(screen, "Migrate database") && !strings.Contains(screen, "Update changelog")
+	})
+	h.Press("enter")
+	h.WaitFor("View Ticket", "Migrate database")
+}
+
+func TestReplace(t *testing.T) {
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Call v1 users")
+	AddTicket(t, cfg, "doing", "Drop v1 endpoint")
+	h := New(t, cfg)
+	h.WaitFor("Drop v1 endpoint")
+
+	h.Press("%")
+	h.Type("v1")
+	h.Press("enter")
+	h.Type("v2")
+	h.Press("enter")
+	h.WaitFor("ticket 1 of 2", "- Call v1 users", "+ Call v2 users")
+
+	h.Press("y", "n")
+	h.WaitFor("Replaced in 1 of 2 tickets", "Call v2 users", "Drop v1 endpoint")
+}
+
+func TestTicketTemplate(t *testing.T) {
+	cfg := NewBoard(t)
+	dir := filepath.Join(cfg.KanbanDir, "templates")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	bug := "---\ntitle: \"{{component}} crashes in {{version}}\"\ntags: [bug]\n---\n\nSeen in {{version}}.\n"
+	if err := os.WriteFile(filepath.Join(dir, "bug.md"), []byte(bug), 0644); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Kanban Board")
+
+	h.Press("N")
+	h.WaitFor("New Ticket from Template", "bug")
+	h.Press("enter")
+	h.WaitFor("component (1 of 2)")
+	h.Type("parser")
+	h.Press("enter")
+	h.WaitFor("version (2 of 2)")
+	h.Type("1.4")
+	h.Press("enter")
+	h.WaitFor("parser crashes in 1.4", "Seen in 1.4.")
+}
+
+func TestHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands use sh")
+	}
+	cfg := NewBoard(t)
+	log := filepath.Join(t.TempDir(), "hooks.log")
+	record := `echo "$KANBAN_EVENT $KANBAN_FROM_COLUMN $KANBAN_COLUMN $KANBAN_TITLE" >> ` + log
+	cfg.Hooks.OnCreate, cfg.Hooks.OnMove, cfg.Hooks.OnDone = record, record, record
+	h := New(t, cfg)
+	h.WaitFor("To Do")
+
+	h.Press("n")
+	h.Type("Ship it")
+	h.Press("ctrl+s")
+	h.WaitFor("Created: Ship it")
+	h.Press(">", "l", ">")
+	want := "create  todo Ship it\nmove todo doing Ship it\nmove doing done Ship it\ndone doing done Ship it\n"
+	h.WaitUntil("hooks to run", func(string) bool {
+		data, _ := os.ReadFile(log)
+		return string(data) == want
+	})
+}
+
+func TestDispatchSameSlug(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("agent commands use sh")
+	}
+	cfg := NewBoard(t)
+	cfg.AgentCommand = "cat > /dev/null"
+	AddTicket(t, cfg, "todo", "Fix login")
+	AddTicket(t, cfg, "done", "Fix login")
+	h := New(t, cfg)
+	h.WaitFor("Fix login")
+
+	// The run belongs to the ticket in done, not the one sharing its slug
+	h.Press("l", "l", "a")
+	h.WaitFor("Agent finished: Fix login")
+	if got := ColumnTickets(t, cfg, "todo"); len(got) != 1 {
+		t.Errorf("todo = %v, want the undispatched ticket", got)
+	}
+	if got := ColumnTickets(t, cfg, "doing"); len(got) != 0 {
+		t.Errorf("doing = %v, want empty", got)
+	}
+}
+
+func TestUnreadableStateIsKept(t *testing.T) {
+	cfg := NewBoard(t)
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	path := state.DefaultPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	broken := []byte("starred: [unclosed\n")
+	if err := os.WriteFile(path, broken, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	model, err := ui.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if data, _ := os.ReadFile(path); string(data) != string(broken) {
+		t.Errorf("state file = %q, want it left alone", data)
+	}
+}
+
+func TestAgentEditsTicket(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("agent commands use sh")
+	}
+	cfg := NewBoard(t)
+	cfg.AgentCommand = "cat > /dev/null; sed -i '1a agent_feedback: did it' .kanban/doing/*.md"
+	AddTicket(t, cfg, "todo", "Fix login")
+	h := New(t, cfg)
+	h.WaitFor("Fix login")
+
+	// The move after the run must keep what the agent wrote to the ticket
+	h.Press("a")
+	h.WaitFor("Agent finished: Fix login")
+	tickets, err := models.ReadColumn(cfg.ColumnPath("done"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != 1 || tickets[0].AgentFeedback != "did it" {
+		t.Fatalf("done has %d tickets, want one with the agent's feedback", len(tickets))
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugins are shell scripts")
+	}
+	cfg := NewBoard(t)
+	AddTicket(t, cfg, "todo", "Fix login", "bug")
+	dir := filepath.Join(cfg.KanbanDir, "plugins")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	triage := "#!/bin/sh\ncat > /dev/null\n" +
+		`echo '{"actions":[{"type":"update","tags":["bug","triaged"]},{"type":"move","column":"doing"},` +
+		`{"type":"create","title":"Write regression test"},{"type":"message","message":"Triaged"}]}'` + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "triage"), []byte(triage), 0755); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Fix login")
+
+	h.Press("X")
+	h.WaitFor("Plugins", "triage")
+	h.Press("enter")
+	h.WaitFor("Triaged", "triaged")
+	if got := ColumnTickets(t, cfg, "doing"); !reflect.DeepEqual(got, []string{"Fix login"}) {
+		t.Errorf("doing = %q", got)
+	}
+	if got := ColumnTickets(t, cfg, "todo"); !reflect.DeepEqual(got, []string{"Write regression test"}) {
+		t.Errorf("todo = %q", got)
+	}
+}
+
+func TestMergeTickets(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.SortBy = "title"
+	target := AddTicket(t, cfg, "todo", "Auth login")
+	source := AddTicket(t, cfg, "todo", "Auth sign-in")
+	child := AddTicket(t, cfg, "doing", "Sign-in form")
+	child.Parent = source.Filename()
+	if err := child.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Auth login", "Auth sign-in")
+
+	h.Press("space", "j", "space", "M", "y")
+	h.WaitFor("Merged into")
+	if got := ColumnTickets(t, cfg, "todo"); !reflect.DeepEqual(got, []string{"Auth login"}) {
+		t.Errorf("todo = %q", got)
+	}
+	if _, err := os.Stat(filepath.Join(cfg.KanbanDir, models.TrashDir, source.Filename())); err != nil {
+		t.Errorf("merged ticket not in the trash: %v", err)
+	}
+	got, err := models.ParseTicket(child.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Parent != target.Filename() {
+		t.Errorf("child parent = %q, want %q", got.Parent, target.Filename())
+	}
+}
+
+func TestPaletteInReviewColumn(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.ReviewColumn = "doing"
+	AddTicket(t, cfg, "doing", "Check the fix")
+	h := New(t, cfg)
+	h.WaitFor("Check the fix")
+
+	// a approves in the review column, but the palette command dispatches
+	h.Press("l", "ctrl+p")
+	h.Type("Dispatch ticket to agent")
+	h.Press("enter")
+	h.WaitFor("Set agent_command in config")
+	if got := ColumnTickets(t, cfg, "doing"); !reflect.DeepEqual(got, []string{"Check the fix"}) {
+		t.Errorf("doing = %q", got)
+	}
+}
+
+func TestApproveChecklist(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.ReviewColumn = "doing"
+	cfg.Columns[2].Checklist = []string{"Docs updated"}
+	ticket := AddTicket(t, cfg, "doing", "Check the fix")
+	h := New(t, cfg)
+	h.WaitFor("Check the fix")
+
+	// Approving asks for the done column's checklist like a manual move
+	h.Press("l", "a")
+	h.WaitFor("Docs updated")
+	h.Press("enter")
+	h.WaitFor("Approved: Check the fix (1 checklist item(s) skipped)")
+	got, err := models.ParseTicket(filepath.Join(cfg.ColumnPath("done"), ticket.Filename()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ReviewedBy == "" || !reflect.DeepEqual(got.SkippedChecks(), []string{"Docs updated"}) {
+		t.Errorf("reviewed by %q, skipped %q", got.ReviewedBy, got.SkippedChecks())
+	}
+}
+
+func TestBatchChunkFiles(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.BatchChunkSize = 1
+	cfg.BatchChunkFiles = true
+	AddTicket(t, cfg, "todo", "First")
+	second := AddTicket(t, cfg, "todo", "Second")
+	AddTicket(t, cfg, "todo", "Third")
+	h := New(t, cfg)
+	h.WaitFor("Third")
+
+	h.Press("P")
+	h.WaitFor("Wrote 3 prompt chunks")
+	if err := os.Remove(second.FilePath); err != nil {
+		t.Fatal(err)
+	}
+	h.WaitUntil("Second to disappear", func(screen string) bool { return !strings.Contains(screen, "Second") })
+
+	// The second batch replaces every chunk file of the first
+	h.Press("P")
+	h.WaitFor("Wrote 2 prompt chunks")
+	paths, _ := filepath.Glob(filepath.Join(cfg.PromptsDir(), "batch-*.md"))
+	if len(paths) != 2 {
+		t.Errorf("chunk files = %q", paths)
+	}
+}
+
+func TestLinkCommits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	cfg := NewBoard(t)
+	cfg.GitIntegration = true
+	auth := AddTicket(t, cfg, "todo", "Fix auth")
+	flow := AddTicket(t, cfg, "todo", "Fix auth flow")
+	project := filepath.Dir(cfg.KanbanDir)
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "Refactor " + flow.Slug()},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", project}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	h := New(t, cfg)
+	h.WaitFor("Linked 1 commits")
+
+	// Only the ticket whose whole slug is mentioned gets the commit
+	for _, ticket := range []*models.Ticket{auth, flow} {
+		got, err := models.ParseTicket(ticket.FilePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := ticket == flow; (len(got.Commits) == 1) != want {
+			t.Errorf("%s: commits %v", ticket.Title, got.Commits)
+		}
+	}
+}
+
+func TestSplitKeepsChecks(t *testing.T) {
+	cfg := NewBoard(t)
+	epic := AddTicket(t, cfg, "todo", "Release")
+	epic.Content = "- [x] Write docs\n- [ ] Add tests\n"
+	if err := epic.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Release")
+
+	h.Press("S")
+	h.WaitFor("Split Ticket", "Write docs")
+	h.Press("space", "j", "space", "ctrl+s")
+	h.WaitFor("Split into 2 child ticket(s)")
+	got, err := models.ParseTicket(epic.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"- [x] Write docs → [[", "- [ ] Add tests → [["} {
+		if !strings.Contains(got.Content, want) {
+			t.Errorf("parent content %q lacks %q", got.Content, want)
+		}
+	}
+}
+
+func TestMoveRenameKeepsLinks(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.SortBy = "title"
+	AddTicket(t, cfg, "doing", "Release")
+	epic := AddTicket(t, cfg, "todo", "Release")
+	child := AddTicket(t, cfg, "todo", "Write docs")
+	child.Parent = epic.Filename()
+	if err := child.Save(); err != nil {
+		t.Fatal(err)
+	}
+	epic.Content = "- [ ] Write docs → [[" + child.Filename() + "]]\n"
+	if err := epic.Save(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("Write docs")
+
+	// Moving the epic next to its namesake renames it; the child follows
+	h.Press(">")
+	h.WaitFor("Moved to Doing")
+	got, err := models.ParseTicket(child.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Parent == epic.Filename() || !strings.HasSuffix(got.Parent, "-2.md") {
+		t.Errorf("child parent = %q", got.Parent)
+	}
+}
+
+func TestWorkspaceWatcher(t *testing.T) {
+	cfg := NewBoard(t)
+	other := NewBoard(t)
+	cfg.WorkspaceBoards = []string{other.KanbanDir}
+	AddTicket(t, other, "todo", "Other board ticket")
+	h := New(t, cfg)
+	h.WaitFor("No tickets")
+
+	// Changes keep arriving through the watcher that replaced the old one
+	h.Press("W")
+	h.WaitFor("Workspace mode: 2 boards", "Other board ticket")
+	AddTicket(t, other, "todo", "Added in workspace mode")
+	h.WaitFor("Added in workspace mode")
+	h.Press("W")
+	h.WaitFor("Workspace mode off")
+	AddTicket(t, cfg, "todo", "Added after leaving")
+	h.WaitFor("Added after leaving")
+}
+
+func TestSyncConflicts(t *testing.T) {
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Fix login", "bug")
+	conflict := *ticket
+	conflict.Tags = []string{"bug", "auth"}
+	conflict.FilePath = strings.TrimSuffix(ticket.FilePath, ".md") + " (conflicted copy).md"
+	if err := conflict.WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, cfg)
+	h.WaitFor("1 sync conflicts (!)")
+
+	h.Press("!")
+	h.WaitFor("Sync Conflicts (1)", "(conflicted copy).md")
+	h.Press("m")
+	h.WaitFor("Merged into", "auth")
+	got, err := models.ParseTicket(ticket.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Tags, []string{"bug", "auth"}) {
+		t.Errorf("tags = %q", got.Tags)
+	}
+	if got := ColumnTickets(t, cfg, "todo"); len(got) != 1 {
+		t.Errorf("todo = %q", got)
+	}
+}
+
+func TestPrivateNotes(t *testing.T) {
+	key, err := secret.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(secret.KeyEnv, key)
+	cfg := NewBoard(t)
+	ticket := AddTicket(t, cfg, "todo", "Rotate credentials")
+	h := New(t, cfg)
+	h.WaitFor("Rotate credentials")
+
+	h.Press("enter")
+	h.WaitFor("View Ticket", "private notes")
+	h.Press("p")
+	h.WaitFor("Private Notes")
+	h.Type("staging password is hunter2")
+	h.Press("ctrl+s")
+	h.WaitFor("Private notes encrypted and saved", "🔒 Private", "hunter2")
+
+	data, err := os.ReadFile(ticket.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "private: "+secret.Prefix) || strings.Contains(string(data), "hunter2") {
+		t.Errorf("ticket file:\n%s", data)
+	}
+	if got := ColumnTickets(t, cfg, "todo"); !reflect.DeepEqual(got, []string{"Rotate credentials"}) {
+		t.Errorf("todo = %q", got)
+	}
+}
+
+func TestColumnDefaults(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.Columns[0].DefaultTags = []string{"bug"}
+	cfg.Columns[0].Skeleton = "## Steps to reproduce"
+	h := New(t, cfg)
+	h.WaitFor("To Do")
+
+	h.Press("n")
+	h.WaitFor("New Ticket", "## Steps to reproduce")
+	h.Type("Crash on save")
+	h.Press("ctrl+s")
+	h.WaitFor("Created: Crash on save")
+
+	paths, _ := filepath.Glob(filepath.Join(cfg.ColumnPath("todo"), "*.md"))
+	if len(paths) != 1 {
+		t.Fatalf("todo files = %q", paths)
+	}
+	got, err := models.ParseTicket(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Tags, []string{"bug"}) || got.Content != "## Steps to reproduce" {
+		t.Errorf("tags %q, content %q", got.Tags, got.Content)
+	}
+}
+
+func TestMoveReason(t *testing.T) {
+	cfg := NewBoard(t)
+	cfg.MoveReasons = []config.Transition{{From: "doing", To: "todo"}}
+	ticket := AddTicket(t, cfg, "doing", "Fix login")
+	h := New(t, cfg)
+	h.WaitFor("Fix login")
+
+	h.Press("l", "<")
+	h.WaitFor("Move from Doing to To Do", "Reason")
+	h.Press("enter")
+	h.WaitFor("A reason is required")
+	h.Type("Blocked on API review")
+	h.Press("enter")
+	h.WaitFor("Moved to To Do: Blocked on API review")
+
+	paths, _ := filepath.Glob(filepath.Join(cfg.ColumnPath("todo"), "*.md"))
+	if len(paths) != 1 {
+		t.Fatalf("todo files = %q", paths)
+	}
+	got, err := models.ParseTicket(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Filename() != ticket.Filename() || got.MoveReason() != "Blocked on API review" {
+		t.Errorf("%s: reason %q", got.Filename(), got.MoveReason())
+	}
+}
+
+func TestMoveReasonBeforeHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands use sh")
+	}
+	cfg := NewBoard(t)
+	cfg.MoveReasons = []config.Transition{{From: "doing", To: "todo"}}
+	log := filepath.Join(t.TempDir(), "hooks.log")
+	cfg.Hooks.OnMove = `grep -h "reason:" "$KANBAN_TICKET" >> ` + log
+	AddTicket(t, cfg, "doing", "Fix login")
+	h := New(t, cfg)
+	h.WaitFor("Fix login")
+
+	// on_move sees the reason already saved in the ticket file
+	h.Press("l", "<")
+	h.WaitFor("Reason")
+	h.Type("Blocked")
+	h.Press("enter")
+	h.WaitUntil("on_move to run", func(string) bool {
+		data, _ := os.ReadFile(log)
+		return strings.TrimSpace(string(data)) == "reason: Blocked"
+	})
+}
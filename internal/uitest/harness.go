@@ -0,0 +1,222 @@
+// Package uitest drives the board UI headlessly for end-to-end tests: it
+// runs the real bubbletea program without a terminal, sends key presses and
+// waits for the rendered screen to show what a user would see.
+package uitest
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/kanban-tui/internal/config"
+	"github.com/user/kanban-tui/internal/state"
+	"github.com/user/kanban-tui/internal/ui"
+)
+
+// DefaultTimeout is how long WaitFor waits for the screen to match.
+const DefaultTimeout = 3 * time.Second
+
+// Screen size the program is started with.
+const (
+	Width  = 160
+	Height = 48
+)
+
+// ansiRe matches terminal escape sequences in rendered views.
+var ansiRe = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+// Harness runs a board program and records the view after every update.
+type Harness struct {
+	t         testing.TB
+	Config    *config.Config
+	Clipboard *Clipboard
+
+	program *tea.Program
+	done    chan struct{}
+
+	mu     sync.Mutex
+	screen string
+}
+
+// New starts the board described by cfg. User state is kept in a temporary
+// directory with the onboarding tips dismissed, the clipboard is in memory,
+// and the program is quit when the test ends. New sets XDG_STATE_HOME, so
+// tests using it cannot run in parallel.
+func New(t testing.TB, cfg *config.Config) *Harness {
+	t.Helper()
+	stateDir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateDir)
+	st, _ := state.Load()
+	st.TipsDismissed = true
+	if err := st.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	model, err := ui.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Harness{
+		t:         t,
+		Config:    cfg,
+		Clipboard: &Clipboard{},
+		done:      make(chan struct{}),
+	}
+	model.SetClipboard(h.Clipboard)
+
+	h.program = tea.NewProgram(&recorder{Model: model, h: h},
+		tea.WithInput(nil),
+		tea.WithOutput(io.Discard),
+		tea.WithoutSignalHandler(),
+	)
+	go func() {
+		defer close(h.done)
+		if _, err := h.program.Run(); err != nil {
+			t.Errorf("program: %v", err)
+		}
+	}()
+	h.Send(tea.WindowSizeMsg{Width: Width, Height: Height})
+	t.Cleanup(h.Quit)
+	return h
+}
+
+// Send delivers a message to the program.
+func (h *Harness) Send(msg tea.Msg) {
+	h.program.Send(msg)
+}
+
+// Press sends key presses by name: single characters, or names such as
+// "enter", "esc", "tab", "backspace", "up", "space" and "ctrl+s".
+func (h *Harness) Press(keys ...string) {
+	for _, key := range keys {
+		h.Send(Key(key))
+	}
+}
+
+// Type sends text one character at a time, as typed.
+func (h *Harness) Type(text string) {
+	for _, r := range text {
+		if r == ' ' {
+			h.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{r}})
+			continue
+		}
+		h.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+// Screen returns the last rendered view without colors or styling.
+func (h *Harness) Screen() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.screen
+}
+
+// WaitFor waits until the screen contains every one of texts, failing the
+// test with the final screen after DefaultTimeout.
+func (h *Harness) WaitFor(texts ...string) {
+	h.t.Helper()
+	h.WaitUntil("screen to contain "+strings.Join(texts, ", "), func(screen string) bool {
+		for _, text := range texts {
+			if !strings.Contains(screen, text) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// WaitUntil waits until cond holds for the screen or DefaultTimeout passes.
+func (h *Harness) WaitUntil(desc string, cond func(screen string) bool) {
+	h.t.Helper()
+	deadline := time.Now().Add(DefaultTimeout)
+	for {
+		screen := h.Screen()
+		if cond(screen) {
+			return
+		}
+		if time.Now().After(deadline) {
+			h.t.Fatalf("timed out waiting for %s; screen:\n%s", desc, screen)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Quit stops the program and waits for it to exit.
+func (h *Harness) Quit() {
+	h.program.Quit()
+	select {
+	case <-h.done:
+	case <-time.After(DefaultTimeout):
+		h.t.Error("program did not quit")
+	}
+}
+
+// recorder stores the plain text of every view the program renders.
+type recorder struct {
+	*ui.Model
+	h *Harness
+}
+
+func (r *recorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	_, cmd := r.Model.Update(msg)
+	view := ansiRe.ReplaceAllString(r.Model.View(), "")
+	r.h.mu.Lock()
+	r.h.screen = view
+	r.h.mu.Unlock()
+	return r, cmd
+}
+
+// keyTypes maps key names to their bubbletea key types.
+var keyTypes = map[string]tea.KeyType{
+	"enter":     tea.KeyEnter,
+	"esc":       tea.KeyEsc,
+	"tab":       tea.KeyTab,
+	"shift+tab": tea.KeyShiftTab,
+	"backspace": tea.KeyBackspace,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"ctrl+e":    tea.KeyCtrlE,
+	"ctrl+o":    tea.KeyCtrlO,
+	"ctrl+p":    tea.KeyCtrlP,
+	"ctrl+r":    tea.KeyCtrlR,
+	"ctrl+s":    tea.KeyCtrlS,
+	"ctrl+x":    tea.KeyCtrlX,
+}
+
+// Key returns the key message for a key name (see Press).
+func Key(name string) tea.KeyMsg {
+	if name == "space" || name == " " {
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}
+	}
+	if t, ok := keyTypes[name]; ok {
+		return tea.KeyMsg{Type: t}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name)}
+}
+
+// Clipboard is an in-memory clipboard.
+type Clipboard struct {
+	mu   sync.Mutex
+	text string
+}
+
+// Copy stores text.
+func (c *Clipboard) Copy(text string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.text = text
+	return nil
+}
+
+// Paste returns the stored text.
+func (c *Clipboard) Paste() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.text, nil
+}
@@ -2,6 +2,7 @@
 package watcher
 
 import (
+	"log/slog"
 	"path/filepath"
 	"sync"
 	"time"
@@ -24,6 +25,12 @@ type Watcher struct {
 	pending     map[string]*time.Timer
 	pendingLock sync.Mutex
 	done        chan struct{}
+
+	// Watched directories (markdown files in them are reported) and single
+	// files of any type
+	dirs      map[string]bool
+	files     map[string]bool
+	watchLock sync.Mutex
 }
 
 // New creates a new Watcher with the specified debounce duration.
@@ -40,6 +47,8 @@ func New(debounce time.Duration) (*Watcher, error) {
 		debounce: debounce,
 		pending:  make(map[string]*time.Timer),
 		done:     make(chan struct{}),
+		dirs:     make(map[string]bool),
+		files:    make(map[string]bool),
 	}
 
 	go w.run()
@@ -49,20 +58,58 @@ func New(debounce time.Duration) (*Watcher, error) {
 
 // Add adds a directory to watch.
 func (w *Watcher) Add(path string) error {
+	w.watchLock.Lock()
+	w.dirs[filepath.Clean(path)] = true
+	w.watchLock.Unlock()
 	return w.watcher.Add(path)
 }
 
+// AddFile watches a single file of any type. Its directory is watched, so
+// the file is still seen after editors replace it on save.
+func (w *Watcher) AddFile(path string) error {
+	w.watchLock.Lock()
+	w.files[filepath.Clean(path)] = true
+	w.watchLock.Unlock()
+	return w.watcher.Add(filepath.Dir(path))
+}
+
 // Remove stops watching a directory.
 func (w *Watcher) Remove(path string) error {
+	w.watchLock.Lock()
+	delete(w.dirs, filepath.Clean(path))
+	w.watchLock.Unlock()
 	return w.watcher.Remove(path)
 }
 
+// wanted reports whether events for path are reported: markdown files in
+// watched directories, and watched files.
+func (w *Watcher) wanted(path string) bool {
+	w.watchLock.Lock()
+	defer w.watchLock.Unlock()
+	if w.files[path] {
+		return true
+	}
+	return filepath.Ext(path) == ".md" && w.dirs[filepath.Dir(path)]
+}
+
+// Notify reports a change of path as if the file system had, for stores
+// that don't live on the local file system.
+func (w *Watcher) Notify(path string, op fsnotify.Op) {
+	w.debounceEvent(fsnotify.Event{Name: path, Op: op})
+}
+
 // Close stops the watcher.
 func (w *Watcher) Close() error {
 	close(w.done)
 	return w.watcher.Close()
 }
 
+// Done returns a channel that is closed when the watcher is closed, so
+// readers of Events and Errors can stop waiting.
+func (w *Watcher) Done() <-chan struct{} {
+	return w.done
+}
+
 // run processes file system events.
 func (w *Watcher) run() {
 	for {
@@ -75,8 +122,8 @@ func (w *Watcher) run() {
 				return
 			}
 
-			// Only process markdown files
-			if filepath.Ext(event.Name) != ".md" {
+			// Only process markdown files and explicitly watched files
+			if !w.wanted(filepath.Clean(event.Name)) {
 				continue
 			}
 
@@ -86,6 +133,7 @@ func (w *Watcher) run() {
 			if !ok {
 				return
 			}
+			slog.Warn("watcher error", "err", err)
 			select {
 			case w.Errors <- err:
 			default:
@@ -111,6 +159,7 @@ func (w *Watcher) debounceEvent(event fsnotify.Event) {
 		delete(w.pending, event.Name)
 		w.pendingLock.Unlock()
 
+		slog.Debug("watcher event", "path", event.Name, "op", event.Op.String())
 		select {
 		case w.Events <- Event{Path: event.Name, Op: event.Op}:
 		case <-w.done:
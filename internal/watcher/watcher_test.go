@@ -0,0 +1,40 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDone(t *testing.T) {
+	dir := t.TempDir()
+	w, err := New(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Add(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "a.md"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case e := <-w.Events:
+		if filepath.Base(e.Path) != "a.md" {
+			t.Errorf("event for %s", e.Path)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event")
+	}
+
+	// Readers waiting on a closed watcher are released
+	w.Close()
+	select {
+	case <-w.Done():
+	case <-w.Events:
+		t.Error("event after close")
+	case <-time.After(time.Second):
+		t.Fatal("Done not closed")
+	}
+}